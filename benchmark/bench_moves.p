@@ -0,0 +1,24 @@
+# output as png image
+set terminal png
+
+# items and workers are passed with -e, like the flags of benchmark/moves
+set output sprintf("moves_%d_%d.png", items, workers)
+
+# graph title
+set title sprintf("%d concurrent moves in a list of %d cards", workers, items)
+
+#nicer aspect ratio for image size
+set size 0.95,1
+
+# y-axis grid
+set grid y
+
+#x-axis label
+set xlabel "move"
+
+#y-axis label
+set ylabel "response time (ms)"
+
+#plot data from both runs using column 2 with smooth sbezier lines
+plot sprintf("positions_%d_%d.data", items, workers) using 2 smooth sbezier with lines title "positions", \
+     sprintf("ranks_%d_%d.data", items, workers) using 2 smooth sbezier with lines title "ranks"
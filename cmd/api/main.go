@@ -2,14 +2,39 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
+	"github.com/SlavaShagalov/my-trello-backend/internal/access"
+	accessRepository "github.com/SlavaShagalov/my-trello-backend/internal/access/repository/postgres"
+	"github.com/SlavaShagalov/my-trello-backend/internal/assignees"
+	assigneesRepository "github.com/SlavaShagalov/my-trello-backend/internal/assignees/repository/postgres"
+	"github.com/SlavaShagalov/my-trello-backend/internal/attachments"
+	attachmentsRepository "github.com/SlavaShagalov/my-trello-backend/internal/attachments/repository/postgres"
 	"github.com/SlavaShagalov/my-trello-backend/internal/boards"
 	boardsRepositoryPgx "github.com/SlavaShagalov/my-trello-backend/internal/boards/repository/pgx"
 	boardsRepository "github.com/SlavaShagalov/my-trello-backend/internal/boards/repository/std"
 	"github.com/SlavaShagalov/my-trello-backend/internal/cards"
 	cardsRepository "github.com/SlavaShagalov/my-trello-backend/internal/cards/repository/postgres"
+	"github.com/SlavaShagalov/my-trello-backend/internal/checklists"
+	checklistsRepository "github.com/SlavaShagalov/my-trello-backend/internal/checklists/repository/postgres"
+	"github.com/SlavaShagalov/my-trello-backend/internal/comments"
+	commentsRepository "github.com/SlavaShagalov/my-trello-backend/internal/comments/repository/postgres"
+	eventsBus "github.com/SlavaShagalov/my-trello-backend/internal/events/bus/redis"
+	webhooksBus "github.com/SlavaShagalov/my-trello-backend/internal/events/bus/webhooks"
+	"github.com/SlavaShagalov/my-trello-backend/internal/images"
+	imagesCollector "github.com/SlavaShagalov/my-trello-backend/internal/images/collector"
+	imagesRepositoryFS "github.com/SlavaShagalov/my-trello-backend/internal/images/repository/fs"
+	imagesReferencesRepository "github.com/SlavaShagalov/my-trello-backend/internal/images/repository/postgres"
 	imagesRepository "github.com/SlavaShagalov/my-trello-backend/internal/images/repository/s3"
+	"github.com/SlavaShagalov/my-trello-backend/internal/invitations"
+	invitationsRepository "github.com/SlavaShagalov/my-trello-backend/internal/invitations/repository/postgres"
+	invitationsSender "github.com/SlavaShagalov/my-trello-backend/internal/invitations/sender/logger"
+	"github.com/SlavaShagalov/my-trello-backend/internal/labels"
+	labelsRepository "github.com/SlavaShagalov/my-trello-backend/internal/labels/repository/postgres"
 	"github.com/SlavaShagalov/my-trello-backend/internal/lists"
 	listsRepository "github.com/SlavaShagalov/my-trello-backend/internal/lists/repository/postgres"
+	"github.com/SlavaShagalov/my-trello-backend/internal/members"
+	membersRepository "github.com/SlavaShagalov/my-trello-backend/internal/members/repository/postgres"
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
 	pHasher "github.com/SlavaShagalov/my-trello-backend/internal/pkg/hasher/bcrypt"
@@ -18,28 +43,59 @@ import (
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/opentel"
 	pStorages "github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages"
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages/postgres"
+	"github.com/SlavaShagalov/my-trello-backend/internal/ranks"
+	ranksRebalancer "github.com/SlavaShagalov/my-trello-backend/internal/ranks/rebalancer"
+	ranksRepository "github.com/SlavaShagalov/my-trello-backend/internal/ranks/repository/postgres"
 	sessionsRepository "github.com/SlavaShagalov/my-trello-backend/internal/sessions/repository/redis"
+	"github.com/SlavaShagalov/my-trello-backend/internal/trash"
+	trashPurger "github.com/SlavaShagalov/my-trello-backend/internal/trash/purger"
+	trashRepository "github.com/SlavaShagalov/my-trello-backend/internal/trash/repository/postgres"
 	"github.com/SlavaShagalov/my-trello-backend/internal/users"
 	usersRepository "github.com/SlavaShagalov/my-trello-backend/internal/users/repository/postgres"
+	"github.com/SlavaShagalov/my-trello-backend/internal/webhooks"
+	webhooksDispatcher "github.com/SlavaShagalov/my-trello-backend/internal/webhooks/dispatcher"
+	webhooksRepository "github.com/SlavaShagalov/my-trello-backend/internal/webhooks/repository/postgres"
 	"github.com/SlavaShagalov/my-trello-backend/internal/workspaces"
 	workspacesRepository "github.com/SlavaShagalov/my-trello-backend/internal/workspaces/repository/postgres"
 	"log"
+	"net"
 	"net/http"
 	"os"
+	"time"
 
+	accessUsecase "github.com/SlavaShagalov/my-trello-backend/internal/access/usecase"
+	assigneesUsecase "github.com/SlavaShagalov/my-trello-backend/internal/assignees/usecase"
+	attachmentsUsecase "github.com/SlavaShagalov/my-trello-backend/internal/attachments/usecase"
 	authUsecase "github.com/SlavaShagalov/my-trello-backend/internal/auth/usecase"
 	boardsUsecase "github.com/SlavaShagalov/my-trello-backend/internal/boards/usecase"
 	cardsUsecase "github.com/SlavaShagalov/my-trello-backend/internal/cards/usecase"
+	checklistsUsecase "github.com/SlavaShagalov/my-trello-backend/internal/checklists/usecase"
+	commentsUsecase "github.com/SlavaShagalov/my-trello-backend/internal/comments/usecase"
+	invitationsUsecase "github.com/SlavaShagalov/my-trello-backend/internal/invitations/usecase"
+	labelsUsecase "github.com/SlavaShagalov/my-trello-backend/internal/labels/usecase"
 	listsUsecase "github.com/SlavaShagalov/my-trello-backend/internal/lists/usecase"
+	membersUsecase "github.com/SlavaShagalov/my-trello-backend/internal/members/usecase"
+	trashUsecase "github.com/SlavaShagalov/my-trello-backend/internal/trash/usecase"
 	usersUsecase "github.com/SlavaShagalov/my-trello-backend/internal/users/usecase"
+	webhooksUsecase "github.com/SlavaShagalov/my-trello-backend/internal/webhooks/usecase"
 	workspacesUsecase "github.com/SlavaShagalov/my-trello-backend/internal/workspaces/usecase"
 
+	assigneesDel "github.com/SlavaShagalov/my-trello-backend/internal/assignees/delivery/http"
+	attachmentsDel "github.com/SlavaShagalov/my-trello-backend/internal/attachments/delivery/http"
 	authDel "github.com/SlavaShagalov/my-trello-backend/internal/auth/delivery/http"
 	boardsDel "github.com/SlavaShagalov/my-trello-backend/internal/boards/delivery/http"
 	cardsDel "github.com/SlavaShagalov/my-trello-backend/internal/cards/delivery/http"
+	checklistsDel "github.com/SlavaShagalov/my-trello-backend/internal/checklists/delivery/http"
+	commentsDel "github.com/SlavaShagalov/my-trello-backend/internal/comments/delivery/http"
+	eventsDel "github.com/SlavaShagalov/my-trello-backend/internal/events/delivery/http"
+	invitationsDel "github.com/SlavaShagalov/my-trello-backend/internal/invitations/delivery/http"
+	labelsDel "github.com/SlavaShagalov/my-trello-backend/internal/labels/delivery/http"
 	listsDel "github.com/SlavaShagalov/my-trello-backend/internal/lists/delivery/http"
+	membersDel "github.com/SlavaShagalov/my-trello-backend/internal/members/delivery/http"
 	mw "github.com/SlavaShagalov/my-trello-backend/internal/middleware"
+	trashDel "github.com/SlavaShagalov/my-trello-backend/internal/trash/delivery/http"
 	usersDel "github.com/SlavaShagalov/my-trello-backend/internal/users/delivery/http"
+	webhooksDel "github.com/SlavaShagalov/my-trello-backend/internal/webhooks/delivery/http"
 	workspacesDel "github.com/SlavaShagalov/my-trello-backend/internal/workspaces/delivery/http"
 
 	_ "github.com/SlavaShagalov/my-trello-backend/docs"
@@ -74,7 +130,10 @@ func main() {
 	config.SetDefaultPostgresConfig()
 	config.SetDefaultRedisConfig()
 	config.SetDefaultS3Config()
+	config.SetDefaultImagesConfig()
 	config.SetDefaultValidationConfig()
+	config.SetDefaultTrashConfig()
+	config.SetDefaultRanksConfig()
 	viper.SetConfigName("api")
 	viper.SetConfigType("yaml")
 	viper.AddConfigPath("/configs")
@@ -140,12 +199,6 @@ func main() {
 		logger.Info("Redis client closed")
 	}()
 
-	// ===== S3 =====
-	s3Client, err := pStorages.NewS3(logger)
-	if err != nil {
-		os.Exit(1)
-	}
-
 	// ===== Prometheus =====
 	mt := pMetrics.NewPrometheusMetrics("api")
 	err = mt.SetupMetrics()
@@ -160,13 +213,35 @@ func main() {
 	// ===== Repositories =====
 	var usersRepo users.Repository
 	var workspacesRepo workspaces.Repository
+	var membersRepo members.Repository
+	var invitationsRepo invitations.Repository
 	var boardsRepo boards.Repository
 	var listsRepo lists.Repository
 	var cardsRepo cards.Repository
+	var labelsRepo labels.Repository
+	var assigneesRepo assignees.Repository
+	var checklistsRepo checklists.Repository
+	var commentsRepo comments.Repository
+	var attachmentsRepo attachments.Repository
+	var accessRepo access.Repository
+	var webhooksRepo webhooks.Repository
+	var trashRepo trash.Repository
+	var ranksRepo ranks.Repository
 	usersRepo = usersRepository.New(db, logger)
 	workspacesRepo = workspacesRepository.New(db, logger)
+	membersRepo = membersRepository.New(db, logger)
+	invitationsRepo = invitationsRepository.New(db, logger)
 	listsRepo = listsRepository.New(db, logger)
 	cardsRepo = cardsRepository.New(db, logger)
+	labelsRepo = labelsRepository.New(db, logger)
+	assigneesRepo = assigneesRepository.New(db, logger)
+	checklistsRepo = checklistsRepository.New(db, logger)
+	commentsRepo = commentsRepository.New(db, logger)
+	attachmentsRepo = attachmentsRepository.New(db, logger)
+	accessRepo = accessRepository.New(db, logger)
+	webhooksRepo = webhooksRepository.New(db, logger)
+	trashRepo = trashRepository.New(db, logger)
+	ranksRepo = ranksRepository.New(db, logger)
 
 	serverType := viper.GetString(config.ServerType)
 
@@ -182,16 +257,73 @@ func main() {
 		boardsRepo = boardsRepositoryPgx.New(pgxPool, logger)
 	}
 
-	imagesRepo := imagesRepository.New(s3Client, logger)
+	var imagesRepo images.Repository
+	imagesStorage := viper.GetString(config.ImagesStorage)
+	if imagesStorage == "fs" {
+		imagesRepo = imagesRepositoryFS.New(viper.GetString(config.ImagesDir), logger)
+	} else if imagesStorage == "s3" {
+		s3Client, err := pStorages.NewS3(logger)
+		if err != nil {
+			os.Exit(1)
+		}
+
+		imagesRepo = imagesRepository.New(s3Client, logger)
+	} else {
+		logger.Error("Unknown images storage", zap.String("storage", imagesStorage))
+		os.Exit(1)
+	}
+
+	// ===== Images Collector =====
+	collector := imagesCollector.New(imagesRepo, imagesReferencesRepository.New(db, logger), logger)
+
+	// ===== Commands =====
+	if len(os.Args) > 1 {
+		err = runCommand(ctx, os.Args[1:], collector)
+		if err != nil {
+			log.Println(err)
+			os.Exit(1)
+		}
+		return
+	}
+	go collector.Run(ctx)
+
 	sessionsRepo := sessionsRepository.New(redisClient, context.Background(), logger)
 
+	// ===== Event Bus =====
+	bus := webhooksBus.New(eventsBus.New(redisClient, context.Background(), logger), webhooksRepo)
+
+	// ===== Webhooks Dispatcher =====
+	dispatcher := webhooksDispatcher.New(webhooksRepo, webhooksDispatcher.NewClient(constants.WebhookTimeout), logger)
+	go dispatcher.Run(ctx)
+
+	// ===== Trash Purger =====
+	purger := trashPurger.New(trashRepo, imagesRepo, logger)
+	go purger.Run(ctx)
+
+	// ===== Ranks Rebalancer =====
+	rebalancer := ranksRebalancer.New(ranksRepo, logger)
+	go rebalancer.Run(ctx)
+
+	// ===== Invitations Sender =====
+	invitationsSnd := invitationsSender.New(logger)
+
 	// ===== Usecases =====
 	authUC := authUsecase.New(usersRepo, sessionsRepo, hasher, logger)
 	usersUC := usersUsecase.New(usersRepo, imagesRepo)
 	workspacesUC := workspacesUsecase.New(workspacesRepo)
-	boardsUC := boardsUsecase.New(boardsRepo, imagesRepo)
-	listsUC := listsUsecase.New(listsRepo)
-	cardsUC := cardsUsecase.New(cardsRepo)
+	membersUC := membersUsecase.New(membersRepo)
+	invitationsUC := invitationsUsecase.New(invitationsRepo, invitationsSnd, usersRepo)
+	boardsUC := boardsUsecase.New(boardsRepo, imagesRepo, bus)
+	listsUC := listsUsecase.New(listsRepo, bus)
+	cardsUC := cardsUsecase.New(cardsRepo, listsRepo, bus)
+	labelsUC := labelsUsecase.New(labelsRepo)
+	assigneesUC := assigneesUsecase.New(assigneesRepo)
+	checklistsUC := checklistsUsecase.New(checklistsRepo, listsRepo, bus)
+	commentsUC := commentsUsecase.New(commentsRepo)
+	attachmentsUC := attachmentsUsecase.New(attachmentsRepo, imagesRepo)
+	accessUC := accessUsecase.New(accessRepo)
+	webhooksUC := webhooksUsecase.New(webhooksRepo, net.DefaultResolver)
+	trashUC := trashUsecase.New(trashRepo, boardsUC, listsUC, cardsUC, bus)
 
 	// ===== Middleware =====
 	checkAuth := mw.NewCheckAuth(authUC, logger)
@@ -203,15 +335,32 @@ func main() {
 
 	// ===== Delivery =====
 	authDel.RegisterHandlers(router, authUC, usersUC, logger, checkAuth, metrics)
-	usersDel.RegisterHandlers(router, usersUC, logger, checkAuth, metrics)
-	workspacesDel.RegisterHandlers(router, workspacesUC, boardsUC, logger, checkAuth, metrics)
-	boardsDel.RegisterHandlers(router, boardsUC, logger, checkAuth, metrics)
-	listsDel.RegisterHandlers(router, listsUC, cardsUC, logger, checkAuth, metrics)
-	cardsDel.RegisterHandlers(router, cardsUC, logger, checkAuth, metrics)
+	usersDel.RegisterHandlers(router, usersUC, accessUC, logger, checkAuth, metrics)
+	workspacesDel.RegisterHandlers(router, workspacesUC, boardsUC, accessUC, logger, checkAuth, metrics)
+	membersDel.RegisterHandlers(router, membersUC, accessUC, logger, checkAuth, metrics)
+	invitationsDel.RegisterHandlers(router, invitationsUC, accessUC, logger, checkAuth, metrics)
+	boardsDel.RegisterHandlers(router, boardsUC, accessUC, logger, checkAuth, metrics)
+	listsDel.RegisterHandlers(router, listsUC, cardsUC, accessUC, logger, checkAuth, metrics)
+	cardsDel.RegisterHandlers(router, cardsUC, accessUC, logger, checkAuth, metrics)
+	labelsDel.RegisterHandlers(router, labelsUC, accessUC, logger, checkAuth, metrics)
+	assigneesDel.RegisterHandlers(router, assigneesUC, accessUC, logger, checkAuth, metrics)
+	checklistsDel.RegisterHandlers(router, checklistsUC, accessUC, logger, checkAuth, metrics)
+	commentsDel.RegisterHandlers(router, commentsUC, accessUC, logger, checkAuth, metrics)
+	attachmentsDel.RegisterHandlers(router, attachmentsUC, accessUC, logger, checkAuth, metrics)
+	eventsDel.RegisterHandlers(router, bus, accessUC, logger, checkAuth)
+	webhooksDel.RegisterHandlers(router, webhooksUC, accessUC, logger, checkAuth, metrics)
+	trashDel.RegisterHandlers(router, trashUC, accessUC, logger, checkAuth, metrics)
 
 	// ===== Swagger =====
 	router.PathPrefix(constants.ApiPrefix + "/swagger/").Handler(httpSwagger.WrapHandler).Methods(http.MethodGet)
 
+	// ===== Images =====
+	if imagesStorage == "fs" {
+		imagesHandler := imagesRepositoryFS.Handler(viper.GetString(config.ImagesDir))
+		router.PathPrefix(constants.ImagesPrefix + "/").
+			Handler(http.StripPrefix(constants.ImagesPrefix, imagesHandler)).Methods(http.MethodGet)
+	}
+
 	// ===== Router =====
 	server := http.Server{
 		Addr:    ":" + viper.GetString(config.ServerPort),
@@ -228,3 +377,37 @@ func main() {
 		logger.Error("API server stopped", zap.Error(err))
 	}
 }
+
+// runCommand runs a one-shot maintenance command instead of the server:
+//
+//	api gc-images [-dry-run]	deletes orphaned avatars and backgrounds
+func runCommand(ctx context.Context, args []string, collector images.Collector) error {
+	switch args[0] {
+	case "gc-images":
+		flags := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		dryRun := flags.Bool("dry-run", false, "only report orphaned images")
+		err := flags.Parse(args[1:])
+		if err != nil {
+			return err
+		}
+
+		report, err := collector.Collect(ctx, *dryRun)
+		if err != nil {
+			return err
+		}
+
+		for _, object := range report.Orphaned {
+			fmt.Printf("%s\t%d\t%s\n", object.Key, object.Size, object.ModifiedAt.Format(time.RFC3339))
+		}
+		fmt.Printf("scanned: %d, referenced: %d, recent: %d, orphaned: %d\n",
+			report.Scanned, report.Referenced, report.Recent, len(report.Orphaned))
+		if *dryRun {
+			fmt.Println("dry run, nothing deleted")
+		} else {
+			fmt.Printf("deleted: %d, failed: %d, freed: %d bytes\n", report.Deleted, report.Failed, report.FreedBytes)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+}
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/access/repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// BoardRole mocks base method.
+func (m *MockRepository) BoardRole(userID, boardID int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BoardRole", userID, boardID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BoardRole indicates an expected call of BoardRole.
+func (mr *MockRepositoryMockRecorder) BoardRole(userID, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BoardRole", reflect.TypeOf((*MockRepository)(nil).BoardRole), userID, boardID)
+}
+
+// CardRole mocks base method.
+func (m *MockRepository) CardRole(userID, cardID int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CardRole", userID, cardID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CardRole indicates an expected call of CardRole.
+func (mr *MockRepositoryMockRecorder) CardRole(userID, cardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CardRole", reflect.TypeOf((*MockRepository)(nil).CardRole), userID, cardID)
+}
+
+// ListRole mocks base method.
+func (m *MockRepository) ListRole(userID, listID int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRole", userID, listID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRole indicates an expected call of ListRole.
+func (mr *MockRepositoryMockRecorder) ListRole(userID, listID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRole", reflect.TypeOf((*MockRepository)(nil).ListRole), userID, listID)
+}
+
+// WebhookRole mocks base method.
+func (m *MockRepository) WebhookRole(userID, webhookID int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebhookRole", userID, webhookID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WebhookRole indicates an expected call of WebhookRole.
+func (mr *MockRepositoryMockRecorder) WebhookRole(userID, webhookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebhookRole", reflect.TypeOf((*MockRepository)(nil).WebhookRole), userID, webhookID)
+}
+
+// WorkspaceRole mocks base method.
+func (m *MockRepository) WorkspaceRole(userID, workspaceID int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkspaceRole", userID, workspaceID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkspaceRole indicates an expected call of WorkspaceRole.
+func (mr *MockRepositoryMockRecorder) WorkspaceRole(userID, workspaceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkspaceRole", reflect.TypeOf((*MockRepository)(nil).WorkspaceRole), userID, workspaceID)
+}
@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/access/usecase.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	access "github.com/SlavaShagalov/my-trello-backend/internal/access"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CheckBoard mocks base method.
+func (m *MockUsecase) CheckBoard(userID, boardID int, perm access.Permission) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckBoard", userID, boardID, perm)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckBoard indicates an expected call of CheckBoard.
+func (mr *MockUsecaseMockRecorder) CheckBoard(userID, boardID, perm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBoard", reflect.TypeOf((*MockUsecase)(nil).CheckBoard), userID, boardID, perm)
+}
+
+// CheckCard mocks base method.
+func (m *MockUsecase) CheckCard(userID, cardID int, perm access.Permission) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckCard", userID, cardID, perm)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckCard indicates an expected call of CheckCard.
+func (mr *MockUsecaseMockRecorder) CheckCard(userID, cardID, perm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckCard", reflect.TypeOf((*MockUsecase)(nil).CheckCard), userID, cardID, perm)
+}
+
+// CheckList mocks base method.
+func (m *MockUsecase) CheckList(userID, listID int, perm access.Permission) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckList", userID, listID, perm)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckList indicates an expected call of CheckList.
+func (mr *MockUsecaseMockRecorder) CheckList(userID, listID, perm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckList", reflect.TypeOf((*MockUsecase)(nil).CheckList), userID, listID, perm)
+}
+
+// CheckUser mocks base method.
+func (m *MockUsecase) CheckUser(userID, targetID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckUser", userID, targetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckUser indicates an expected call of CheckUser.
+func (mr *MockUsecaseMockRecorder) CheckUser(userID, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUser", reflect.TypeOf((*MockUsecase)(nil).CheckUser), userID, targetID)
+}
+
+// CheckWebhook mocks base method.
+func (m *MockUsecase) CheckWebhook(userID, webhookID int, perm access.Permission) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckWebhook", userID, webhookID, perm)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckWebhook indicates an expected call of CheckWebhook.
+func (mr *MockUsecaseMockRecorder) CheckWebhook(userID, webhookID, perm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckWebhook", reflect.TypeOf((*MockUsecase)(nil).CheckWebhook), userID, webhookID, perm)
+}
+
+// CheckWorkspace mocks base method.
+func (m *MockUsecase) CheckWorkspace(userID, workspaceID int, perm access.Permission) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckWorkspace", userID, workspaceID, perm)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckWorkspace indicates an expected call of CheckWorkspace.
+func (mr *MockUsecaseMockRecorder) CheckWorkspace(userID, workspaceID, perm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckWorkspace", reflect.TypeOf((*MockUsecase)(nil).CheckWorkspace), userID, workspaceID, perm)
+}
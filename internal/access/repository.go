@@ -0,0 +1,12 @@
+package access
+
+// Repository resolves the role of a user in the workspace owning a resource.
+// An empty role means the resource exists but the user is not a member.
+// Everyone observes built-in templates, which belong to no workspace.
+type Repository interface {
+	WorkspaceRole(userID, workspaceID int) (string, error)
+	BoardRole(userID, boardID int) (string, error)
+	ListRole(userID, listID int) (string, error)
+	CardRole(userID, cardID int) (string, error)
+	WebhookRole(userID, webhookID int) (string, error)
+}
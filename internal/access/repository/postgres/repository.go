@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"database/sql"
+	pkgAccess "github.com/SlavaShagalov/my-trello-backend/internal/access"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+type repository struct {
+	db  *sql.DB
+	log *zap.Logger
+}
+
+func New(db *sql.DB, log *zap.Logger) pkgAccess.Repository {
+	return &repository{db: db, log: log}
+}
+
+const workspaceRoleCmd = `
+	SELECT COALESCE(m.role, '')
+	FROM workspaces w
+	LEFT JOIN workspace_members m on m.workspace_id = w.id AND m.user_id = $1
+	WHERE w.id = $2;`
+
+func (repo *repository) WorkspaceRole(userID, workspaceID int) (string, error) {
+	return repo.role(workspaceRoleCmd, userID, workspaceID, pkgErrors.ErrWorkspaceNotFound)
+}
+
+const boardRoleCmd = `
+	SELECT COALESCE(m.role, CASE WHEN b.workspace_id IS NULL THEN 'observer' ELSE '' END)
+	FROM boards b
+	LEFT JOIN workspace_members m on m.workspace_id = b.workspace_id AND m.user_id = $1
+	WHERE b.id = $2;`
+
+func (repo *repository) BoardRole(userID, boardID int) (string, error) {
+	return repo.role(boardRoleCmd, userID, boardID, pkgErrors.ErrBoardNotFound)
+}
+
+const listRoleCmd = `
+	SELECT COALESCE(m.role, CASE WHEN b.workspace_id IS NULL THEN 'observer' ELSE '' END)
+	FROM lists l
+	JOIN boards b on b.id = l.board_id
+	LEFT JOIN workspace_members m on m.workspace_id = b.workspace_id AND m.user_id = $1
+	WHERE l.id = $2;`
+
+func (repo *repository) ListRole(userID, listID int) (string, error) {
+	return repo.role(listRoleCmd, userID, listID, pkgErrors.ErrListNotFound)
+}
+
+const cardRoleCmd = `
+	SELECT COALESCE(m.role, CASE WHEN b.workspace_id IS NULL THEN 'observer' ELSE '' END)
+	FROM cards c
+	JOIN lists l on l.id = c.list_id
+	JOIN boards b on b.id = l.board_id
+	LEFT JOIN workspace_members m on m.workspace_id = b.workspace_id AND m.user_id = $1
+	WHERE c.id = $2;`
+
+func (repo *repository) CardRole(userID, cardID int) (string, error) {
+	return repo.role(cardRoleCmd, userID, cardID, pkgErrors.ErrCardNotFound)
+}
+
+const webhookRoleCmd = `
+	SELECT COALESCE(m.role, '')
+	FROM webhooks h
+	LEFT JOIN boards b on b.id = h.board_id
+	LEFT JOIN workspace_members m on m.workspace_id = COALESCE(h.workspace_id, b.workspace_id) AND m.user_id = $1
+	WHERE h.id = $2;`
+
+func (repo *repository) WebhookRole(userID, webhookID int) (string, error) {
+	return repo.role(webhookRoleCmd, userID, webhookID, pkgErrors.ErrWebhookNotFound)
+}
+
+func (repo *repository) role(query string, userID, id int, errNotFound error) (string, error) {
+	var role string
+	err := repo.db.QueryRow(query, userID, id).Scan(&role)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", errors.Wrap(errNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", query),
+			zap.Int("user_id", userID), zap.Int("id", id))
+		return "", errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	return role, nil
+}
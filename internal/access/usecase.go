@@ -0,0 +1,23 @@
+package access
+
+type Permission int
+
+const (
+	// Read is granted to every workspace member, including observers.
+	Read Permission = iota + 1
+	// Write allows creating and editing boards, lists and cards.
+	Write
+	// Manage allows editing the workspace and its members.
+	Manage
+	// Own allows deleting the workspace.
+	Own
+)
+
+type Usecase interface {
+	CheckWorkspace(userID, workspaceID int, perm Permission) error
+	CheckBoard(userID, boardID int, perm Permission) error
+	CheckList(userID, listID int, perm Permission) error
+	CheckCard(userID, cardID int, perm Permission) error
+	CheckWebhook(userID, webhookID int, perm Permission) error
+	CheckUser(userID, targetID int) error
+}
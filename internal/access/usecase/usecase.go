@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/access"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+)
+
+var rolePermissions = map[string]access.Permission{
+	models.RoleObserver: access.Read,
+	models.RoleMember:   access.Write,
+	models.RoleAdmin:    access.Manage,
+	models.RoleOwner:    access.Own,
+}
+
+type usecase struct {
+	repo access.Repository
+}
+
+func New(repo access.Repository) access.Usecase {
+	return &usecase{repo: repo}
+}
+
+func (uc *usecase) CheckWorkspace(userID, workspaceID int, perm access.Permission) error {
+	role, err := uc.repo.WorkspaceRole(userID, workspaceID)
+	if err != nil {
+		return err
+	}
+	return checkRole(role, perm)
+}
+
+func (uc *usecase) CheckBoard(userID, boardID int, perm access.Permission) error {
+	role, err := uc.repo.BoardRole(userID, boardID)
+	if err != nil {
+		return err
+	}
+	return checkRole(role, perm)
+}
+
+func (uc *usecase) CheckList(userID, listID int, perm access.Permission) error {
+	role, err := uc.repo.ListRole(userID, listID)
+	if err != nil {
+		return err
+	}
+	return checkRole(role, perm)
+}
+
+func (uc *usecase) CheckCard(userID, cardID int, perm access.Permission) error {
+	role, err := uc.repo.CardRole(userID, cardID)
+	if err != nil {
+		return err
+	}
+	return checkRole(role, perm)
+}
+
+func (uc *usecase) CheckWebhook(userID, webhookID int, perm access.Permission) error {
+	role, err := uc.repo.WebhookRole(userID, webhookID)
+	if err != nil {
+		return err
+	}
+	return checkRole(role, perm)
+}
+
+func (uc *usecase) CheckUser(userID, targetID int) error {
+	if userID != targetID {
+		return pkgErrors.ErrAccessDenied
+	}
+	return nil
+}
+
+func checkRole(role string, perm access.Permission) error {
+	granted, ok := rolePermissions[role]
+	if !ok || granted < perm {
+		return pkgErrors.ErrAccessDenied
+	}
+	return nil
+}
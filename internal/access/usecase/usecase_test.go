@@ -0,0 +1,429 @@
+package usecase
+
+import (
+	pkgAccess "github.com/SlavaShagalov/my-trello-backend/internal/access"
+	"github.com/SlavaShagalov/my-trello-backend/internal/access/mocks"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"testing"
+)
+
+func TestUsecase_CheckWorkspace(t *testing.T) {
+	type fields struct {
+		repo        *mocks.MockRepository
+		userID      int
+		workspaceID int
+		role        string
+	}
+
+	type testCase struct {
+		prepare     func(f *fields)
+		userID      int
+		workspaceID int
+		perm        pkgAccess.Permission
+		role        string
+		err         error
+	}
+
+	tests := map[string]testCase{
+		"owner deletes": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().WorkspaceRole(f.userID, f.workspaceID).Return(f.role, nil)
+			},
+			userID:      1,
+			workspaceID: 21,
+			perm:        pkgAccess.Own,
+			role:        models.RoleOwner,
+			err:         nil,
+		},
+		"admin deletes": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().WorkspaceRole(f.userID, f.workspaceID).Return(f.role, nil)
+			},
+			userID:      1,
+			workspaceID: 21,
+			perm:        pkgAccess.Own,
+			role:        models.RoleAdmin,
+			err:         pkgErrors.ErrAccessDenied,
+		},
+		"admin manages": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().WorkspaceRole(f.userID, f.workspaceID).Return(f.role, nil)
+			},
+			userID:      1,
+			workspaceID: 21,
+			perm:        pkgAccess.Manage,
+			role:        models.RoleAdmin,
+			err:         nil,
+		},
+		"not a member": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().WorkspaceRole(f.userID, f.workspaceID).Return(f.role, nil)
+			},
+			userID:      1,
+			workspaceID: 21,
+			perm:        pkgAccess.Read,
+			role:        "",
+			err:         pkgErrors.ErrAccessDenied,
+		},
+		"workspace not found": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().WorkspaceRole(f.userID, f.workspaceID).Return("", pkgErrors.ErrWorkspaceNotFound)
+			},
+			userID:      1,
+			workspaceID: 21,
+			perm:        pkgAccess.Read,
+			err:         pkgErrors.ErrWorkspaceNotFound,
+		},
+		"storages error": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().WorkspaceRole(f.userID, f.workspaceID).Return("", pkgErrors.ErrDb)
+			},
+			userID:      1,
+			workspaceID: 21,
+			perm:        pkgAccess.Read,
+			err:         pkgErrors.ErrDb,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), userID: test.userID, workspaceID: test.workspaceID,
+				role: test.role}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo)
+			err := uc.CheckWorkspace(test.userID, test.workspaceID, test.perm)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_CheckBoard(t *testing.T) {
+	type fields struct {
+		repo    *mocks.MockRepository
+		userID  int
+		boardID int
+		role    string
+	}
+
+	type testCase struct {
+		prepare func(f *fields)
+		userID  int
+		boardID int
+		perm    pkgAccess.Permission
+		role    string
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"member writes": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().BoardRole(f.userID, f.boardID).Return(f.role, nil)
+			},
+			userID:  1,
+			boardID: 12,
+			perm:    pkgAccess.Write,
+			role:    models.RoleMember,
+			err:     nil,
+		},
+		"member manages": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().BoardRole(f.userID, f.boardID).Return(f.role, nil)
+			},
+			userID:  1,
+			boardID: 12,
+			perm:    pkgAccess.Manage,
+			role:    models.RoleMember,
+			err:     pkgErrors.ErrAccessDenied,
+		},
+		"board not found": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().BoardRole(f.userID, f.boardID).Return("", pkgErrors.ErrBoardNotFound)
+			},
+			userID:  1,
+			boardID: 12,
+			perm:    pkgAccess.Read,
+			err:     pkgErrors.ErrBoardNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), userID: test.userID, boardID: test.boardID,
+				role: test.role}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo)
+			err := uc.CheckBoard(test.userID, test.boardID, test.perm)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_CheckList(t *testing.T) {
+	type fields struct {
+		repo   *mocks.MockRepository
+		userID int
+		listID int
+		role   string
+	}
+
+	type testCase struct {
+		prepare func(f *fields)
+		userID  int
+		listID  int
+		perm    pkgAccess.Permission
+		role    string
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"observer reads": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().ListRole(f.userID, f.listID).Return(f.role, nil)
+			},
+			userID: 2,
+			listID: 27,
+			perm:   pkgAccess.Read,
+			role:   models.RoleObserver,
+			err:    nil,
+		},
+		"observer writes": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().ListRole(f.userID, f.listID).Return(f.role, nil)
+			},
+			userID: 2,
+			listID: 27,
+			perm:   pkgAccess.Write,
+			role:   models.RoleObserver,
+			err:    pkgErrors.ErrAccessDenied,
+		},
+		"list not found": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().ListRole(f.userID, f.listID).Return("", pkgErrors.ErrListNotFound)
+			},
+			userID: 2,
+			listID: 27,
+			perm:   pkgAccess.Read,
+			err:    pkgErrors.ErrListNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), userID: test.userID, listID: test.listID,
+				role: test.role}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo)
+			err := uc.CheckList(test.userID, test.listID, test.perm)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_CheckCard(t *testing.T) {
+	type fields struct {
+		repo   *mocks.MockRepository
+		userID int
+		cardID int
+		role   string
+	}
+
+	type testCase struct {
+		prepare func(f *fields)
+		userID  int
+		cardID  int
+		perm    pkgAccess.Permission
+		role    string
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"owner writes": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().CardRole(f.userID, f.cardID).Return(f.role, nil)
+			},
+			userID: 3,
+			cardID: 41,
+			perm:   pkgAccess.Write,
+			role:   models.RoleOwner,
+			err:    nil,
+		},
+		"not a member": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().CardRole(f.userID, f.cardID).Return(f.role, nil)
+			},
+			userID: 3,
+			cardID: 41,
+			perm:   pkgAccess.Read,
+			role:   "",
+			err:    pkgErrors.ErrAccessDenied,
+		},
+		"card not found": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().CardRole(f.userID, f.cardID).Return("", pkgErrors.ErrCardNotFound)
+			},
+			userID: 3,
+			cardID: 41,
+			perm:   pkgAccess.Read,
+			err:    pkgErrors.ErrCardNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), userID: test.userID, cardID: test.cardID,
+				role: test.role}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo)
+			err := uc.CheckCard(test.userID, test.cardID, test.perm)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_CheckWebhook(t *testing.T) {
+	type fields struct {
+		repo      *mocks.MockRepository
+		userID    int
+		webhookID int
+		role      string
+	}
+
+	type testCase struct {
+		prepare   func(f *fields)
+		userID    int
+		webhookID int
+		perm      pkgAccess.Permission
+		role      string
+		err       error
+	}
+
+	tests := map[string]testCase{
+		"admin manages": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().WebhookRole(f.userID, f.webhookID).Return(f.role, nil)
+			},
+			userID:    1,
+			webhookID: 5,
+			perm:      pkgAccess.Manage,
+			role:      models.RoleAdmin,
+			err:       nil,
+		},
+		"member manages": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().WebhookRole(f.userID, f.webhookID).Return(f.role, nil)
+			},
+			userID:    1,
+			webhookID: 5,
+			perm:      pkgAccess.Manage,
+			role:      models.RoleMember,
+			err:       pkgErrors.ErrAccessDenied,
+		},
+		"webhook not found": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().WebhookRole(f.userID, f.webhookID).Return("", pkgErrors.ErrWebhookNotFound)
+			},
+			userID:    1,
+			webhookID: 5,
+			perm:      pkgAccess.Manage,
+			err:       pkgErrors.ErrWebhookNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), userID: test.userID, webhookID: test.webhookID,
+				role: test.role}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo)
+			err := uc.CheckWebhook(test.userID, test.webhookID, test.perm)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_CheckUser(t *testing.T) {
+	type testCase struct {
+		userID   int
+		targetID int
+		err      error
+	}
+
+	tests := map[string]testCase{
+		"self":         {userID: 4, targetID: 4, err: nil},
+		"another user": {userID: 4, targetID: 5, err: pkgErrors.ErrAccessDenied},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := New(mocks.NewMockRepository(ctrl))
+			err := uc.CheckUser(test.userID, test.targetID)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package http
+
+import (
+	pAccess "github.com/SlavaShagalov/my-trello-backend/internal/access"
+	pAssignees "github.com/SlavaShagalov/my-trello-backend/internal/assignees"
+	mw "github.com/SlavaShagalov/my-trello-backend/internal/middleware"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pHTTP "github.com/SlavaShagalov/my-trello-backend/internal/pkg/http"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+type delivery struct {
+	uc       pAssignees.Usecase
+	accessUC pAccess.Usecase
+	log      *zap.Logger
+}
+
+func RegisterHandlers(mux *mux.Router, uc pAssignees.Usecase, accessUC pAccess.Usecase, log *zap.Logger,
+	checkAuth mw.Middleware, metrics mw.Middleware) {
+	del := delivery{
+		uc:       uc,
+		accessUC: accessUC,
+		log:      log,
+	}
+
+	const (
+		cardAssigneesPrefix = "/cards/{id}/assignees"
+		cardAssigneesPath   = constants.ApiPrefix + cardAssigneesPrefix
+		cardAssigneePath    = cardAssigneesPath + "/{user_id}"
+	)
+
+	mux.HandleFunc(cardAssigneesPath, metrics(checkAuth(del.listByCard))).Methods(http.MethodGet)
+	mux.HandleFunc(cardAssigneesPath, metrics(checkAuth(del.assign))).Methods(http.MethodPost)
+	mux.HandleFunc(cardAssigneePath, metrics(checkAuth(del.unassign))).Methods(http.MethodDelete)
+}
+
+// listByCard godoc
+//
+//	@Summary		Returns assignees of card
+//	@Description	Returns workspace members assigned to the card
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id	path		int				true	"Card ID"
+//	@Success		200	{object}	listResponse	"Assignees data"
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/assignees [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) listByCard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	assignees, err := del.uc.ListByCard(cardID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newListResponse(assignees)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// assign godoc
+//
+//	@Summary		Assign user to card
+//	@Description	Assign a member of the card's workspace to the card
+//	@Tags			cards
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		int				true	"Card ID"
+//	@Param			AssigneeData	body		assignRequest	true	"User to assign"
+//	@Success		200				{object}	listResponse	"Assignees of card"
+//	@Failure		400				{object}	http.JSONError
+//	@Failure		401				{object}	http.JSONError
+//	@Failure		403				{object}	http.JSONError
+//	@Failure		404				{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/assignees [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) assign(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	body, err := pHTTP.ReadBody(r, del.log)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	var request assignRequest
+	err = request.UnmarshalJSON(body)
+	if err != nil {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	assignees, err := del.uc.Assign(cardID, request.UserID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newListResponse(assignees)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// unassign godoc
+//
+//	@Summary		Unassign user from card
+//	@Description	Unassign user from card
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id		path		int				true	"Card ID"
+//	@Param			user_id	path		int				true	"User ID"
+//	@Success		200		{object}	listResponse	"Assignees of card"
+//	@Failure		400		{object}	http.JSONError
+//	@Failure		401		{object}	http.JSONError
+//	@Failure		403		{object}	http.JSONError
+//	@Failure		404		{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/assignees/{user_id} [delete]
+//
+//	@Security		cookieAuth
+func (del *delivery) unassign(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	assigneeID, err := strconv.Atoi(vars["user_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	assignees, err := del.uc.Unassign(cardID, assigneeID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newListResponse(assignees)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
@@ -0,0 +1,23 @@
+package http
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+)
+
+//go:generate easyjson -all -snake_case models.go
+
+// API requests
+type assignRequest struct {
+	UserID int `json:"user_id"`
+}
+
+// API responses
+type listResponse struct {
+	Assignees []models.Assignee `json:"assignees"`
+}
+
+func newListResponse(assignees []models.Assignee) *listResponse {
+	return &listResponse{
+		Assignees: assignees,
+	}
+}
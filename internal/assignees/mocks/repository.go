@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/assignees/repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	models "github.com/SlavaShagalov/my-trello-backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockRepository) Assign(cardID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", cardID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockRepositoryMockRecorder) Assign(cardID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockRepository)(nil).Assign), cardID, userID)
+}
+
+// ListByCard mocks base method.
+func (m *MockRepository) ListByCard(cardID int) ([]models.Assignee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCard", cardID)
+	ret0, _ := ret[0].([]models.Assignee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCard indicates an expected call of ListByCard.
+func (mr *MockRepositoryMockRecorder) ListByCard(cardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCard", reflect.TypeOf((*MockRepository)(nil).ListByCard), cardID)
+}
+
+// Unassign mocks base method.
+func (m *MockRepository) Unassign(cardID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unassign", cardID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unassign indicates an expected call of Unassign.
+func (mr *MockRepositoryMockRecorder) Unassign(cardID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockRepository)(nil).Unassign), cardID, userID)
+}
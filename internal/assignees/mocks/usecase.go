@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/assignees/usecase.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	models "github.com/SlavaShagalov/my-trello-backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockUsecase) Assign(cardID, userID int) ([]models.Assignee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", cardID, userID)
+	ret0, _ := ret[0].([]models.Assignee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockUsecaseMockRecorder) Assign(cardID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockUsecase)(nil).Assign), cardID, userID)
+}
+
+// ListByCard mocks base method.
+func (m *MockUsecase) ListByCard(cardID int) ([]models.Assignee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCard", cardID)
+	ret0, _ := ret[0].([]models.Assignee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCard indicates an expected call of ListByCard.
+func (mr *MockUsecaseMockRecorder) ListByCard(cardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCard", reflect.TypeOf((*MockUsecase)(nil).ListByCard), cardID)
+}
+
+// Unassign mocks base method.
+func (m *MockUsecase) Unassign(cardID, userID int) ([]models.Assignee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unassign", cardID, userID)
+	ret0, _ := ret[0].([]models.Assignee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unassign indicates an expected call of Unassign.
+func (mr *MockUsecaseMockRecorder) Unassign(cardID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockUsecase)(nil).Unassign), cardID, userID)
+}
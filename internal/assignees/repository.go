@@ -0,0 +1,11 @@
+package assignees
+
+import "github.com/SlavaShagalov/my-trello-backend/internal/models"
+
+type Repository interface {
+	ListByCard(cardID int) ([]models.Assignee, error)
+	// Assign is idempotent. It fails with ErrAssigneeNotInWorkspace unless
+	// the user is a member of the workspace of the card.
+	Assign(cardID, userID int) error
+	Unassign(cardID, userID int) error
+}
@@ -0,0 +1,135 @@
+package postgres
+
+import (
+	"database/sql"
+	pkgAssignees "github.com/SlavaShagalov/my-trello-backend/internal/assignees"
+	"github.com/SlavaShagalov/my-trello-backend/internal/images"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+type repository struct {
+	db  *sql.DB
+	log *zap.Logger
+}
+
+func New(db *sql.DB, log *zap.Logger) pkgAssignees.Repository {
+	return &repository{db: db, log: log}
+}
+
+const listByCardCmd = `
+	SELECT u.id, u.username, u.name, u.avatar, a.created_at
+	FROM card_assignees a
+	JOIN users u on u.id = a.user_id
+	WHERE a.card_id = $1
+	ORDER BY a.created_at, u.id;`
+
+func (repo *repository) ListByCard(cardID int) ([]models.Assignee, error) {
+	rows, err := repo.db.Query(listByCardCmd, cardID)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listByCardCmd),
+			zap.Int("card_id", cardID))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	assignees := []models.Assignee{}
+	for rows.Next() {
+		var assignee models.Assignee
+		err = rows.Scan(
+			&assignee.UserID,
+			&assignee.Username,
+			&assignee.Name,
+			&assignee.Avatar,
+			&assignee.AssignedAt,
+		)
+		if err != nil {
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", listByCardCmd),
+				zap.Int("card_id", cardID))
+			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+		assignee.Avatar = images.OptionalURL(assignee.Avatar)
+
+		assignees = append(assignees, assignee)
+	}
+
+	return assignees, nil
+}
+
+// assignCmd inserts nothing unless the user is a member of the workspace of the card.
+const assignCmd = `
+	INSERT INTO card_assignees (card_id, user_id)
+	SELECT c.id, m.user_id
+	FROM cards c
+	JOIN lists l on l.id = c.list_id
+	JOIN boards b on b.id = l.board_id
+	JOIN workspace_members m on m.workspace_id = b.workspace_id
+	WHERE c.id = $1 AND m.user_id = $2
+	ON CONFLICT DO NOTHING;`
+
+const isAssignedCmd = `
+	SELECT EXISTS(SELECT 1 FROM card_assignees WHERE card_id = $1 AND user_id = $2);`
+
+func (repo *repository) Assign(cardID, userID int) error {
+	result, err := repo.db.Exec(assignCmd, cardID, userID)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", assignCmd),
+			zap.Int("card_id", cardID), zap.Int("user_id", userID))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", assignCmd),
+			zap.Int("card_id", cardID), zap.Int("user_id", userID))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	if rowsAffected > 0 {
+		repo.log.Debug("User assigned", zap.Int("card_id", cardID), zap.Int("user_id", userID))
+		return nil
+	}
+
+	var assigned bool
+	err = repo.db.QueryRow(isAssignedCmd, cardID, userID).Scan(&assigned)
+	if err != nil {
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", isAssignedCmd),
+			zap.Int("card_id", cardID), zap.Int("user_id", userID))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	if !assigned {
+		return pkgErrors.ErrAssigneeNotInWorkspace
+	}
+
+	return nil
+}
+
+const unassignCmd = `
+	DELETE FROM card_assignees
+	WHERE card_id = $1 AND user_id = $2;`
+
+func (repo *repository) Unassign(cardID, userID int) error {
+	result, err := repo.db.Exec(unassignCmd, cardID, userID)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", unassignCmd),
+			zap.Int("card_id", cardID), zap.Int("user_id", userID))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", unassignCmd),
+			zap.Int("card_id", cardID), zap.Int("user_id", userID))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	if rowsAffected == 0 {
+		return pkgErrors.ErrAssigneeNotFound
+	}
+
+	repo.log.Debug("User unassigned", zap.Int("card_id", cardID), zap.Int("user_id", userID))
+	return nil
+}
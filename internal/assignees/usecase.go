@@ -0,0 +1,10 @@
+package assignees
+
+import "github.com/SlavaShagalov/my-trello-backend/internal/models"
+
+type Usecase interface {
+	ListByCard(cardID int) ([]models.Assignee, error)
+	// Assign and Unassign return the assignees of the card after the change.
+	Assign(cardID, userID int) ([]models.Assignee, error)
+	Unassign(cardID, userID int) ([]models.Assignee, error)
+}
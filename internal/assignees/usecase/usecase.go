@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/assignees"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+)
+
+type usecase struct {
+	repo assignees.Repository
+}
+
+func New(repo assignees.Repository) assignees.Usecase {
+	return &usecase{repo: repo}
+}
+
+func (uc *usecase) ListByCard(cardID int) ([]models.Assignee, error) {
+	return uc.repo.ListByCard(cardID)
+}
+
+func (uc *usecase) Assign(cardID, userID int) ([]models.Assignee, error) {
+	err := uc.repo.Assign(cardID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.ListByCard(cardID)
+}
+
+func (uc *usecase) Unassign(cardID, userID int) ([]models.Assignee, error) {
+	err := uc.repo.Unassign(cardID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.ListByCard(cardID)
+}
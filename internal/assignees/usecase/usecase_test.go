@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/assignees/mocks"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestUsecase_Assign(t *testing.T) {
+	type testCase struct {
+		prepare   func(repo *mocks.MockRepository, assignees []models.Assignee)
+		userID    int
+		assignees []models.Assignee
+		err       error
+	}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(repo *mocks.MockRepository, assignees []models.Assignee) {
+				repo.EXPECT().Assign(1, 4).Return(nil)
+				repo.EXPECT().ListByCard(1).Return(assignees, nil)
+			},
+			userID:    4,
+			assignees: []models.Assignee{{UserID: 4, Username: "evgenii"}},
+			err:       nil,
+		},
+		"not in workspace": {
+			prepare: func(repo *mocks.MockRepository, assignees []models.Assignee) {
+				repo.EXPECT().Assign(1, 2).Return(pkgErrors.ErrAssigneeNotInWorkspace)
+			},
+			userID:    2,
+			assignees: nil,
+			err:       pkgErrors.ErrAssigneeNotInWorkspace,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockRepository(ctrl)
+			test.prepare(repo, test.assignees)
+
+			uc := New(repo)
+			assignees, err := uc.Assign(1, test.userID)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			assert.Equal(t, test.assignees, assignees)
+		})
+	}
+}
+
+func TestUsecase_Unassign(t *testing.T) {
+	type testCase struct {
+		prepare   func(repo *mocks.MockRepository, assignees []models.Assignee)
+		assignees []models.Assignee
+		err       error
+	}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(repo *mocks.MockRepository, assignees []models.Assignee) {
+				repo.EXPECT().Unassign(1, 4).Return(nil)
+				repo.EXPECT().ListByCard(1).Return(assignees, nil)
+			},
+			assignees: []models.Assignee{},
+			err:       nil,
+		},
+		"not assigned": {
+			prepare: func(repo *mocks.MockRepository, assignees []models.Assignee) {
+				repo.EXPECT().Unassign(1, 4).Return(pkgErrors.ErrAssigneeNotFound)
+			},
+			assignees: nil,
+			err:       pkgErrors.ErrAssigneeNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockRepository(ctrl)
+			test.prepare(repo, test.assignees)
+
+			uc := New(repo)
+			assignees, err := uc.Unassign(1, 4)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			assert.Equal(t, test.assignees, assignees)
+		})
+	}
+}
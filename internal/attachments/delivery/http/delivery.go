@@ -0,0 +1,308 @@
+package http
+
+import (
+	pAccess "github.com/SlavaShagalov/my-trello-backend/internal/access"
+	pAttachments "github.com/SlavaShagalov/my-trello-backend/internal/attachments"
+	mw "github.com/SlavaShagalov/my-trello-backend/internal/middleware"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pHTTP "github.com/SlavaShagalov/my-trello-backend/internal/pkg/http"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+)
+
+type delivery struct {
+	uc       pAttachments.Usecase
+	accessUC pAccess.Usecase
+	log      *zap.Logger
+}
+
+func RegisterHandlers(mux *mux.Router, uc pAttachments.Usecase, accessUC pAccess.Usecase, log *zap.Logger,
+	checkAuth mw.Middleware, metrics mw.Middleware) {
+	del := delivery{
+		uc:       uc,
+		accessUC: accessUC,
+		log:      log,
+	}
+
+	const (
+		cardAttachmentsPrefix  = "/cards/{id}/attachments"
+		cardAttachmentsPath    = constants.ApiPrefix + cardAttachmentsPrefix
+		attachmentPath         = cardAttachmentsPath + "/{attachment_id}"
+		attachmentDownloadPath = attachmentPath + "/download"
+	)
+
+	mux.HandleFunc(cardAttachmentsPath, metrics(checkAuth(del.upload))).Methods(http.MethodPost)
+	mux.HandleFunc(cardAttachmentsPath, metrics(checkAuth(del.listByCard))).Methods(http.MethodGet)
+	mux.HandleFunc(attachmentPath, metrics(checkAuth(del.delete))).Methods(http.MethodDelete)
+	mux.HandleFunc(attachmentDownloadPath, metrics(checkAuth(del.download))).Methods(http.MethodGet)
+}
+
+// upload godoc
+//
+//	@Summary		Upload attachment
+//	@Description	Upload a file of any type to the card
+//	@Tags			cards
+//	@Accept			mpfd
+//	@Produce		json
+//	@Param			id		path		int					true	"Card ID"
+//	@Param			file	formData	file				true	"File"
+//	@Success		200		{object}	models.Attachment	"Attachment data"
+//	@Failure		400		{object}	http.JSONError
+//	@Failure		401		{object}	http.JSONError
+//	@Failure		403		{object}	http.JSONError
+//	@Failure		404		{object}	http.JSONError
+//	@Failure		405
+//	@Failure		413		{object}	http.JSONError
+//	@Failure		500
+//	@Router			/cards/{id}/attachments [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) upload(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	maxBodySize := viper.GetInt64(config.MaxAttachmentSize) + constants.MultipartOverhead
+	if r.ContentLength > maxBodySize {
+		pHTTP.HandleError(w, r, pErrors.ErrTooLargeAttachment)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodySize)
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			pHTTP.HandleError(w, r, pErrors.ErrTooLargeAttachment)
+			return
+		}
+		pHTTP.HandleError(w, r, errors.Wrap(pErrors.ErrReadBody, err.Error()))
+		return
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		pHTTP.HandleError(w, r, errors.Wrap(pErrors.ErrReadBody, err.Error()))
+		return
+	}
+
+	params := pAttachments.UploadParams{
+		CardID:      cardID,
+		UploaderID:  userID,
+		Filename:    header.Filename,
+		ContentType: header.Header.Get("Content-Type"),
+		Data:        data,
+	}
+
+	attachment, err := del.uc.Upload(&params)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	pHTTP.SendJSON(w, r, http.StatusOK, &attachment)
+}
+
+// listByCard godoc
+//
+//	@Summary		Returns attachments of card
+//	@Description	Returns attachments of card
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id	path		int				true	"Card ID"
+//	@Success		200	{object}	listResponse	"Attachments data"
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/attachments [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) listByCard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	attachments, err := del.uc.ListByCard(cardID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newListResponse(attachments)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// download godoc
+//
+//	@Summary		Download attachment
+//	@Description	Sends the stored file as a download
+//	@Tags			cards
+//	@Produce		octet-stream
+//	@Param			id				path	int	true	"Card ID"
+//	@Param			attachment_id	path	int	true	"Attachment ID"
+//	@Success		200				"Content of the file"
+//	@Failure		400				{object}	http.JSONError
+//	@Failure		401				{object}	http.JSONError
+//	@Failure		403				{object}	http.JSONError
+//	@Failure		404				{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/attachments/{attachment_id}/download [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) download(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	attachmentID, err := strconv.Atoi(vars["attachment_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	attachment, data, err := del.uc.Download(cardID, attachmentID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	// Uploads are never rendered by the browser: they are saved as files, with
+	// the content type of the client only if it is one of the harmless ones.
+	w.Header().Set("Content-Type", safeContentType(attachment.ContentType))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment",
+		map[string]string{"filename": attachment.Filename}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "private")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// safeContentTypes are served as uploaded, anything else as binary data.
+var safeContentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/gif":       true,
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/webp":      true,
+	"text/plain":      true,
+}
+
+func safeContentType(contentType string) string {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || !safeContentTypes[mediaType] {
+		return "application/octet-stream"
+	}
+	return mime.FormatMediaType(mediaType, params)
+}
+
+// delete godoc
+//
+//	@Summary		Delete attachment
+//	@Description	Delete attachment and its file
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id				path	int	true	"Card ID"
+//	@Param			attachment_id	path	int	true	"Attachment ID"
+//	@Success		204				"Attachment deleted successfully"
+//	@Failure		400				{object}	http.JSONError
+//	@Failure		401				{object}	http.JSONError
+//	@Failure		403				{object}	http.JSONError
+//	@Failure		404				{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/attachments/{attachment_id} [delete]
+//
+//	@Security		cookieAuth
+func (del *delivery) delete(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	attachmentID, err := strconv.Atoi(vars["attachment_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	err = del.uc.Delete(cardID, attachmentID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
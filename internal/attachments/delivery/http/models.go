@@ -0,0 +1,18 @@
+package http
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+)
+
+//go:generate easyjson -all -snake_case models.go
+
+// API responses
+type listResponse struct {
+	Attachments []models.Attachment `json:"attachments"`
+}
+
+func newListResponse(attachments []models.Attachment) *listResponse {
+	return &listResponse{
+		Attachments: attachments,
+	}
+}
@@ -0,0 +1,20 @@
+package attachments
+
+import "github.com/SlavaShagalov/my-trello-backend/internal/models"
+
+type CreateParams struct {
+	CardID      int
+	UploaderID  int
+	Filename    string
+	Size        int64
+	ContentType string
+	Key         string
+}
+
+// Repository keeps attachment metadata. The files themselves are in images.Repository.
+type Repository interface {
+	Create(params *CreateParams) (models.Attachment, error)
+	ListByCard(cardID int) ([]models.Attachment, error)
+	Get(id int) (models.Attachment, error)
+	Delete(id int) error
+}
@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"database/sql"
+	pkgAttachments "github.com/SlavaShagalov/my-trello-backend/internal/attachments"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+type repository struct {
+	db  *sql.DB
+	log *zap.Logger
+}
+
+func New(db *sql.DB, log *zap.Logger) pkgAttachments.Repository {
+	return &repository{db: db, log: log}
+}
+
+const createCmd = `
+	INSERT INTO attachments (card_id, uploader_id, filename, size, content_type, object_key)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING id, card_id, uploader_id, filename, size, content_type, object_key, created_at;`
+
+func (repo *repository) Create(params *pkgAttachments.CreateParams) (models.Attachment, error) {
+	row := repo.db.QueryRow(createCmd, params.CardID, params.UploaderID, params.Filename, params.Size,
+		params.ContentType, params.Key)
+
+	var attachment models.Attachment
+	err := scanAttachment(row, &attachment)
+	if err != nil {
+		pgErr, ok := err.(*pq.Error)
+		if !ok {
+			repo.log.Error("Cannot convert err to pq.Error", zap.Error(err))
+			return models.Attachment{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+		if pgErr.Constraint == "attachments_card_id_fkey" {
+			return models.Attachment{}, errors.Wrap(pkgErrors.ErrCardNotFound, err.Error())
+		}
+		if pgErr.Constraint == "attachments_uploader_id_fkey" {
+			return models.Attachment{}, errors.Wrap(pkgErrors.ErrUserNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", createCmd),
+			zap.Any("create_params", params))
+		return models.Attachment{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("New attachment created", zap.Any("attachment", attachment))
+	return attachment, nil
+}
+
+const listByCardCmd = `
+	SELECT id, card_id, uploader_id, filename, size, content_type, object_key, created_at
+	FROM attachments
+	WHERE card_id = $1
+	ORDER BY id;`
+
+func (repo *repository) ListByCard(cardID int) ([]models.Attachment, error) {
+	rows, err := repo.db.Query(listByCardCmd, cardID)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listByCardCmd),
+			zap.Int("card_id", cardID))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	attachments := []models.Attachment{}
+	for rows.Next() {
+		var attachment models.Attachment
+		err = scanAttachment(rows, &attachment)
+		if err != nil {
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", listByCardCmd),
+				zap.Int("card_id", cardID))
+			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+
+		attachments = append(attachments, attachment)
+	}
+
+	return attachments, nil
+}
+
+const getCmd = `
+	SELECT id, card_id, uploader_id, filename, size, content_type, object_key, created_at
+	FROM attachments
+	WHERE id = $1;`
+
+func (repo *repository) Get(id int) (models.Attachment, error) {
+	row := repo.db.QueryRow(getCmd, id)
+
+	var attachment models.Attachment
+	err := scanAttachment(row, &attachment)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Attachment{}, errors.Wrap(pkgErrors.ErrAttachmentNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", getCmd), zap.Int("id", id))
+		return models.Attachment{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	return attachment, nil
+}
+
+const deleteCmd = `
+	DELETE FROM attachments
+	WHERE id = $1;`
+
+func (repo *repository) Delete(id int) error {
+	result, err := repo.db.Exec(deleteCmd, id)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", deleteCmd), zap.Int("id", id))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", deleteCmd), zap.Int("id", id))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	if rowsAffected == 0 {
+		return pkgErrors.ErrAttachmentNotFound
+	}
+
+	repo.log.Debug("Attachment deleted", zap.Int("id", id))
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAttachment(row scanner, attachment *models.Attachment) error {
+	var uploaderID sql.NullInt64
+	err := row.Scan(
+		&attachment.ID,
+		&attachment.CardID,
+		&uploaderID,
+		&attachment.Filename,
+		&attachment.Size,
+		&attachment.ContentType,
+		&attachment.Key,
+		&attachment.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if uploaderID.Valid {
+		id := int(uploaderID.Int64)
+		attachment.UploaderID = &id
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package attachments
+
+import "github.com/SlavaShagalov/my-trello-backend/internal/models"
+
+type UploadParams struct {
+	CardID      int
+	UploaderID  int
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+type Usecase interface {
+	Upload(params *UploadParams) (models.Attachment, error)
+	ListByCard(cardID int) ([]models.Attachment, error)
+	// Get and Delete fail with ErrAttachmentNotFound unless the attachment
+	// belongs to cardID.
+	Get(cardID, id int) (models.Attachment, error)
+	// Download returns the attachment with the content of its file.
+	Download(cardID, id int) (models.Attachment, []byte, error)
+	// Delete removes the stored file along with the metadata.
+	Delete(cardID, id int) error
+}
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/attachments"
+	"github.com/SlavaShagalov/my-trello-backend/internal/images"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	attachmentsFolder = "attachments"
+
+	defaultFilename = "file"
+
+	storedContentType = "application/octet-stream"
+)
+
+type usecase struct {
+	repo    attachments.Repository
+	imgRepo images.Repository
+}
+
+func New(repo attachments.Repository, imgRepo images.Repository) attachments.Usecase {
+	return &usecase{
+		repo:    repo,
+		imgRepo: imgRepo,
+	}
+}
+
+func (uc *usecase) Upload(params *attachments.UploadParams) (models.Attachment, error) {
+	filename, err := validateFilename(params.Filename)
+	if err != nil {
+		return models.Attachment{}, err
+	}
+
+	size := int64(len(params.Data))
+	if size == 0 {
+		return models.Attachment{}, pkgErrors.ErrEmptyAttachment
+	}
+	if size > viper.GetInt64(config.MaxAttachmentSize) {
+		return models.Attachment{}, pkgErrors.ErrTooLargeAttachment
+	}
+
+	contentType := params.ContentType
+	if contentType == "" {
+		contentType = http.DetectContentType(params.Data)
+	}
+
+	// Files are stored without the extension and the content type of the client,
+	// so that storages serving them never render them. Downloads go through
+	// the API, the content type is kept with the metadata.
+	key := attachmentsFolder + "/" + strconv.Itoa(params.CardID) + "/" + uuid.NewString()
+	err = uc.imgRepo.Create(key, params.Data, storedContentType)
+	if err != nil {
+		return models.Attachment{}, err
+	}
+
+	attachment, err := uc.repo.Create(&attachments.CreateParams{
+		CardID:      params.CardID,
+		UploaderID:  params.UploaderID,
+		Filename:    filename,
+		Size:        size,
+		ContentType: contentType,
+		Key:         key,
+	})
+	if err != nil {
+		// Nothing refers to the file any more.
+		_ = uc.imgRepo.Delete(key)
+		return models.Attachment{}, err
+	}
+
+	return attachment, nil
+}
+
+func (uc *usecase) ListByCard(cardID int) ([]models.Attachment, error) {
+	return uc.repo.ListByCard(cardID)
+}
+
+func (uc *usecase) Get(cardID, id int) (models.Attachment, error) {
+	attachment, err := uc.repo.Get(id)
+	if err != nil {
+		return models.Attachment{}, err
+	}
+	if attachment.CardID != cardID {
+		return models.Attachment{}, pkgErrors.ErrAttachmentNotFound
+	}
+	return attachment, nil
+}
+
+func (uc *usecase) Download(cardID, id int) (models.Attachment, []byte, error) {
+	attachment, err := uc.Get(cardID, id)
+	if err != nil {
+		return models.Attachment{}, nil, err
+	}
+
+	data, err := uc.imgRepo.Get(attachment.Key)
+	if err != nil {
+		return models.Attachment{}, nil, err
+	}
+	return attachment, data, nil
+}
+
+func (uc *usecase) Delete(cardID, id int) error {
+	attachment, err := uc.Get(cardID, id)
+	if err != nil {
+		return err
+	}
+
+	// The file goes first: deleting it again is harmless, so a failed
+	// deletion can be retried.
+	err = uc.imgRepo.Delete(attachment.Key)
+	if err != nil {
+		return err
+	}
+
+	return uc.repo.Delete(id)
+}
+
+// validateFilename drops directories from the client supplied name.
+func validateFilename(filename string) (string, error) {
+	filename = strings.TrimSpace(filepath.Base(strings.ReplaceAll(filename, "\\", "/")))
+	if filename == "" || filename == "." || filename == "/" {
+		return defaultFilename, nil
+	}
+	if utf8.RuneCountInString(filename) > constants.MaxAttachmentNameLen {
+		return "", pkgErrors.ErrTooLongAttachmentName
+	}
+	return filename, nil
+}
@@ -0,0 +1,240 @@
+package usecase
+
+import (
+	pkgAttachments "github.com/SlavaShagalov/my-trello-backend/internal/attachments"
+	"github.com/SlavaShagalov/my-trello-backend/internal/attachments/mocks"
+	imgMocks "github.com/SlavaShagalov/my-trello-backend/internal/images/mocks"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestUsecase_Upload(t *testing.T) {
+	config.SetDefaultValidationConfig()
+
+	type fields struct {
+		repo    *mocks.MockRepository
+		imgRepo *imgMocks.MockRepository
+		key     string
+	}
+
+	type testCase struct {
+		prepare    func(f *fields)
+		params     *pkgAttachments.UploadParams
+		attachment models.Attachment
+		err        error
+	}
+
+	// Whatever the client sends, the file is stored as binary data without an extension.
+	stored := func(f *fields) {
+		f.imgRepo.EXPECT().Create(gomock.Any(), gomock.Any(), "application/octet-stream").
+			DoAndReturn(func(key string, data []byte, contentType string) error {
+				assert.True(t, strings.HasPrefix(key, "attachments/21/"), key)
+				assert.NotContains(t, key, ".")
+				f.key = key
+				return nil
+			})
+	}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(f *fields) {
+				stored(f)
+				f.repo.EXPECT().Create(gomock.Any()).
+					DoAndReturn(func(params *pkgAttachments.CreateParams) (models.Attachment, error) {
+						assert.Equal(t, &pkgAttachments.CreateParams{CardID: 21, UploaderID: 1, Filename: "report.PDF",
+							Size: 4, ContentType: "application/pdf", Key: f.key}, params)
+						return models.Attachment{ID: 1, CardID: 21, Filename: "report.PDF"}, nil
+					})
+			},
+			params: &pkgAttachments.UploadParams{CardID: 21, UploaderID: 1, Filename: "../../report.PDF",
+				ContentType: "application/pdf", Data: []byte("%PDF")},
+			attachment: models.Attachment{ID: 1, CardID: 21, Filename: "report.PDF"},
+			err:        nil,
+		},
+		"sniffed content type": {
+			prepare: func(f *fields) {
+				stored(f)
+				f.repo.EXPECT().Create(gomock.Any()).
+					DoAndReturn(func(params *pkgAttachments.CreateParams) (models.Attachment, error) {
+						assert.Equal(t, "text/plain; charset=utf-8", params.ContentType)
+						return models.Attachment{ID: 1}, nil
+					})
+			},
+			params:     &pkgAttachments.UploadParams{CardID: 21, UploaderID: 1, Filename: "notes.pdf", Data: []byte("text")},
+			attachment: models.Attachment{ID: 1},
+			err:        nil,
+		},
+		"empty": {
+			params:     &pkgAttachments.UploadParams{CardID: 21, UploaderID: 1, Filename: "report.pdf"},
+			attachment: models.Attachment{},
+			err:        pkgErrors.ErrEmptyAttachment,
+		},
+		"too large": {
+			params: &pkgAttachments.UploadParams{CardID: 21, UploaderID: 1, Filename: "report.pdf",
+				Data: make([]byte, 10<<20+1)},
+			attachment: models.Attachment{},
+			err:        pkgErrors.ErrTooLargeAttachment,
+		},
+		"card deleted meanwhile": {
+			prepare: func(f *fields) {
+				stored(f)
+				f.repo.EXPECT().Create(gomock.Any()).Return(models.Attachment{}, pkgErrors.ErrCardNotFound)
+				f.imgRepo.EXPECT().Delete(gomock.Any()).DoAndReturn(func(key string) error {
+					assert.Equal(t, f.key, key)
+					return nil
+				})
+			},
+			params: &pkgAttachments.UploadParams{CardID: 21, UploaderID: 1, Filename: "report.pdf",
+				ContentType: "application/pdf", Data: []byte("%PDF")},
+			attachment: models.Attachment{},
+			err:        pkgErrors.ErrCardNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), imgRepo: imgMocks.NewMockRepository(ctrl)}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo, f.imgRepo)
+			attachment, err := uc.Upload(test.params)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			assert.Equal(t, test.attachment, attachment)
+		})
+	}
+}
+
+func TestUsecase_Download(t *testing.T) {
+	const key = "attachments/21/3f2c"
+
+	type fields struct {
+		repo    *mocks.MockRepository
+		imgRepo *imgMocks.MockRepository
+	}
+
+	type testCase struct {
+		prepare    func(f *fields)
+		cardID     int
+		attachment models.Attachment
+		data       []byte
+		err        error
+	}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(3).Return(models.Attachment{ID: 3, CardID: 21, Key: key}, nil)
+				f.imgRepo.EXPECT().Get(key).Return([]byte("%PDF"), nil)
+			},
+			cardID:     21,
+			attachment: models.Attachment{ID: 3, CardID: 21, Key: key},
+			data:       []byte("%PDF"),
+			err:        nil,
+		},
+		"another card": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(3).Return(models.Attachment{ID: 3, CardID: 22, Key: key}, nil)
+			},
+			cardID:     21,
+			attachment: models.Attachment{},
+			data:       nil,
+			err:        pkgErrors.ErrAttachmentNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), imgRepo: imgMocks.NewMockRepository(ctrl)}
+			test.prepare(&f)
+
+			uc := New(f.repo, f.imgRepo)
+			attachment, data, err := uc.Download(test.cardID, 3)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			assert.Equal(t, test.attachment, attachment)
+			assert.Equal(t, test.data, data)
+		})
+	}
+}
+
+func TestUsecase_Delete(t *testing.T) {
+	const key = "attachments/21/3f2c"
+
+	type fields struct {
+		repo    *mocks.MockRepository
+		imgRepo *imgMocks.MockRepository
+	}
+
+	type testCase struct {
+		prepare func(f *fields)
+		cardID  int
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(3).Return(models.Attachment{ID: 3, CardID: 21, Key: key}, nil)
+				f.imgRepo.EXPECT().Delete(key).Return(nil)
+				f.repo.EXPECT().Delete(3).Return(nil)
+			},
+			cardID: 21,
+			err:    nil,
+		},
+		"another card": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(3).Return(models.Attachment{ID: 3, CardID: 22, Key: key}, nil)
+			},
+			cardID: 21,
+			err:    pkgErrors.ErrAttachmentNotFound,
+		},
+		"storage failure": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(3).Return(models.Attachment{ID: 3, CardID: 21, Key: key}, nil)
+				f.imgRepo.EXPECT().Delete(key).Return(pkgErrors.ErrDb)
+			},
+			cardID: 21,
+			err:    pkgErrors.ErrDb,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), imgRepo: imgMocks.NewMockRepository(ctrl)}
+			test.prepare(&f)
+
+			uc := New(f.repo, f.imgRepo)
+			err := uc.Delete(test.cardID, 3)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+		})
+	}
+}
@@ -24,67 +24,73 @@ type SignInRequest struct {
 // API responses
 
 type SignInResponse struct {
-	ID        int       `json:"id"`
-	Username  string    `json:"username"`
-	Email     string    `json:"email"`
-	Name      string    `json:"name"`
-	Avatar    *string   `json:"avatar"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID               int               `json:"id"`
+	Username         string            `json:"username"`
+	Email            string            `json:"email"`
+	Name             string            `json:"name"`
+	Avatar           *string           `json:"avatar"`
+	AvatarThumbnails map[string]string `json:"avatar_thumbnails"`
+	CreatedAt        time.Time         `json:"created_at"`
+	UpdatedAt        time.Time         `json:"updated_at"`
 }
 
 func newSignInResponse(user *models.User) *SignInResponse {
 	return &SignInResponse{
-		ID:        user.ID,
-		Username:  user.Username,
-		Email:     user.Email,
-		Name:      user.Name,
-		Avatar:    user.Avatar,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		ID:               user.ID,
+		Username:         user.Username,
+		Email:            user.Email,
+		Name:             user.Name,
+		Avatar:           user.Avatar,
+		AvatarThumbnails: user.AvatarThumbnails,
+		CreatedAt:        user.CreatedAt,
+		UpdatedAt:        user.UpdatedAt,
 	}
 }
 
 type SignUpResponse struct {
-	ID        int       `json:"id"`
-	Username  string    `json:"username"`
-	Email     string    `json:"email"`
-	Name      string    `json:"name"`
-	Avatar    *string   `json:"avatar"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID               int               `json:"id"`
+	Username         string            `json:"username"`
+	Email            string            `json:"email"`
+	Name             string            `json:"name"`
+	Avatar           *string           `json:"avatar"`
+	AvatarThumbnails map[string]string `json:"avatar_thumbnails"`
+	CreatedAt        time.Time         `json:"created_at"`
+	UpdatedAt        time.Time         `json:"updated_at"`
 }
 
 func newSignUpResponse(user *models.User) *SignUpResponse {
 	return &SignUpResponse{
-		ID:        user.ID,
-		Username:  user.Username,
-		Email:     user.Email,
-		Name:      user.Name,
-		Avatar:    user.Avatar,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		ID:               user.ID,
+		Username:         user.Username,
+		Email:            user.Email,
+		Name:             user.Name,
+		Avatar:           user.Avatar,
+		AvatarThumbnails: user.AvatarThumbnails,
+		CreatedAt:        user.CreatedAt,
+		UpdatedAt:        user.UpdatedAt,
 	}
 }
 
 type getResponse struct {
-	ID        int       `json:"id"`
-	Username  string    `json:"username"`
-	Email     string    `json:"email"`
-	Name      string    `json:"name"`
-	Avatar    *string   `json:"avatar"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID               int               `json:"id"`
+	Username         string            `json:"username"`
+	Email            string            `json:"email"`
+	Name             string            `json:"name"`
+	Avatar           *string           `json:"avatar"`
+	AvatarThumbnails map[string]string `json:"avatar_thumbnails"`
+	CreatedAt        time.Time         `json:"created_at"`
+	UpdatedAt        time.Time         `json:"updated_at"`
 }
 
 func newGetResponse(user *models.User) *getResponse {
 	return &getResponse{
-		ID:        user.ID,
-		Username:  user.Username,
-		Email:     user.Email,
-		Name:      user.Name,
-		Avatar:    user.Avatar,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		ID:               user.ID,
+		Username:         user.Username,
+		Email:            user.Email,
+		Name:             user.Name,
+		Avatar:           user.Avatar,
+		AvatarThumbnails: user.AvatarThumbnails,
+		CreatedAt:        user.CreatedAt,
+		UpdatedAt:        user.UpdatedAt,
 	}
 }
@@ -54,6 +54,22 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalAuthDeli
 				}
 				*out.Avatar = string(in.String())
 			}
+		case "avatar_thumbnails":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.AvatarThumbnails = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v1 string
+					v1 = string(in.String())
+					(out.AvatarThumbnails)[key] = v1
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
@@ -105,6 +121,27 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalAuthDeli
 			out.String(string(*in.Avatar))
 		}
 	}
+	{
+		const prefix string = ",\"avatar_thumbnails\":"
+		out.RawString(prefix)
+		if in.AvatarThumbnails == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v2First := true
+			for v2Name, v2Value := range in.AvatarThumbnails {
+				if v2First {
+					v2First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v2Name))
+				out.RawByte(':')
+				out.String(string(v2Value))
+			}
+			out.RawByte('}')
+		}
+	}
 	{
 		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
@@ -178,6 +215,22 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalAuthDeli
 				}
 				*out.Avatar = string(in.String())
 			}
+		case "avatar_thumbnails":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.AvatarThumbnails = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v3 string
+					v3 = string(in.String())
+					(out.AvatarThumbnails)[key] = v3
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
@@ -229,6 +282,27 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalAuthDeli
 			out.String(string(*in.Avatar))
 		}
 	}
+	{
+		const prefix string = ",\"avatar_thumbnails\":"
+		out.RawString(prefix)
+		if in.AvatarThumbnails == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v4First := true
+			for v4Name, v4Value := range in.AvatarThumbnails {
+				if v4First {
+					v4First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v4Name))
+				out.RawByte(':')
+				out.String(string(v4Value))
+			}
+			out.RawByte('}')
+		}
+	}
 	{
 		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
@@ -389,6 +463,22 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalAuthDeli
 				}
 				*out.Avatar = string(in.String())
 			}
+		case "avatar_thumbnails":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.AvatarThumbnails = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v5 string
+					v5 = string(in.String())
+					(out.AvatarThumbnails)[key] = v5
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
@@ -440,6 +530,27 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalAuthDeli
 			out.String(string(*in.Avatar))
 		}
 	}
+	{
+		const prefix string = ",\"avatar_thumbnails\":"
+		out.RawString(prefix)
+		if in.AvatarThumbnails == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v6First := true
+			for v6Name, v6Value := range in.AvatarThumbnails {
+				if v6First {
+					v6First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v6Name))
+				out.RawByte(':')
+				out.String(string(v6Value))
+			}
+			out.RawByte('}')
+		}
+	}
 	{
 		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
@@ -7,6 +7,7 @@ import (
 	pkgZap "github.com/SlavaShagalov/my-trello-backend/internal/pkg/log/zap"
 	"github.com/SlavaShagalov/my-trello-backend/internal/users"
 	"github.com/pkg/errors"
+	"reflect"
 
 	hasherMocks "github.com/SlavaShagalov/my-trello-backend/internal/pkg/hasher/mocks"
 	sessionsMocks "github.com/SlavaShagalov/my-trello-backend/internal/sessions/mocks"
@@ -85,7 +86,7 @@ func TestUsecase_SignIn(t *testing.T) {
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if user != test.user {
+			if !reflect.DeepEqual(user, test.user) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.user, user)
 			}
 			if authToken != test.authToken {
@@ -173,7 +174,7 @@ func TestUsecase_SignUp(t *testing.T) {
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if user != test.user {
+			if !reflect.DeepEqual(user, test.user) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.user, user)
 			}
 			if authToken != test.authToken {
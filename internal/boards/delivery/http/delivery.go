@@ -2,13 +2,20 @@ package http
 
 import (
 	"bytes"
+	"context"
+	"fmt"
+	pAccess "github.com/SlavaShagalov/my-trello-backend/internal/access"
 	pBoards "github.com/SlavaShagalov/my-trello-backend/internal/boards"
 	mw "github.com/SlavaShagalov/my-trello-backend/internal/middleware"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
 	pErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
 	pHTTP "github.com/SlavaShagalov/my-trello-backend/internal/pkg/http"
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/opentel"
 	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
 	"go.uber.org/zap"
 	"io"
 	"net/http"
@@ -16,14 +23,17 @@ import (
 )
 
 type delivery struct {
-	uc  pBoards.Usecase
-	log *zap.Logger
+	uc       pBoards.Usecase
+	accessUC pAccess.Usecase
+	log      *zap.Logger
 }
 
-func RegisterHandlers(mux *mux.Router, uc pBoards.Usecase, log *zap.Logger, checkAuth mw.Middleware, metrics mw.Middleware) {
+func RegisterHandlers(mux *mux.Router, uc pBoards.Usecase, accessUC pAccess.Usecase, log *zap.Logger,
+	checkAuth mw.Middleware, metrics mw.Middleware) {
 	del := delivery{
-		uc:  uc,
-		log: log,
+		uc:       uc,
+		accessUC: accessUC,
+		log:      log,
 	}
 
 	const (
@@ -34,7 +44,17 @@ func RegisterHandlers(mux *mux.Router, uc pBoards.Usecase, log *zap.Logger, chec
 		boardsPath   = constants.ApiPrefix + boardsPrefix
 		boardPath    = boardsPath + "/{id}"
 
-		backgroundPath = boardPath + "/background"
+		backgroundPath  = boardPath + "/background"
+		backgroundsPath = boardsPath + "/backgrounds"
+		archivePath     = boardPath + "/archive"
+		unarchivePath   = boardPath + "/unarchive"
+		copyPath        = boardPath + "/copy"
+		fullPath        = boardPath + "/full"
+		exportPath      = boardPath + "/export"
+
+		templatesPrefix = "/templates"
+		templatesPath   = constants.ApiPrefix + templatesPrefix
+		instantiatePath = templatesPath + "/{id}/instantiate"
 	)
 
 	mux.HandleFunc(workspaceBoardsPath, metrics(checkAuth(del.create))).Methods(http.MethodPost)
@@ -43,10 +63,21 @@ func RegisterHandlers(mux *mux.Router, uc pBoards.Usecase, log *zap.Logger, chec
 	mux.HandleFunc(boardsPath, metrics(checkAuth(del.list))).Methods(http.MethodGet).
 		Queries("title", "{title}")
 
+	// Before boardPath, which would take "backgrounds" for an id.
+	mux.HandleFunc(backgroundsPath, metrics(checkAuth(del.listBackgrounds))).Methods(http.MethodGet)
+
 	mux.HandleFunc(boardPath, metrics(checkAuth(del.get))).Methods(http.MethodGet)
+	mux.HandleFunc(fullPath, metrics(checkAuth(del.getFull))).Methods(http.MethodGet)
+	mux.HandleFunc(exportPath, metrics(checkAuth(del.export))).Methods(http.MethodGet)
 	mux.HandleFunc(boardPath, metrics(checkAuth(del.partialUpdate))).Methods(http.MethodPatch)
 	mux.HandleFunc(backgroundPath, metrics(checkAuth(del.updateBackground))).Methods(http.MethodPut)
 	mux.HandleFunc(boardPath, metrics(checkAuth(del.delete))).Methods(http.MethodDelete)
+	mux.HandleFunc(archivePath, metrics(checkAuth(del.archive))).Methods(http.MethodPost)
+	mux.HandleFunc(unarchivePath, metrics(checkAuth(del.unarchive))).Methods(http.MethodPost)
+	mux.HandleFunc(copyPath, metrics(checkAuth(del.copy))).Methods(http.MethodPost)
+
+	mux.HandleFunc(templatesPath, metrics(checkAuth(del.listTemplates))).Methods(http.MethodGet)
+	mux.HandleFunc(instantiatePath, metrics(checkAuth(del.instantiate))).Methods(http.MethodPost)
 }
 
 // create godoc
@@ -61,6 +92,7 @@ func RegisterHandlers(mux *mux.Router, uc pBoards.Usecase, log *zap.Logger, chec
 //	@Success		200				{object}	createResponse	"Created board data."
 //	@Failure		400				{object}	http.JSONError
 //	@Failure		401				{object}	http.JSONError
+//	@Failure		403				{object}	http.JSONError
 //	@Failure		405
 //	@Failure		500
 //	@Router			/workspaces/{id}/boards [post]
@@ -77,6 +109,18 @@ func (del *delivery) create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckWorkspace(userID, workspaceID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
 	body, err := pHTTP.ReadBody(r, del.log)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
@@ -109,13 +153,16 @@ func (del *delivery) create(w http.ResponseWriter, r *http.Request) {
 // listByWorkspace godoc
 //
 //	@Summary		Returns boards by workspace id
-//	@Description	Returns boards by workspace id
+//	@Description	Returns active boards by workspace id. Archived boards are returned instead with archived=true,
+//	@Description	the last archived first.
 //	@Tags			workspaces
 //	@Produce		json
-//	@Param			id	path		int				true	"Workspace ID"
-//	@Success		200	{object}	listResponse	"Boards data"
-//	@Failure		400	{object}	http.JSONError
-//	@Failure		401	{object}	http.JSONError
+//	@Param			id			path		int				true	"Workspace ID"
+//	@Param			archived	query		bool			false	"Return archived boards"
+//	@Success		200			{object}	listResponse	"Boards data"
+//	@Failure		400			{object}	http.JSONError
+//	@Failure		401			{object}	http.JSONError
+//	@Failure		403			{object}	http.JSONError
 //	@Failure		405
 //	@Failure		500
 //	@Router			/workspaces/{id}/boards [get]
@@ -132,7 +179,25 @@ func (del *delivery) listByWorkspace(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	boards, err := del.uc.ListByWorkspace(ctx, workspaceID)
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckWorkspace(userID, workspaceID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	archived, err := pHTTP.ParseArchived(r)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	boards, err := del.uc.ListByWorkspace(ctx, workspaceID, archived)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
 		return
@@ -189,6 +254,7 @@ func (del *delivery) list(w http.ResponseWriter, r *http.Request) {
 //	@Success		200	{object}	getResponse	"Board data"
 //	@Failure		400	{object}	http.JSONError
 //	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
 //	@Failure		404	{object}	http.JSONError
 //	@Failure		405
 //	@Failure		500
@@ -206,6 +272,18 @@ func (del *delivery) get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, boardID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
 	board, err := del.uc.Get(ctx, boardID)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
@@ -216,10 +294,84 @@ func (del *delivery) get(w http.ResponseWriter, r *http.Request) {
 	pHTTP.SendJSON(w, r, http.StatusOK, response)
 }
 
+// getFull godoc
+//
+//	@Summary		Returns board with its lists and cards
+//	@Description	Returns board by id with its labels and its active lists in order, each with its active cards in
+//	@Description	order. Cards have their labels, assignees, checklist progress and comments count. The board is
+//	@Description	read in one snapshot with the same few queries whatever its size.
+//	@Tags			boards
+//	@Produce		json
+//	@Param			id	path		int				true	"Board ID"
+//	@Success		200	{object}	fullResponse	"Board data with lists and cards"
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/boards/{id}/full [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) getFull(w http.ResponseWriter, r *http.Request) {
+	ctx, span := opentel.Tracer.Start(r.Context(), r.Method+" "+r.RequestURI)
+	defer span.End()
+
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, boardID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	full, err := del.uc.GetFull(ctx, boardID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newFullResponse(&full)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// listBackgrounds godoc
+//
+//	@Summary		Returns background presets
+//	@Description	Returns suggested background colors and the gradients boards can have
+//	@Tags			boards
+//	@Produce		json
+//	@Success		200	{object}	backgroundsResponse	"Background presets"
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		405
+//	@Router			/boards/backgrounds [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) listBackgrounds(w http.ResponseWriter, r *http.Request) {
+	_, span := opentel.Tracer.Start(r.Context(), r.Method+" "+r.RequestURI)
+	defer span.End()
+
+	response := newBackgroundsResponse()
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
 // partialUpdate godoc
 //
 //	@Summary		Partial update of board
-//	@Description	Partial update of board
+//	@Description	Partial update of board. Setting background_type with background_color or
+//	@Description	background_gradient switches the background and drops an uploaded image. is_template makes the
+//	@Description	board a template or a regular board again.
 //	@Tags			boards
 //	@Accept			json
 //	@Produce		json
@@ -228,6 +380,7 @@ func (del *delivery) get(w http.ResponseWriter, r *http.Request) {
 //	@Success		200				{object}	getResponse				"Updated board data."
 //	@Failure		400				{object}	http.JSONError
 //	@Failure		401				{object}	http.JSONError
+//	@Failure		403				{object}	http.JSONError
 //	@Failure		405
 //	@Failure		500
 //	@Router			/boards/{id}  [patch]
@@ -244,6 +397,18 @@ func (del *delivery) partialUpdate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, boardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
 	body, err := pHTTP.ReadBody(r, del.log)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
@@ -266,6 +431,19 @@ func (del *delivery) partialUpdate(w http.ResponseWriter, r *http.Request) {
 	if params.UpdateDescription {
 		params.Description = *request.Description
 	}
+	params.UpdateBackground = request.BackgroundType != nil || request.BackgroundColor != nil ||
+		request.BackgroundGradient != nil
+	if params.UpdateBackground {
+		if request.BackgroundType != nil {
+			params.BackgroundType = *request.BackgroundType
+		}
+		params.BackgroundColor = request.BackgroundColor
+		params.BackgroundGradient = request.BackgroundGradient
+	}
+	params.UpdateIsTemplate = request.IsTemplate != nil
+	if params.UpdateIsTemplate {
+		params.IsTemplate = *request.IsTemplate
+	}
 
 	board, err := del.uc.PartialUpdate(ctx, &params)
 	if err != nil {
@@ -291,6 +469,7 @@ func (del *delivery) partialUpdate(w http.ResponseWriter, r *http.Request) {
 //	@Failure		401			{object}	http.JSONError
 //	@Failure		403			{object}	http.JSONError
 //	@Failure		404			{object}	http.JSONError
+//	@Failure		413			{object}	http.JSONError
 //	@Failure		405
 //	@Failure		500
 //	@Router			/boards/{id}/background [put]
@@ -301,18 +480,42 @@ func (del *delivery) updateBackground(w http.ResponseWriter, r *http.Request) {
 	defer span.End()
 
 	vars := mux.Vars(r)
-	userID, err := strconv.Atoi(vars["id"])
+	boardID, err := strconv.Atoi(vars["id"])
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
 		return
 	}
 
-	file, header, err := r.FormFile("background")
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, boardID, pAccess.Write)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
 		return
 	}
 
+	maxBodySize := viper.GetInt64(config.MaxImageSize) + constants.MultipartOverhead
+	if r.ContentLength > maxBodySize {
+		pHTTP.HandleError(w, r, pErrors.ErrTooLargeImage)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodySize)
+
+	file, _, err := r.FormFile("background")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			pHTTP.HandleError(w, r, pErrors.ErrTooLargeImage)
+			return
+		}
+		pHTTP.HandleError(w, r, errors.Wrap(pErrors.ErrReadBody, err.Error()))
+		return
+	}
+
 	buf := bytes.NewBuffer(nil)
 	_, err = io.Copy(buf, file)
 	if err != nil {
@@ -320,7 +523,7 @@ func (del *delivery) updateBackground(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	board, err := del.uc.UpdateBackground(ctx, userID, buf.Bytes(), header.Filename)
+	board, err := del.uc.UpdateBackground(ctx, boardID, buf.Bytes())
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
 		return
@@ -340,6 +543,7 @@ func (del *delivery) updateBackground(w http.ResponseWriter, r *http.Request) {
 //	@Success		204	"Board deleted successfully"
 //	@Failure		400	{object}	http.JSONError
 //	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
 //	@Failure		404	{object}	http.JSONError
 //	@Failure		405
 //	@Failure		500
@@ -357,6 +561,18 @@ func (del *delivery) delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, boardID, pAccess.Manage)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
 	err = del.uc.Delete(ctx, boardID)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
@@ -365,3 +581,328 @@ func (del *delivery) delete(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// copy godoc
+//
+//	@Summary		Copy board
+//	@Description	Copy board with its active lists and cards, its labels and the checklists of the cards into a
+//	@Description	workspace. The copy is a regular board unless is_template is set. An uploaded background is not
+//	@Description	copied, the copy gets the default color.
+//	@Tags			boards
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		int			true	"Board ID"
+//	@Param			CopyData	body		copyRequest	true	"Target workspace and title"
+//	@Success		200			{object}	getResponse	"Copied board data."
+//	@Failure		400			{object}	http.JSONError
+//	@Failure		401			{object}	http.JSONError
+//	@Failure		403			{object}	http.JSONError
+//	@Failure		404			{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/boards/{id}/copy [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) copy(w http.ResponseWriter, r *http.Request) {
+	ctx, span := opentel.Tracer.Start(r.Context(), r.Method+" "+r.RequestURI)
+	defer span.End()
+
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, boardID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	body, err := pHTTP.ReadBody(r, del.log)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	var request copyRequest
+	err = request.UnmarshalJSON(body)
+	if err != nil {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckWorkspace(userID, request.WorkspaceID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	params := pBoards.CopyParams{
+		ID:          boardID,
+		WorkspaceID: request.WorkspaceID,
+		Title:       request.Title,
+		IsTemplate:  request.IsTemplate,
+	}
+
+	board, err := del.uc.Copy(ctx, &params)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newGetResponse(&board)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// export godoc
+//
+//	@Summary		Exports board
+//	@Description	Exports board by id with its labels and its active lists and cards to a versioned document, which
+//	@Description	can be imported into a workspace of this or another instance. Members, assignees, comments and
+//	@Description	attachments are not exported, an uploaded background is exported as the default color.
+//	@Tags			boards
+//	@Produce		json
+//	@Param			id	path		int				true	"Board ID"
+//	@Success		200	{object}	exportResponse	"Board export"
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/boards/{id}/export [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) export(w http.ResponseWriter, r *http.Request) {
+	ctx, span := opentel.Tracer.Start(r.Context(), r.Method+" "+r.RequestURI)
+	defer span.End()
+
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, boardID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	export, err := del.uc.Export(ctx, boardID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="board-%d.json"`, boardID))
+	response := newExportResponse(&export)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// listTemplates godoc
+//
+//	@Summary		Returns templates
+//	@Description	Returns the built-in templates, which have no workspace_id, then the templates of the workspaces of
+//	@Description	current user.
+//	@Tags			templates
+//	@Produce		json
+//	@Success		200	{object}	listResponse	"Templates data"
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/templates [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) listTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx, span := opentel.Tracer.Start(r.Context(), r.Method+" "+r.RequestURI)
+	defer span.End()
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	boards, err := del.uc.ListTemplates(ctx, userID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newListResponse(boards)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// instantiate godoc
+//
+//	@Summary		Create board from template
+//	@Description	Create a regular board in the workspace from the template, with its lists, cards, labels and
+//	@Description	checklists.
+//	@Tags			templates
+//	@Accept			json
+//	@Produce		json
+//	@Param			id					path		int					true	"Template ID"
+//	@Param			InstantiateData		body		instantiateRequest	true	"Target workspace and title"
+//	@Success		200					{object}	getResponse			"Created board data."
+//	@Failure		400					{object}	http.JSONError
+//	@Failure		401					{object}	http.JSONError
+//	@Failure		403					{object}	http.JSONError
+//	@Failure		404					{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/templates/{id}/instantiate [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) instantiate(w http.ResponseWriter, r *http.Request) {
+	ctx, span := opentel.Tracer.Start(r.Context(), r.Method+" "+r.RequestURI)
+	defer span.End()
+
+	vars := mux.Vars(r)
+	templateID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, templateID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	body, err := pHTTP.ReadBody(r, del.log)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	var request instantiateRequest
+	err = request.UnmarshalJSON(body)
+	if err != nil {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckWorkspace(userID, request.WorkspaceID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	params := pBoards.CopyParams{
+		ID:          templateID,
+		WorkspaceID: request.WorkspaceID,
+		Title:       request.Title,
+	}
+
+	board, err := del.uc.Instantiate(ctx, &params)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newGetResponse(&board)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// archive godoc
+//
+//	@Summary		Archive board
+//	@Description	Archive board. It leaves the workspace listings and search, its lists and cards are kept.
+//	@Tags			boards
+//	@Produce		json
+//	@Param			id	path		int			true	"Board ID"
+//	@Success		200	{object}	getResponse	"Updated board data."
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/boards/{id}/archive [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) archive(w http.ResponseWriter, r *http.Request) {
+	// setArchived refuses requests without the user.
+	userID, _ := r.Context().Value(mw.ContextUserID).(int)
+	del.setArchived(w, r, func(ctx context.Context, id int) (models.Board, error) {
+		return del.uc.Archive(ctx, id, userID)
+	})
+}
+
+// unarchive godoc
+//
+//	@Summary		Restore archived board
+//	@Description	Restore archived board
+//	@Tags			boards
+//	@Produce		json
+//	@Param			id	path		int			true	"Board ID"
+//	@Success		200	{object}	getResponse	"Updated board data."
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/boards/{id}/unarchive [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) unarchive(w http.ResponseWriter, r *http.Request) {
+	del.setArchived(w, r, del.uc.Unarchive)
+}
+
+func (del *delivery) setArchived(w http.ResponseWriter, r *http.Request,
+	set func(ctx context.Context, id int) (models.Board, error)) {
+	ctx, span := opentel.Tracer.Start(r.Context(), r.Method+" "+r.RequestURI)
+	defer span.End()
+
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, boardID, pAccess.Manage)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	board, err := set(ctx, boardID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newGetResponse(&board)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
@@ -14,8 +14,27 @@ type createRequest struct {
 }
 
 type partialUpdateRequest struct {
-	Title       *string `json:"title"`
-	Description *string `json:"description"`
+	Title              *string `json:"title"`
+	Description        *string `json:"description"`
+	BackgroundType     *string `json:"background_type"`
+	BackgroundColor    *string `json:"background_color"`
+	BackgroundGradient *string `json:"background_gradient"`
+	IsTemplate         *bool   `json:"is_template"`
+}
+
+// copyRequest copies the board into the workspace, as a template with
+// is_template. An empty title keeps the title of the board.
+type copyRequest struct {
+	WorkspaceID int    `json:"workspace_id"`
+	Title       string `json:"title"`
+	IsTemplate  bool   `json:"is_template"`
+}
+
+// instantiateRequest creates a board of the template in the workspace. An
+// empty title keeps the title of the template.
+type instantiateRequest struct {
+	WorkspaceID int    `json:"workspace_id"`
+	Title       string `json:"title"`
 }
 
 // API responses
@@ -30,39 +49,157 @@ func newListResponse(boards []models.Board) *listResponse {
 }
 
 type createResponse struct {
-	ID          int       `json:"id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID                 int       `json:"id"`
+	Title              string    `json:"title"`
+	Description        string    `json:"description"`
+	BackgroundType     string    `json:"background_type"`
+	BackgroundColor    *string   `json:"background_color"`
+	BackgroundGradient *string   `json:"background_gradient"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
 }
 
 func newCreateResponse(board *models.Board) *createResponse {
 	return &createResponse{
-		ID:          board.ID,
-		Title:       board.Title,
-		Description: board.Description,
-		CreatedAt:   board.CreatedAt,
-		UpdatedAt:   board.UpdatedAt,
+		ID:                 board.ID,
+		Title:              board.Title,
+		Description:        board.Description,
+		BackgroundType:     board.BackgroundType,
+		BackgroundColor:    board.BackgroundColor,
+		BackgroundGradient: board.BackgroundGradient,
+		CreatedAt:          board.CreatedAt,
+		UpdatedAt:          board.UpdatedAt,
 	}
 }
 
 type getResponse struct {
-	ID          int       `json:"id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	Background  *string   `json:"background"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID                   int               `json:"id"`
+	Title                string            `json:"title"`
+	Description          string            `json:"description"`
+	BackgroundType       string            `json:"background_type"`
+	BackgroundColor      *string           `json:"background_color"`
+	BackgroundGradient   *string           `json:"background_gradient"`
+	Background           *string           `json:"background"`
+	BackgroundThumbnails map[string]string `json:"background_thumbnails"`
+	IsTemplate           bool              `json:"is_template"`
+	ArchivedAt           *time.Time        `json:"archived_at"`
+	CreatedAt            time.Time         `json:"created_at"`
+	UpdatedAt            time.Time         `json:"updated_at"`
 }
 
 func newGetResponse(board *models.Board) *getResponse {
 	return &getResponse{
-		ID:          board.ID,
-		Title:       board.Title,
-		Description: board.Description,
-		Background:  board.Background,
-		CreatedAt:   board.CreatedAt,
-		UpdatedAt:   board.UpdatedAt,
+		ID:                   board.ID,
+		Title:                board.Title,
+		Description:          board.Description,
+		BackgroundType:       board.BackgroundType,
+		BackgroundColor:      board.BackgroundColor,
+		BackgroundGradient:   board.BackgroundGradient,
+		Background:           board.Background,
+		BackgroundThumbnails: board.BackgroundThumbnails,
+		IsTemplate:           board.IsTemplate,
+		ArchivedAt:           board.ArchivedAt,
+		CreatedAt:            board.CreatedAt,
+		UpdatedAt:            board.UpdatedAt,
+	}
+}
+
+type fullResponse struct {
+	ID                   int                `json:"id"`
+	WorkspaceID          int                `json:"workspace_id"`
+	Title                string             `json:"title"`
+	Description          string             `json:"description"`
+	BackgroundType       string             `json:"background_type"`
+	BackgroundColor      *string            `json:"background_color"`
+	BackgroundGradient   *string            `json:"background_gradient"`
+	Background           *string            `json:"background"`
+	BackgroundThumbnails map[string]string  `json:"background_thumbnails"`
+	IsTemplate           bool               `json:"is_template"`
+	ArchivedAt           *time.Time         `json:"archived_at"`
+	CreatedAt            time.Time          `json:"created_at"`
+	UpdatedAt            time.Time          `json:"updated_at"`
+	Labels               []models.Label     `json:"labels"`
+	Lists                []fullListResponse `json:"lists"`
+}
+
+type fullListResponse struct {
+	ID        int           `json:"id"`
+	Title     string        `json:"title"`
+	Position  int           `json:"position"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+	Cards     []models.Card `json:"cards"`
+}
+
+func newFullResponse(full *models.BoardFull) *fullResponse {
+	response := &fullResponse{
+		ID:                   full.ID,
+		WorkspaceID:          full.WorkspaceID,
+		Title:                full.Title,
+		Description:          full.Description,
+		BackgroundType:       full.BackgroundType,
+		BackgroundColor:      full.BackgroundColor,
+		BackgroundGradient:   full.BackgroundGradient,
+		Background:           full.Background,
+		BackgroundThumbnails: full.BackgroundThumbnails,
+		IsTemplate:           full.IsTemplate,
+		ArchivedAt:           full.ArchivedAt,
+		CreatedAt:            full.CreatedAt,
+		UpdatedAt:            full.UpdatedAt,
+		Labels:               full.Labels,
+		Lists:                make([]fullListResponse, len(full.Lists)),
+	}
+
+	for i, list := range full.Lists {
+		response.Lists[i] = fullListResponse{
+			ID:        list.ID,
+			Title:     list.Title,
+			Position:  list.Position,
+			CreatedAt: list.CreatedAt,
+			UpdatedAt: list.UpdatedAt,
+			Cards:     list.Cards,
+		}
+	}
+
+	return response
+}
+
+type exportResponse struct {
+	Version            int                  `json:"version"`
+	ExportedAt         time.Time            `json:"exported_at"`
+	Title              string               `json:"title"`
+	Description        string               `json:"description"`
+	IsTemplate         bool                 `json:"is_template"`
+	BackgroundType     string               `json:"background_type"`
+	BackgroundColor    *string              `json:"background_color"`
+	BackgroundGradient *string              `json:"background_gradient"`
+	Labels             []models.ExportLabel `json:"labels"`
+	Lists              []models.ExportList  `json:"lists"`
+}
+
+func newExportResponse(export *models.BoardExport) *exportResponse {
+	return &exportResponse{
+		Version:            export.Version,
+		ExportedAt:         export.ExportedAt,
+		Title:              export.Title,
+		Description:        export.Description,
+		IsTemplate:         export.IsTemplate,
+		BackgroundType:     export.BackgroundType,
+		BackgroundColor:    export.BackgroundColor,
+		BackgroundGradient: export.BackgroundGradient,
+		Labels:             export.Labels,
+		Lists:              export.Lists,
+	}
+}
+
+type backgroundsResponse struct {
+	Colors    []string          `json:"colors"`
+	Gradients []models.Gradient `json:"gradients"`
+}
+
+func newBackgroundsResponse() *backgroundsResponse {
+	return &backgroundsResponse{
+		Colors:    models.BackgroundColors,
+		Gradients: models.BackgroundGradients,
 	}
 }
@@ -8,6 +8,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -57,6 +58,46 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDe
 				}
 				*out.Description = string(in.String())
 			}
+		case "background_type":
+			if in.IsNull() {
+				in.Skip()
+				out.BackgroundType = nil
+			} else {
+				if out.BackgroundType == nil {
+					out.BackgroundType = new(string)
+				}
+				*out.BackgroundType = string(in.String())
+			}
+		case "background_color":
+			if in.IsNull() {
+				in.Skip()
+				out.BackgroundColor = nil
+			} else {
+				if out.BackgroundColor == nil {
+					out.BackgroundColor = new(string)
+				}
+				*out.BackgroundColor = string(in.String())
+			}
+		case "background_gradient":
+			if in.IsNull() {
+				in.Skip()
+				out.BackgroundGradient = nil
+			} else {
+				if out.BackgroundGradient == nil {
+					out.BackgroundGradient = new(string)
+				}
+				*out.BackgroundGradient = string(in.String())
+			}
+		case "is_template":
+			if in.IsNull() {
+				in.Skip()
+				out.IsTemplate = nil
+			} else {
+				if out.IsTemplate == nil {
+					out.IsTemplate = new(bool)
+				}
+				*out.IsTemplate = bool(in.Bool())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -89,6 +130,42 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDe
 			out.String(string(*in.Description))
 		}
 	}
+	{
+		const prefix string = ",\"background_type\":"
+		out.RawString(prefix)
+		if in.BackgroundType == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.BackgroundType))
+		}
+	}
+	{
+		const prefix string = ",\"background_color\":"
+		out.RawString(prefix)
+		if in.BackgroundColor == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.BackgroundColor))
+		}
+	}
+	{
+		const prefix string = ",\"background_gradient\":"
+		out.RawString(prefix)
+		if in.BackgroundGradient == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.BackgroundGradient))
+		}
+	}
+	{
+		const prefix string = ",\"is_template\":"
+		out.RawString(prefix)
+		if in.IsTemplate == nil {
+			out.RawString("null")
+		} else {
+			out.Bool(bool(*in.IsTemplate))
+		}
+	}
 	out.RawByte('}')
 }
 
@@ -240,6 +317,30 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels(i
 			out.Title = string(in.String())
 		case "description":
 			out.Description = string(in.String())
+		case "is_template":
+			out.IsTemplate = bool(in.Bool())
+		case "background_type":
+			out.BackgroundType = string(in.String())
+		case "background_color":
+			if in.IsNull() {
+				in.Skip()
+				out.BackgroundColor = nil
+			} else {
+				if out.BackgroundColor == nil {
+					out.BackgroundColor = new(string)
+				}
+				*out.BackgroundColor = string(in.String())
+			}
+		case "background_gradient":
+			if in.IsNull() {
+				in.Skip()
+				out.BackgroundGradient = nil
+			} else {
+				if out.BackgroundGradient == nil {
+					out.BackgroundGradient = new(string)
+				}
+				*out.BackgroundGradient = string(in.String())
+			}
 		case "background":
 			if in.IsNull() {
 				in.Skip()
@@ -250,6 +351,34 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels(i
 				}
 				*out.Background = string(in.String())
 			}
+		case "background_thumbnails":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.BackgroundThumbnails = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v4 string
+					v4 = string(in.String())
+					(out.BackgroundThumbnails)[key] = v4
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "archived_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ArchivedAt = nil
+			} else {
+				if out.ArchivedAt == nil {
+					out.ArchivedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ArchivedAt).UnmarshalJSON(data))
+				}
+			}
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
@@ -292,6 +421,34 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels(o
 		out.RawString(prefix)
 		out.String(string(in.Description))
 	}
+	{
+		const prefix string = ",\"is_template\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsTemplate))
+	}
+	{
+		const prefix string = ",\"background_type\":"
+		out.RawString(prefix)
+		out.String(string(in.BackgroundType))
+	}
+	{
+		const prefix string = ",\"background_color\":"
+		out.RawString(prefix)
+		if in.BackgroundColor == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.BackgroundColor))
+		}
+	}
+	{
+		const prefix string = ",\"background_gradient\":"
+		out.RawString(prefix)
+		if in.BackgroundGradient == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.BackgroundGradient))
+		}
+	}
 	{
 		const prefix string = ",\"background\":"
 		out.RawString(prefix)
@@ -301,6 +458,36 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels(o
 			out.String(string(*in.Background))
 		}
 	}
+	{
+		const prefix string = ",\"background_thumbnails\":"
+		out.RawString(prefix)
+		if in.BackgroundThumbnails == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v5First := true
+			for v5Name, v5Value := range in.BackgroundThumbnails {
+				if v5First {
+					v5First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v5Name))
+				out.RawByte(':')
+				out.String(string(v5Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"archived_at\":"
+		out.RawString(prefix)
+		if in.ArchivedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ArchivedAt).MarshalJSON())
+		}
+	}
 	{
 		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
@@ -313,7 +500,7 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels(o
 	}
 	out.RawByte('}')
 }
-func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp2(in *jlexer.Lexer, out *getResponse) {
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp2(in *jlexer.Lexer, out *instantiateRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -332,30 +519,10 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDe
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = int(in.Int())
+		case "workspace_id":
+			out.WorkspaceID = int(in.Int())
 		case "title":
 			out.Title = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "background":
-			if in.IsNull() {
-				in.Skip()
-				out.Background = nil
-			} else {
-				if out.Background == nil {
-					out.Background = new(string)
-				}
-				*out.Background = string(in.String())
-			}
-		case "created_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "updated_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
-			}
 		default:
 			in.SkipRecursive()
 		}
@@ -366,71 +533,47 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDe
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp2(out *jwriter.Writer, in getResponse) {
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp2(out *jwriter.Writer, in instantiateRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"workspace_id\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ID))
+		out.Int(int(in.WorkspaceID))
 	}
 	{
 		const prefix string = ",\"title\":"
 		out.RawString(prefix)
 		out.String(string(in.Title))
 	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	{
-		const prefix string = ",\"background\":"
-		out.RawString(prefix)
-		if in.Background == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Background))
-		}
-	}
-	{
-		const prefix string = ",\"created_at\":"
-		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"updated_at\":"
-		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v getResponse) MarshalJSON() ([]byte, error) {
+func (v instantiateRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v getResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v instantiateRequest) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *getResponse) UnmarshalJSON(data []byte) error {
+func (v *instantiateRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *getResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *instantiateRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp2(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp3(in *jlexer.Lexer, out *createResponse) {
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp3(in *jlexer.Lexer, out *getResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -455,6 +598,68 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDe
 			out.Title = string(in.String())
 		case "description":
 			out.Description = string(in.String())
+		case "background_type":
+			out.BackgroundType = string(in.String())
+		case "background_color":
+			if in.IsNull() {
+				in.Skip()
+				out.BackgroundColor = nil
+			} else {
+				if out.BackgroundColor == nil {
+					out.BackgroundColor = new(string)
+				}
+				*out.BackgroundColor = string(in.String())
+			}
+		case "background_gradient":
+			if in.IsNull() {
+				in.Skip()
+				out.BackgroundGradient = nil
+			} else {
+				if out.BackgroundGradient == nil {
+					out.BackgroundGradient = new(string)
+				}
+				*out.BackgroundGradient = string(in.String())
+			}
+		case "background":
+			if in.IsNull() {
+				in.Skip()
+				out.Background = nil
+			} else {
+				if out.Background == nil {
+					out.Background = new(string)
+				}
+				*out.Background = string(in.String())
+			}
+		case "background_thumbnails":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.BackgroundThumbnails = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v6 string
+					v6 = string(in.String())
+					(out.BackgroundThumbnails)[key] = v6
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "is_template":
+			out.IsTemplate = bool(in.Bool())
+		case "archived_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ArchivedAt = nil
+			} else {
+				if out.ArchivedAt == nil {
+					out.ArchivedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ArchivedAt).UnmarshalJSON(data))
+				}
+			}
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
@@ -473,7 +678,7 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDe
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp3(out *jwriter.Writer, in createResponse) {
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp3(out *jwriter.Writer, in getResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -492,6 +697,73 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDe
 		out.RawString(prefix)
 		out.String(string(in.Description))
 	}
+	{
+		const prefix string = ",\"background_type\":"
+		out.RawString(prefix)
+		out.String(string(in.BackgroundType))
+	}
+	{
+		const prefix string = ",\"background_color\":"
+		out.RawString(prefix)
+		if in.BackgroundColor == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.BackgroundColor))
+		}
+	}
+	{
+		const prefix string = ",\"background_gradient\":"
+		out.RawString(prefix)
+		if in.BackgroundGradient == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.BackgroundGradient))
+		}
+	}
+	{
+		const prefix string = ",\"background\":"
+		out.RawString(prefix)
+		if in.Background == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Background))
+		}
+	}
+	{
+		const prefix string = ",\"background_thumbnails\":"
+		out.RawString(prefix)
+		if in.BackgroundThumbnails == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v7First := true
+			for v7Name, v7Value := range in.BackgroundThumbnails {
+				if v7First {
+					v7First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v7Name))
+				out.RawByte(':')
+				out.String(string(v7Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"is_template\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsTemplate))
+	}
+	{
+		const prefix string = ",\"archived_at\":"
+		out.RawString(prefix)
+		if in.ArchivedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ArchivedAt).MarshalJSON())
+		}
+	}
 	{
 		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
@@ -506,29 +778,29 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDe
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v createResponse) MarshalJSON() ([]byte, error) {
+func (v getResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v createResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v getResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *createResponse) UnmarshalJSON(data []byte) error {
+func (v *getResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *createResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *getResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp3(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp4(in *jlexer.Lexer, out *createRequest) {
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp4(in *jlexer.Lexer, out *fullResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -547,26 +819,1765 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDe
 			continue
 		}
 		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "workspace_id":
+			out.WorkspaceID = int(in.Int())
 		case "title":
 			out.Title = string(in.String())
 		case "description":
 			out.Description = string(in.String())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp4(out *jwriter.Writer, in createRequest) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"title\":"
+		case "background_type":
+			out.BackgroundType = string(in.String())
+		case "background_color":
+			if in.IsNull() {
+				in.Skip()
+				out.BackgroundColor = nil
+			} else {
+				if out.BackgroundColor == nil {
+					out.BackgroundColor = new(string)
+				}
+				*out.BackgroundColor = string(in.String())
+			}
+		case "background_gradient":
+			if in.IsNull() {
+				in.Skip()
+				out.BackgroundGradient = nil
+			} else {
+				if out.BackgroundGradient == nil {
+					out.BackgroundGradient = new(string)
+				}
+				*out.BackgroundGradient = string(in.String())
+			}
+		case "background":
+			if in.IsNull() {
+				in.Skip()
+				out.Background = nil
+			} else {
+				if out.Background == nil {
+					out.Background = new(string)
+				}
+				*out.Background = string(in.String())
+			}
+		case "background_thumbnails":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.BackgroundThumbnails = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v8 string
+					v8 = string(in.String())
+					(out.BackgroundThumbnails)[key] = v8
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "is_template":
+			out.IsTemplate = bool(in.Bool())
+		case "archived_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ArchivedAt = nil
+			} else {
+				if out.ArchivedAt == nil {
+					out.ArchivedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ArchivedAt).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "labels":
+			if in.IsNull() {
+				in.Skip()
+				out.Labels = nil
+			} else {
+				in.Delim('[')
+				if out.Labels == nil {
+					if !in.IsDelim(']') {
+						out.Labels = make([]models.Label, 0, 0)
+					} else {
+						out.Labels = []models.Label{}
+					}
+				} else {
+					out.Labels = (out.Labels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v9 models.Label
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels1(in, &v9)
+					out.Labels = append(out.Labels, v9)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "lists":
+			if in.IsNull() {
+				in.Skip()
+				out.Lists = nil
+			} else {
+				in.Delim('[')
+				if out.Lists == nil {
+					if !in.IsDelim(']') {
+						out.Lists = make([]fullListResponse, 0, 0)
+					} else {
+						out.Lists = []fullListResponse{}
+					}
+				} else {
+					out.Lists = (out.Lists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 fullListResponse
+					(v10).UnmarshalEasyJSON(in)
+					out.Lists = append(out.Lists, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp4(out *jwriter.Writer, in fullResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"workspace_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.WorkspaceID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"background_type\":"
+		out.RawString(prefix)
+		out.String(string(in.BackgroundType))
+	}
+	{
+		const prefix string = ",\"background_color\":"
+		out.RawString(prefix)
+		if in.BackgroundColor == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.BackgroundColor))
+		}
+	}
+	{
+		const prefix string = ",\"background_gradient\":"
+		out.RawString(prefix)
+		if in.BackgroundGradient == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.BackgroundGradient))
+		}
+	}
+	{
+		const prefix string = ",\"background\":"
+		out.RawString(prefix)
+		if in.Background == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Background))
+		}
+	}
+	{
+		const prefix string = ",\"background_thumbnails\":"
+		out.RawString(prefix)
+		if in.BackgroundThumbnails == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v11First := true
+			for v11Name, v11Value := range in.BackgroundThumbnails {
+				if v11First {
+					v11First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v11Name))
+				out.RawByte(':')
+				out.String(string(v11Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"is_template\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsTemplate))
+	}
+	{
+		const prefix string = ",\"archived_at\":"
+		out.RawString(prefix)
+		if in.ArchivedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ArchivedAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"labels\":"
+		out.RawString(prefix)
+		if in.Labels == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v12, v13 := range in.Labels {
+				if v12 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels1(out, v13)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"lists\":"
+		out.RawString(prefix)
+		if in.Lists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.Lists {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				(v15).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v fullResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v fullResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *fullResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *fullResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp4(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels1(in *jlexer.Lexer, out *models.Label) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "board_id":
+			out.BoardID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "color":
+			out.Color = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels1(out *jwriter.Writer, in models.Label) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.BoardID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"color\":"
+		out.RawString(prefix)
+		out.String(string(in.Color))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp5(in *jlexer.Lexer, out *fullListResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "position":
+			out.Position = int(in.Int())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "cards":
+			if in.IsNull() {
+				in.Skip()
+				out.Cards = nil
+			} else {
+				in.Delim('[')
+				if out.Cards == nil {
+					if !in.IsDelim(']') {
+						out.Cards = make([]models.Card, 0, 0)
+					} else {
+						out.Cards = []models.Card{}
+					}
+				} else {
+					out.Cards = (out.Cards)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 models.Card
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels2(in, &v16)
+					out.Cards = append(out.Cards, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp5(out *jwriter.Writer, in fullListResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Int(int(in.Position))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"cards\":"
+		out.RawString(prefix)
+		if in.Cards == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.Cards {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels2(out, v18)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v fullListResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v fullListResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *fullListResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *fullListResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp5(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels2(in *jlexer.Lexer, out *models.Card) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "list_id":
+			out.ListID = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "content":
+			out.Content = string(in.String())
+		case "position":
+			out.Position = int(in.Int())
+		case "labels":
+			if in.IsNull() {
+				in.Skip()
+				out.Labels = nil
+			} else {
+				in.Delim('[')
+				if out.Labels == nil {
+					if !in.IsDelim(']') {
+						out.Labels = make([]models.Label, 0, 0)
+					} else {
+						out.Labels = []models.Label{}
+					}
+				} else {
+					out.Labels = (out.Labels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 models.Label
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels1(in, &v19)
+					out.Labels = append(out.Labels, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "assignees":
+			if in.IsNull() {
+				in.Skip()
+				out.Assignees = nil
+			} else {
+				in.Delim('[')
+				if out.Assignees == nil {
+					if !in.IsDelim(']') {
+						out.Assignees = make([]models.Assignee, 0, 0)
+					} else {
+						out.Assignees = []models.Assignee{}
+					}
+				} else {
+					out.Assignees = (out.Assignees)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v20 models.Assignee
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels3(in, &v20)
+					out.Assignees = append(out.Assignees, v20)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "checklist_progress":
+			easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels4(in, &out.ChecklistProgress)
+		case "comments_count":
+			out.CommentsCount = int(in.Int())
+		case "start_at":
+			if in.IsNull() {
+				in.Skip()
+				out.StartAt = nil
+			} else {
+				if out.StartAt == nil {
+					out.StartAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.StartAt).UnmarshalJSON(data))
+				}
+			}
+		case "due_at":
+			if in.IsNull() {
+				in.Skip()
+				out.DueAt = nil
+			} else {
+				if out.DueAt == nil {
+					out.DueAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DueAt).UnmarshalJSON(data))
+				}
+			}
+		case "completed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.CompletedAt = nil
+			} else {
+				if out.CompletedAt == nil {
+					out.CompletedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CompletedAt).UnmarshalJSON(data))
+				}
+			}
+		case "archived_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ArchivedAt = nil
+			} else {
+				if out.ArchivedAt == nil {
+					out.ArchivedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ArchivedAt).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels2(out *jwriter.Writer, in models.Card) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"list_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ListID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix)
+		out.String(string(in.Content))
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Int(int(in.Position))
+	}
+	{
+		const prefix string = ",\"labels\":"
+		out.RawString(prefix)
+		if in.Labels == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v21, v22 := range in.Labels {
+				if v21 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels1(out, v22)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"assignees\":"
+		out.RawString(prefix)
+		if in.Assignees == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v23, v24 := range in.Assignees {
+				if v23 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels3(out, v24)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"checklist_progress\":"
+		out.RawString(prefix)
+		easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels4(out, in.ChecklistProgress)
+	}
+	{
+		const prefix string = ",\"comments_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.CommentsCount))
+	}
+	{
+		const prefix string = ",\"start_at\":"
+		out.RawString(prefix)
+		if in.StartAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.StartAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"due_at\":"
+		out.RawString(prefix)
+		if in.DueAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.DueAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"completed_at\":"
+		out.RawString(prefix)
+		if in.CompletedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.CompletedAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"archived_at\":"
+		out.RawString(prefix)
+		if in.ArchivedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ArchivedAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels4(in *jlexer.Lexer, out *models.ChecklistProgress) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "done":
+			out.Done = int(in.Int())
+		case "total":
+			out.Total = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels4(out *jwriter.Writer, in models.ChecklistProgress) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"done\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Done))
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels3(in *jlexer.Lexer, out *models.Assignee) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "username":
+			out.Username = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "avatar":
+			if in.IsNull() {
+				in.Skip()
+				out.Avatar = nil
+			} else {
+				if out.Avatar == nil {
+					out.Avatar = new(string)
+				}
+				*out.Avatar = string(in.String())
+			}
+		case "assigned_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.AssignedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels3(out *jwriter.Writer, in models.Assignee) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"avatar\":"
+		out.RawString(prefix)
+		if in.Avatar == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Avatar))
+		}
+	}
+	{
+		const prefix string = ",\"assigned_at\":"
+		out.RawString(prefix)
+		out.Raw((in.AssignedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp6(in *jlexer.Lexer, out *exportResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "version":
+			out.Version = int(in.Int())
+		case "exported_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExportedAt).UnmarshalJSON(data))
+			}
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "is_template":
+			out.IsTemplate = bool(in.Bool())
+		case "background_type":
+			out.BackgroundType = string(in.String())
+		case "background_color":
+			if in.IsNull() {
+				in.Skip()
+				out.BackgroundColor = nil
+			} else {
+				if out.BackgroundColor == nil {
+					out.BackgroundColor = new(string)
+				}
+				*out.BackgroundColor = string(in.String())
+			}
+		case "background_gradient":
+			if in.IsNull() {
+				in.Skip()
+				out.BackgroundGradient = nil
+			} else {
+				if out.BackgroundGradient == nil {
+					out.BackgroundGradient = new(string)
+				}
+				*out.BackgroundGradient = string(in.String())
+			}
+		case "labels":
+			if in.IsNull() {
+				in.Skip()
+				out.Labels = nil
+			} else {
+				in.Delim('[')
+				if out.Labels == nil {
+					if !in.IsDelim(']') {
+						out.Labels = make([]models.ExportLabel, 0, 2)
+					} else {
+						out.Labels = []models.ExportLabel{}
+					}
+				} else {
+					out.Labels = (out.Labels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v25 models.ExportLabel
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels5(in, &v25)
+					out.Labels = append(out.Labels, v25)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "lists":
+			if in.IsNull() {
+				in.Skip()
+				out.Lists = nil
+			} else {
+				in.Delim('[')
+				if out.Lists == nil {
+					if !in.IsDelim(']') {
+						out.Lists = make([]models.ExportList, 0, 1)
+					} else {
+						out.Lists = []models.ExportList{}
+					}
+				} else {
+					out.Lists = (out.Lists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v26 models.ExportList
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels6(in, &v26)
+					out.Lists = append(out.Lists, v26)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp6(out *jwriter.Writer, in exportResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Version))
+	}
+	{
+		const prefix string = ",\"exported_at\":"
+		out.RawString(prefix)
+		out.Raw((in.ExportedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"is_template\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsTemplate))
+	}
+	{
+		const prefix string = ",\"background_type\":"
+		out.RawString(prefix)
+		out.String(string(in.BackgroundType))
+	}
+	{
+		const prefix string = ",\"background_color\":"
+		out.RawString(prefix)
+		if in.BackgroundColor == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.BackgroundColor))
+		}
+	}
+	{
+		const prefix string = ",\"background_gradient\":"
+		out.RawString(prefix)
+		if in.BackgroundGradient == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.BackgroundGradient))
+		}
+	}
+	{
+		const prefix string = ",\"labels\":"
+		out.RawString(prefix)
+		if in.Labels == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v27, v28 := range in.Labels {
+				if v27 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels5(out, v28)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"lists\":"
+		out.RawString(prefix)
+		if in.Lists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v29, v30 := range in.Lists {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels6(out, v30)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v exportResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v exportResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *exportResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *exportResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp6(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels6(in *jlexer.Lexer, out *models.ExportList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "cards":
+			if in.IsNull() {
+				in.Skip()
+				out.Cards = nil
+			} else {
+				in.Delim('[')
+				if out.Cards == nil {
+					if !in.IsDelim(']') {
+						out.Cards = make([]models.ExportCard, 0, 0)
+					} else {
+						out.Cards = []models.ExportCard{}
+					}
+				} else {
+					out.Cards = (out.Cards)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 models.ExportCard
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels7(in, &v31)
+					out.Cards = append(out.Cards, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels6(out *jwriter.Writer, in models.ExportList) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"cards\":"
+		out.RawString(prefix)
+		if in.Cards == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v32, v33 := range in.Cards {
+				if v32 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels7(out, v33)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels7(in *jlexer.Lexer, out *models.ExportCard) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "content":
+			out.Content = string(in.String())
+		case "start_at":
+			if in.IsNull() {
+				in.Skip()
+				out.StartAt = nil
+			} else {
+				if out.StartAt == nil {
+					out.StartAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.StartAt).UnmarshalJSON(data))
+				}
+			}
+		case "due_at":
+			if in.IsNull() {
+				in.Skip()
+				out.DueAt = nil
+			} else {
+				if out.DueAt == nil {
+					out.DueAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DueAt).UnmarshalJSON(data))
+				}
+			}
+		case "completed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.CompletedAt = nil
+			} else {
+				if out.CompletedAt == nil {
+					out.CompletedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CompletedAt).UnmarshalJSON(data))
+				}
+			}
+		case "labels":
+			if in.IsNull() {
+				in.Skip()
+				out.Labels = nil
+			} else {
+				in.Delim('[')
+				if out.Labels == nil {
+					if !in.IsDelim(']') {
+						out.Labels = make([]int, 0, 8)
+					} else {
+						out.Labels = []int{}
+					}
+				} else {
+					out.Labels = (out.Labels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v34 int
+					v34 = int(in.Int())
+					out.Labels = append(out.Labels, v34)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "checklists":
+			if in.IsNull() {
+				in.Skip()
+				out.Checklists = nil
+			} else {
+				in.Delim('[')
+				if out.Checklists == nil {
+					if !in.IsDelim(']') {
+						out.Checklists = make([]models.ExportChecklist, 0, 1)
+					} else {
+						out.Checklists = []models.ExportChecklist{}
+					}
+				} else {
+					out.Checklists = (out.Checklists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v35 models.ExportChecklist
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels8(in, &v35)
+					out.Checklists = append(out.Checklists, v35)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels7(out *jwriter.Writer, in models.ExportCard) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix)
+		out.String(string(in.Content))
+	}
+	{
+		const prefix string = ",\"start_at\":"
+		out.RawString(prefix)
+		if in.StartAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.StartAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"due_at\":"
+		out.RawString(prefix)
+		if in.DueAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.DueAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"completed_at\":"
+		out.RawString(prefix)
+		if in.CompletedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.CompletedAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"labels\":"
+		out.RawString(prefix)
+		if in.Labels == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v36, v37 := range in.Labels {
+				if v36 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v37))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"checklists\":"
+		out.RawString(prefix)
+		if in.Checklists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v38, v39 := range in.Checklists {
+				if v38 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels8(out, v39)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels8(in *jlexer.Lexer, out *models.ExportChecklist) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]models.ExportChecklistItem, 0, 2)
+					} else {
+						out.Items = []models.ExportChecklistItem{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v40 models.ExportChecklistItem
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels9(in, &v40)
+					out.Items = append(out.Items, v40)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels8(out *jwriter.Writer, in models.ExportChecklist) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix)
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v41, v42 := range in.Items {
+				if v41 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels9(out, v42)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels9(in *jlexer.Lexer, out *models.ExportChecklistItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "done":
+			out.Done = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels9(out *jwriter.Writer, in models.ExportChecklistItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"done\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Done))
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels5(in *jlexer.Lexer, out *models.ExportLabel) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "color":
+			out.Color = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels5(out *jwriter.Writer, in models.ExportLabel) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"color\":"
+		out.RawString(prefix)
+		out.String(string(in.Color))
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp7(in *jlexer.Lexer, out *createResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "background_type":
+			out.BackgroundType = string(in.String())
+		case "background_color":
+			if in.IsNull() {
+				in.Skip()
+				out.BackgroundColor = nil
+			} else {
+				if out.BackgroundColor == nil {
+					out.BackgroundColor = new(string)
+				}
+				*out.BackgroundColor = string(in.String())
+			}
+		case "background_gradient":
+			if in.IsNull() {
+				in.Skip()
+				out.BackgroundGradient = nil
+			} else {
+				if out.BackgroundGradient == nil {
+					out.BackgroundGradient = new(string)
+				}
+				*out.BackgroundGradient = string(in.String())
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp7(out *jwriter.Writer, in createResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"background_type\":"
+		out.RawString(prefix)
+		out.String(string(in.BackgroundType))
+	}
+	{
+		const prefix string = ",\"background_color\":"
+		out.RawString(prefix)
+		if in.BackgroundColor == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.BackgroundColor))
+		}
+	}
+	{
+		const prefix string = ",\"background_gradient\":"
+		out.RawString(prefix)
+		if in.BackgroundGradient == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.BackgroundGradient))
+		}
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v createResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v createResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *createResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *createResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp7(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp8(in *jlexer.Lexer, out *createRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp8(out *jwriter.Writer, in createRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
 		out.RawString(prefix[1:])
 		out.String(string(in.Title))
 	}
@@ -581,23 +2592,321 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDe
 // MarshalJSON supports json.Marshaler interface
 func (v createRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp4(&w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v createRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp4(w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *createRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp4(&r, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *createRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp4(l, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp8(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp9(in *jlexer.Lexer, out *copyRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "workspace_id":
+			out.WorkspaceID = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "is_template":
+			out.IsTemplate = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp9(out *jwriter.Writer, in copyRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"workspace_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.WorkspaceID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"is_template\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsTemplate))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v copyRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v copyRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *copyRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *copyRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp9(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp10(in *jlexer.Lexer, out *backgroundsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "colors":
+			if in.IsNull() {
+				in.Skip()
+				out.Colors = nil
+			} else {
+				in.Delim('[')
+				if out.Colors == nil {
+					if !in.IsDelim(']') {
+						out.Colors = make([]string, 0, 4)
+					} else {
+						out.Colors = []string{}
+					}
+				} else {
+					out.Colors = (out.Colors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v43 string
+					v43 = string(in.String())
+					out.Colors = append(out.Colors, v43)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "gradients":
+			if in.IsNull() {
+				in.Skip()
+				out.Gradients = nil
+			} else {
+				in.Delim('[')
+				if out.Gradients == nil {
+					if !in.IsDelim(']') {
+						out.Gradients = make([]models.Gradient, 0, 1)
+					} else {
+						out.Gradients = []models.Gradient{}
+					}
+				} else {
+					out.Gradients = (out.Gradients)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v44 models.Gradient
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels10(in, &v44)
+					out.Gradients = append(out.Gradients, v44)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp10(out *jwriter.Writer, in backgroundsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"colors\":"
+		out.RawString(prefix[1:])
+		if in.Colors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v45, v46 := range in.Colors {
+				if v45 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v46))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"gradients\":"
+		out.RawString(prefix)
+		if in.Gradients == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v47, v48 := range in.Gradients {
+				if v47 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels10(out, v48)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v backgroundsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v backgroundsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *backgroundsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *backgroundsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalBoardsDeliveryHttp10(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels10(in *jlexer.Lexer, out *models.Gradient) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "colors":
+			if in.IsNull() {
+				in.Skip()
+				out.Colors = nil
+			} else {
+				in.Delim('[')
+				if out.Colors == nil {
+					if !in.IsDelim(']') {
+						out.Colors = make([]string, 0, 4)
+					} else {
+						out.Colors = []string{}
+					}
+				} else {
+					out.Colors = (out.Colors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v49 string
+					v49 = string(in.String())
+					out.Colors = append(out.Colors, v49)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels10(out *jwriter.Writer, in models.Gradient) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"colors\":"
+		out.RawString(prefix)
+		if in.Colors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v50, v51 := range in.Colors {
+				if v50 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v51))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
 }
@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	boards "github.com/SlavaShagalov/my-trello-backend/internal/boards"
 	models "github.com/SlavaShagalov/my-trello-backend/internal/models"
@@ -36,6 +37,21 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Copy mocks base method.
+func (m *MockRepository) Copy(ctx context.Context, params *boards.CopyParams) (models.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", ctx, params)
+	ret0, _ := ret[0].(models.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Copy indicates an expected call of Copy.
+func (mr *MockRepositoryMockRecorder) Copy(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockRepository)(nil).Copy), ctx, params)
+}
+
 // Create mocks base method.
 func (m *MockRepository) Create(ctx context.Context, params *boards.CreateParams) (models.Board, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +81,21 @@ func (mr *MockRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id)
 }
 
+// Export mocks base method.
+func (m *MockRepository) Export(ctx context.Context, id int) (models.BoardExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, id)
+	ret0, _ := ret[0].(models.BoardExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockRepositoryMockRecorder) Export(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockRepository)(nil).Export), ctx, id)
+}
+
 // FullUpdate mocks base method.
 func (m *MockRepository) FullUpdate(ctx context.Context, params *boards.FullUpdateParams) (models.Board, error) {
 	m.ctrl.T.Helper()
@@ -95,19 +126,49 @@ func (mr *MockRepositoryMockRecorder) Get(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRepository)(nil).Get), ctx, id)
 }
 
+// GetFull mocks base method.
+func (m *MockRepository) GetFull(ctx context.Context, id int) (models.BoardFull, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFull", ctx, id)
+	ret0, _ := ret[0].(models.BoardFull)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFull indicates an expected call of GetFull.
+func (mr *MockRepositoryMockRecorder) GetFull(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFull", reflect.TypeOf((*MockRepository)(nil).GetFull), ctx, id)
+}
+
+// Import mocks base method.
+func (m *MockRepository) Import(ctx context.Context, workspaceID int, export *models.BoardExport) (models.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, workspaceID, export)
+	ret0, _ := ret[0].(models.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockRepositoryMockRecorder) Import(ctx, workspaceID, export interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockRepository)(nil).Import), ctx, workspaceID, export)
+}
+
 // List mocks base method.
-func (m *MockRepository) List(ctx context.Context, workspaceID int) ([]models.Board, error) {
+func (m *MockRepository) List(ctx context.Context, workspaceID int, archived bool) ([]models.Board, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, workspaceID)
+	ret := m.ctrl.Call(m, "List", ctx, workspaceID, archived)
 	ret0, _ := ret[0].([]models.Board)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockRepositoryMockRecorder) List(ctx, workspaceID interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) List(ctx, workspaceID, archived interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, workspaceID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, workspaceID, archived)
 }
 
 // ListByTitle mocks base method.
@@ -125,6 +186,21 @@ func (mr *MockRepositoryMockRecorder) ListByTitle(ctx, title, userID interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTitle", reflect.TypeOf((*MockRepository)(nil).ListByTitle), ctx, title, userID)
 }
 
+// ListTemplates mocks base method.
+func (m *MockRepository) ListTemplates(ctx context.Context, userID int) ([]models.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTemplates", ctx, userID)
+	ret0, _ := ret[0].([]models.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTemplates indicates an expected call of ListTemplates.
+func (mr *MockRepositoryMockRecorder) ListTemplates(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplates", reflect.TypeOf((*MockRepository)(nil).ListTemplates), ctx, userID)
+}
+
 // PartialUpdate mocks base method.
 func (m *MockRepository) PartialUpdate(ctx context.Context, params *boards.PartialUpdateParams) (models.Board, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +216,21 @@ func (mr *MockRepositoryMockRecorder) PartialUpdate(ctx, params interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PartialUpdate", reflect.TypeOf((*MockRepository)(nil).PartialUpdate), ctx, params)
 }
 
+// SetArchived mocks base method.
+func (m *MockRepository) SetArchived(ctx context.Context, id int, archivedAt *time.Time, userID int) (models.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetArchived", ctx, id, archivedAt, userID)
+	ret0, _ := ret[0].(models.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetArchived indicates an expected call of SetArchived.
+func (mr *MockRepositoryMockRecorder) SetArchived(ctx, id, archivedAt, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetArchived", reflect.TypeOf((*MockRepository)(nil).SetArchived), ctx, id, archivedAt, userID)
+}
+
 // UpdateBackground mocks base method.
 func (m *MockRepository) UpdateBackground(ctx context.Context, id int, background string) error {
 	m.ctrl.T.Helper()
@@ -36,6 +36,36 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// Archive mocks base method.
+func (m *MockUsecase) Archive(ctx context.Context, id, userID int) (models.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", ctx, id, userID)
+	ret0, _ := ret[0].(models.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockUsecaseMockRecorder) Archive(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockUsecase)(nil).Archive), ctx, id, userID)
+}
+
+// Copy mocks base method.
+func (m *MockUsecase) Copy(ctx context.Context, params *boards.CopyParams) (models.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", ctx, params)
+	ret0, _ := ret[0].(models.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Copy indicates an expected call of Copy.
+func (mr *MockUsecaseMockRecorder) Copy(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockUsecase)(nil).Copy), ctx, params)
+}
+
 // Create mocks base method.
 func (m *MockUsecase) Create(ctx context.Context, params *boards.CreateParams) (models.Board, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +95,21 @@ func (mr *MockUsecaseMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUsecase)(nil).Delete), ctx, id)
 }
 
+// Export mocks base method.
+func (m *MockUsecase) Export(ctx context.Context, id int) (models.BoardExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, id)
+	ret0, _ := ret[0].(models.BoardExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockUsecaseMockRecorder) Export(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockUsecase)(nil).Export), ctx, id)
+}
+
 // FullUpdate mocks base method.
 func (m *MockUsecase) FullUpdate(ctx context.Context, params *boards.FullUpdateParams) (models.Board, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +140,51 @@ func (mr *MockUsecaseMockRecorder) Get(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUsecase)(nil).Get), ctx, id)
 }
 
+// GetFull mocks base method.
+func (m *MockUsecase) GetFull(ctx context.Context, id int) (models.BoardFull, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFull", ctx, id)
+	ret0, _ := ret[0].(models.BoardFull)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFull indicates an expected call of GetFull.
+func (mr *MockUsecaseMockRecorder) GetFull(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFull", reflect.TypeOf((*MockUsecase)(nil).GetFull), ctx, id)
+}
+
+// Import mocks base method.
+func (m *MockUsecase) Import(ctx context.Context, workspaceID int, export *models.BoardExport) (models.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, workspaceID, export)
+	ret0, _ := ret[0].(models.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockUsecaseMockRecorder) Import(ctx, workspaceID, export interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockUsecase)(nil).Import), ctx, workspaceID, export)
+}
+
+// Instantiate mocks base method.
+func (m *MockUsecase) Instantiate(ctx context.Context, params *boards.CopyParams) (models.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Instantiate", ctx, params)
+	ret0, _ := ret[0].(models.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Instantiate indicates an expected call of Instantiate.
+func (mr *MockUsecaseMockRecorder) Instantiate(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Instantiate", reflect.TypeOf((*MockUsecase)(nil).Instantiate), ctx, params)
+}
+
 // ListByTitle mocks base method.
 func (m *MockUsecase) ListByTitle(ctx context.Context, title string, userID int) ([]models.Board, error) {
 	m.ctrl.T.Helper()
@@ -111,18 +201,33 @@ func (mr *MockUsecaseMockRecorder) ListByTitle(ctx, title, userID interface{}) *
 }
 
 // ListByWorkspace mocks base method.
-func (m *MockUsecase) ListByWorkspace(ctx context.Context, workspaceID int) ([]models.Board, error) {
+func (m *MockUsecase) ListByWorkspace(ctx context.Context, workspaceID int, archived bool) ([]models.Board, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListByWorkspace", ctx, workspaceID)
+	ret := m.ctrl.Call(m, "ListByWorkspace", ctx, workspaceID, archived)
 	ret0, _ := ret[0].([]models.Board)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListByWorkspace indicates an expected call of ListByWorkspace.
-func (mr *MockUsecaseMockRecorder) ListByWorkspace(ctx, workspaceID interface{}) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) ListByWorkspace(ctx, workspaceID, archived interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByWorkspace", reflect.TypeOf((*MockUsecase)(nil).ListByWorkspace), ctx, workspaceID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByWorkspace", reflect.TypeOf((*MockUsecase)(nil).ListByWorkspace), ctx, workspaceID, archived)
+}
+
+// ListTemplates mocks base method.
+func (m *MockUsecase) ListTemplates(ctx context.Context, userID int) ([]models.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTemplates", ctx, userID)
+	ret0, _ := ret[0].([]models.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTemplates indicates an expected call of ListTemplates.
+func (mr *MockUsecaseMockRecorder) ListTemplates(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplates", reflect.TypeOf((*MockUsecase)(nil).ListTemplates), ctx, userID)
 }
 
 // PartialUpdate mocks base method.
@@ -140,17 +245,32 @@ func (mr *MockUsecaseMockRecorder) PartialUpdate(ctx, params interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PartialUpdate", reflect.TypeOf((*MockUsecase)(nil).PartialUpdate), ctx, params)
 }
 
+// Unarchive mocks base method.
+func (m *MockUsecase) Unarchive(ctx context.Context, id int) (models.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unarchive", ctx, id)
+	ret0, _ := ret[0].(models.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unarchive indicates an expected call of Unarchive.
+func (mr *MockUsecaseMockRecorder) Unarchive(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unarchive", reflect.TypeOf((*MockUsecase)(nil).Unarchive), ctx, id)
+}
+
 // UpdateBackground mocks base method.
-func (m *MockUsecase) UpdateBackground(ctx context.Context, id int, imgData []byte, filename string) (*models.Board, error) {
+func (m *MockUsecase) UpdateBackground(ctx context.Context, id int, imgData []byte) (*models.Board, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateBackground", ctx, id, imgData, filename)
+	ret := m.ctrl.Call(m, "UpdateBackground", ctx, id, imgData)
 	ret0, _ := ret[0].(*models.Board)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateBackground indicates an expected call of UpdateBackground.
-func (mr *MockUsecaseMockRecorder) UpdateBackground(ctx, id, imgData, filename interface{}) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) UpdateBackground(ctx, id, imgData interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBackground", reflect.TypeOf((*MockUsecase)(nil).UpdateBackground), ctx, id, imgData, filename)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBackground", reflect.TypeOf((*MockUsecase)(nil).UpdateBackground), ctx, id, imgData)
 }
@@ -3,6 +3,7 @@ package boards
 import (
 	"context"
 	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"time"
 )
 
 type CreateParams struct {
@@ -26,15 +27,52 @@ type PartialUpdateParams struct {
 	UpdateDescription bool
 	WorkspaceID       int
 	UpdateWorkspaceID bool
+	// The background is switched to BackgroundType, only the value of that
+	// type is set. Images are set by UpdateBackground only.
+	BackgroundType     string
+	BackgroundColor    *string
+	BackgroundGradient *string
+	UpdateBackground   bool
+	IsTemplate         bool
+	UpdateIsTemplate   bool
+}
+
+// CopyParams copy the board into the workspace WorkspaceID as a template when
+// IsTemplate is set. An empty Title keeps the title of the board.
+type CopyParams struct {
+	ID          int
+	WorkspaceID int
+	Title       string
+	IsTemplate  bool
 }
 
 type Repository interface {
 	Create(ctx context.Context, params *CreateParams) (models.Board, error)
-	List(ctx context.Context, workspaceID int) ([]models.Board, error)
+	// List returns the active boards of the workspace, or the archived ones with
+	// the last archived first.
+	List(ctx context.Context, workspaceID int, archived bool) ([]models.Board, error)
 	ListByTitle(ctx context.Context, title string, userID int) ([]models.Board, error)
+	// ListTemplates returns the built-in templates, then the templates of the
+	// workspaces of the user.
+	ListTemplates(ctx context.Context, userID int) ([]models.Board, error)
 	Get(ctx context.Context, id int) (models.Board, error)
+	// GetFull reads the board with its labels and its active lists and cards at
+	// once.
+	GetFull(ctx context.Context, id int) (models.BoardFull, error)
 	FullUpdate(ctx context.Context, params *FullUpdateParams) (models.Board, error)
 	PartialUpdate(ctx context.Context, params *PartialUpdateParams) (models.Board, error)
 	UpdateBackground(ctx context.Context, id int, background string) error
+	// Copy copies the board with its active lists and cards, the labels of the
+	// board and the checklists of the cards in one transaction. An uploaded
+	// background is not copied, the copy gets the default color instead.
+	Copy(ctx context.Context, params *CopyParams) (models.Board, error)
+	// Export reads the board with its labels and its active lists and cards at
+	// once, the version and the time of the export are left to the caller.
+	Export(ctx context.Context, id int) (models.BoardExport, error)
+	// Import creates the board of the export in the workspace in one transaction.
+	Import(ctx context.Context, workspaceID int, export *models.BoardExport) (models.Board, error)
+	// SetArchived archives the board by the user at archivedAt, or restores it when
+	// archivedAt is nil. An already archived board keeps its original archiving.
+	SetArchived(ctx context.Context, id int, archivedAt *time.Time, userID int) (models.Board, error)
 	Delete(ctx context.Context, id int) error
 }
@@ -4,13 +4,16 @@ import (
 	"context"
 	"database/sql"
 	pkgBoards "github.com/SlavaShagalov/my-trello-backend/internal/boards"
+	"github.com/SlavaShagalov/my-trello-backend/internal/images"
 	"github.com/SlavaShagalov/my-trello-backend/internal/models"
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
 	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/opentel"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/rank"
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
+	"time"
 )
 
 const (
@@ -29,7 +32,8 @@ func New(db *sql.DB, log *zap.Logger) pkgBoards.Repository {
 const createCmd = `
 	INSERT INTO boards (workspace_id, title, description) 
 	VALUES ($1, $2, $3)
-	RETURNING id, workspace_id, title, description, background, created_at, updated_at;`
+	RETURNING id, workspace_id, title, description, background_type, background_color, background_gradient,
+	          background, is_template, archived_at, created_at, updated_at;`
 
 func (repo *repository) Create(ctx context.Context, params *pkgBoards.CreateParams) (models.Board, error) {
 	_, span := opentel.Tracer.Start(ctx, componentName+" "+"Create")
@@ -59,18 +63,20 @@ func (repo *repository) Create(ctx context.Context, params *pkgBoards.CreatePara
 }
 
 const listCmd = `
-	SELECT id, workspace_id, title, description, background, created_at, updated_at
+	SELECT id, workspace_id, title, description, background_type, background_color, background_gradient,
+	       background, is_template, archived_at, created_at, updated_at
 	FROM boards
-	WHERE workspace_id = $1;`
+	WHERE workspace_id = $1 AND (archived_at IS NOT NULL) = $2
+	ORDER BY archived_at DESC, id;`
 
-func (repo *repository) List(ctx context.Context, workspaceID int) ([]models.Board, error) {
+func (repo *repository) List(ctx context.Context, workspaceID int, archived bool) ([]models.Board, error) {
 	_, span := opentel.Tracer.Start(ctx, componentName+" "+"List")
 	defer span.End()
 
-	rows, err := repo.db.Query(listCmd, workspaceID)
+	rows, err := repo.db.Query(listCmd, workspaceID, archived)
 	if err != nil {
 		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listCmd),
-			zap.Int("workspace_id", workspaceID))
+			zap.Int("workspace_id", workspaceID), zap.Bool("archived", archived))
 		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
 	}
 	defer func() {
@@ -79,31 +85,14 @@ func (repo *repository) List(ctx context.Context, workspaceID int) ([]models.Boa
 
 	boards := []models.Board{}
 	var board models.Board
-	var description sql.NullString
-	background := new(sql.NullString)
 	for rows.Next() {
-		err = rows.Scan(
-			&board.ID,
-			&board.WorkspaceID,
-			&board.Title,
-			&description,
-			background,
-			&board.CreatedAt,
-			&board.UpdatedAt,
-		)
+		err = scanBoard(rows, &board)
 		if err != nil {
 			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", listCmd),
 				zap.Int("workspace_id", workspaceID))
 			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
 		}
 
-		if background.Valid {
-			board.Background = &background.String
-		} else {
-			board.Background = nil
-		}
-		board.Description = description.String
-
 		boards = append(boards, board)
 	}
 
@@ -111,10 +100,11 @@ func (repo *repository) List(ctx context.Context, workspaceID int) ([]models.Boa
 }
 
 const listByTitleCmd = `
-	SELECT b.id, b.workspace_id, b.title, b.description, b.background, b.created_at, b.updated_at
+	SELECT b.id, b.workspace_id, b.title, b.description, b.background_type, b.background_color, b.background_gradient,
+	       b.background, b.is_template, b.archived_at, b.created_at, b.updated_at
 	FROM boards b 
-	JOIN workspaces w on w.id = b.workspace_id
-	WHERE lower(b.title) LIKE lower('%' || $1 || '%') AND w.user_id = $2;`
+	JOIN workspace_members m on m.workspace_id = b.workspace_id
+	WHERE lower(b.title) LIKE lower('%' || $1 || '%') AND m.user_id = $2 AND b.archived_at IS NULL;`
 
 func (repo *repository) ListByTitle(ctx context.Context, title string, userID int) ([]models.Board, error) {
 	_, span := opentel.Tracer.Start(ctx, componentName+" "+"ListByTitle")
@@ -132,30 +122,51 @@ func (repo *repository) ListByTitle(ctx context.Context, title string, userID in
 
 	boards := []models.Board{}
 	var board models.Board
-	var description sql.NullString
-	background := new(sql.NullString)
 	for rows.Next() {
-		err = rows.Scan(
-			&board.ID,
-			&board.WorkspaceID,
-			&board.Title,
-			&description,
-			background,
-			&board.CreatedAt,
-			&board.UpdatedAt,
-		)
+		err = scanBoard(rows, &board)
 		if err != nil {
 			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql", listByTitleCmd),
 				zap.String("title", title))
 			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
 		}
 
-		if background.Valid {
-			board.Background = &background.String
-		} else {
-			board.Background = nil
+		boards = append(boards, board)
+	}
+
+	return boards, nil
+}
+
+const listTemplatesCmd = `
+	SELECT id, workspace_id, title, description, background_type, background_color, background_gradient,
+	       background, is_template, archived_at, created_at, updated_at
+	FROM boards
+	WHERE is_template AND archived_at IS NULL
+	  AND (workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE user_id = $1))
+	ORDER BY workspace_id NULLS FIRST, id;`
+
+func (repo *repository) ListTemplates(ctx context.Context, userID int) ([]models.Board, error) {
+	_, span := opentel.Tracer.Start(ctx, componentName+" "+"ListTemplates")
+	defer span.End()
+
+	rows, err := repo.db.Query(listTemplatesCmd, userID)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listTemplatesCmd),
+			zap.Int("user_id", userID))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	boards := []models.Board{}
+	var board models.Board
+	for rows.Next() {
+		err = scanBoard(rows, &board)
+		if err != nil {
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", listTemplatesCmd),
+				zap.Int("user_id", userID))
+			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
 		}
-		board.Description = description.String
 
 		boards = append(boards, board)
 	}
@@ -164,7 +175,8 @@ func (repo *repository) ListByTitle(ctx context.Context, title string, userID in
 }
 
 const getCmd = `
-	SELECT id, workspace_id, title, description, background, created_at, updated_at
+	SELECT id, workspace_id, title, description, background_type, background_color, background_gradient,
+	       background, is_template, archived_at, created_at, updated_at
 	FROM boards
 	WHERE id = $1;`
 
@@ -189,13 +201,202 @@ func (repo *repository) Get(ctx context.Context, id int) (models.Board, error) {
 	return board, nil
 }
 
+const (
+	fullLabelsCmd = `
+	SELECT id, board_id, name, color, created_at, updated_at
+	FROM labels
+	WHERE board_id = $1
+	ORDER BY id;`
+
+	fullListsCmd = `
+	SELECT id, board_id, title, created_at, updated_at
+	FROM lists
+	WHERE board_id = $1 AND archived_at IS NULL
+	ORDER BY rank;`
+
+	// fullCardsCmd counts done and total checklist items and comments of the
+	// cards.
+	fullCardsCmd = `
+	SELECT c.id, c.list_id, c.title, c.content, c.start_at, c.due_at, c.completed_at, c.created_at, c.updated_at,
+	       (SELECT count(*) FILTER (WHERE i.done)
+	        FROM checklist_items i
+	        JOIN checklists ch on ch.id = i.checklist_id
+	        WHERE ch.card_id = c.id),
+	       (SELECT count(*)
+	        FROM checklist_items i
+	        JOIN checklists ch on ch.id = i.checklist_id
+	        WHERE ch.card_id = c.id),
+	       (SELECT count(*) FROM comments cm WHERE cm.card_id = c.id)
+	FROM cards c
+	JOIN lists l on l.id = c.list_id
+	WHERE l.board_id = $1 AND l.archived_at IS NULL AND c.archived_at IS NULL
+	ORDER BY l.rank, c.rank;`
+
+	fullCardLabelsCmd = `
+	SELECT cl.card_id, lb.id, lb.board_id, lb.name, lb.color, lb.created_at, lb.updated_at
+	FROM card_labels cl
+	JOIN labels lb on lb.id = cl.label_id
+	JOIN cards c on c.id = cl.card_id
+	JOIN lists l on l.id = c.list_id
+	WHERE l.board_id = $1 AND l.archived_at IS NULL AND c.archived_at IS NULL
+	ORDER BY lb.id;`
+
+	fullAssigneesCmd = `
+	SELECT a.card_id, u.id, u.username, u.name, u.avatar, a.created_at
+	FROM card_assignees a
+	JOIN users u on u.id = a.user_id
+	JOIN cards c on c.id = a.card_id
+	JOIN lists l on l.id = c.list_id
+	WHERE l.board_id = $1 AND l.archived_at IS NULL AND c.archived_at IS NULL
+	ORDER BY a.created_at, u.id;`
+)
+
+func (repo *repository) GetFull(ctx context.Context, id int) (models.BoardFull, error) {
+	ctx, span := opentel.Tracer.Start(ctx, componentName+" "+"GetFull")
+	defer span.End()
+
+	full, err := repo.getFull(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.BoardFull{}, errors.Wrap(pkgErrors.ErrBoardNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Int("board_id", id))
+		return models.BoardFull{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	return full, nil
+}
+
+// getFull reads the board in one snapshot. Cards, their labels and assignees
+// are read for the board at once and put in place by their ids. Positions are
+// the places in the order of the ranks.
+func (repo *repository) getFull(ctx context.Context, id int) (models.BoardFull, error) {
+	tx, err := repo.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return models.BoardFull{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var full models.BoardFull
+	err = scanBoard(tx.QueryRow(getCmd, id), &full.Board)
+	if err != nil {
+		return models.BoardFull{}, err
+	}
+
+	full.Labels = []models.Label{}
+	err = scanAll(tx, fullLabelsCmd, id, func(rows *sql.Rows) error {
+		var label models.Label
+		err := rows.Scan(&label.ID, &label.BoardID, &label.Name, &label.Color, &label.CreatedAt, &label.UpdatedAt)
+		if err != nil {
+			return err
+		}
+
+		full.Labels = append(full.Labels, label)
+		return nil
+	})
+	if err != nil {
+		return models.BoardFull{}, err
+	}
+
+	full.Lists = []models.ListFull{}
+	lists := map[int]int{}
+	err = scanAll(tx, fullListsCmd, id, func(rows *sql.Rows) error {
+		list := models.ListFull{Cards: []models.Card{}}
+		err := rows.Scan(&list.ID, &list.BoardID, &list.Title, &list.CreatedAt, &list.UpdatedAt)
+		if err != nil {
+			return err
+		}
+
+		list.Position = len(full.Lists) + 1
+		lists[list.ID] = len(full.Lists)
+		full.Lists = append(full.Lists, list)
+		return nil
+	})
+	if err != nil {
+		return models.BoardFull{}, err
+	}
+
+	// Cards are found by the index of their list and their index in it.
+	type place struct{ list, card int }
+	cards := map[int]place{}
+	err = scanAll(tx, fullCardsCmd, id, func(rows *sql.Rows) error {
+		card := models.Card{Labels: []models.Label{}, Assignees: []models.Assignee{}}
+		var content sql.NullString
+		var startAt, dueAt, completedAt sql.NullTime
+		err := rows.Scan(&card.ID, &card.ListID, &card.Title, &content, &startAt, &dueAt, &completedAt,
+			&card.CreatedAt, &card.UpdatedAt, &card.ChecklistProgress.Done, &card.ChecklistProgress.Total,
+			&card.CommentsCount)
+		if err != nil {
+			return err
+		}
+		card.Content = content.String
+		card.StartAt = nullTime(startAt)
+		card.DueAt = nullTime(dueAt)
+		card.CompletedAt = nullTime(completedAt)
+
+		list := &full.Lists[lists[card.ListID]]
+		card.Position = len(list.Cards) + 1
+		cards[card.ID] = place{list: lists[card.ListID], card: len(list.Cards)}
+		list.Cards = append(list.Cards, card)
+		return nil
+	})
+	if err != nil {
+		return models.BoardFull{}, err
+	}
+	card := func(cardID int) *models.Card {
+		p := cards[cardID]
+		return &full.Lists[p.list].Cards[p.card]
+	}
+
+	err = scanAll(tx, fullCardLabelsCmd, id, func(rows *sql.Rows) error {
+		var cardID int
+		var label models.Label
+		err := rows.Scan(&cardID, &label.ID, &label.BoardID, &label.Name, &label.Color, &label.CreatedAt,
+			&label.UpdatedAt)
+		if err != nil {
+			return err
+		}
+
+		c := card(cardID)
+		c.Labels = append(c.Labels, label)
+		return nil
+	})
+	if err != nil {
+		return models.BoardFull{}, err
+	}
+
+	err = scanAll(tx, fullAssigneesCmd, id, func(rows *sql.Rows) error {
+		var cardID int
+		var assignee models.Assignee
+		err := rows.Scan(&cardID, &assignee.UserID, &assignee.Username, &assignee.Name, &assignee.Avatar,
+			&assignee.AssignedAt)
+		if err != nil {
+			return err
+		}
+		assignee.Avatar = images.OptionalURL(assignee.Avatar)
+
+		c := card(cardID)
+		c.Assignees = append(c.Assignees, assignee)
+		return nil
+	})
+	if err != nil {
+		return models.BoardFull{}, err
+	}
+
+	return full, tx.Commit()
+}
+
 const fullUpdateCmd = `
 	UPDATE boards
 	SET title        = $1,
 		description  = $2,
 		workspace_id = $3
 	WHERE id = $4
-	RETURNING id, workspace_id, title, description, background, created_at, updated_at;`
+	RETURNING id, workspace_id, title, description, background_type, background_color, background_gradient,
+	          background, is_template, archived_at, created_at, updated_at;`
 
 func (repo *repository) FullUpdate(ctx context.Context, params *pkgBoards.FullUpdateParams) (models.Board, error) {
 	_, span := opentel.Tracer.Start(ctx, componentName+" "+"FullUpdate")
@@ -217,11 +418,17 @@ func (repo *repository) FullUpdate(ctx context.Context, params *pkgBoards.FullUp
 
 const partialUpdateCmd = `
 	UPDATE boards
-	SET title        = CASE WHEN $1::boolean THEN $2 ELSE title END,
-		description  = CASE WHEN $3::boolean THEN $4 ELSE description END,
-		workspace_id = CASE WHEN $5::boolean THEN $6 ELSE workspace_id END
-	WHERE id = $7
-	RETURNING id, workspace_id, title, description, background, created_at, updated_at;`
+	SET title               = CASE WHEN $1::boolean THEN $2 ELSE title END,
+		description         = CASE WHEN $3::boolean THEN $4 ELSE description END,
+		workspace_id        = CASE WHEN $5::boolean THEN $6 ELSE workspace_id END,
+		background_type     = CASE WHEN $7::boolean THEN $8 ELSE background_type END,
+		background_color    = CASE WHEN $7::boolean THEN $9 ELSE background_color END,
+		background_gradient = CASE WHEN $7::boolean THEN $10 ELSE background_gradient END,
+		background          = CASE WHEN $7::boolean THEN NULL ELSE background END,
+		is_template         = CASE WHEN $11::boolean THEN $12 ELSE is_template END
+	WHERE id = $13
+	RETURNING id, workspace_id, title, description, background_type, background_color, background_gradient,
+	          background, is_template, archived_at, created_at, updated_at;`
 
 func (repo *repository) PartialUpdate(ctx context.Context, params *pkgBoards.PartialUpdateParams) (models.Board, error) {
 	_, span := opentel.Tracer.Start(ctx, componentName+" "+"PartialUpdate")
@@ -234,6 +441,12 @@ func (repo *repository) PartialUpdate(ctx context.Context, params *pkgBoards.Par
 		params.Description,
 		params.UpdateWorkspaceID,
 		params.WorkspaceID,
+		params.UpdateBackground,
+		params.BackgroundType,
+		params.BackgroundColor,
+		params.BackgroundGradient,
+		params.UpdateIsTemplate,
+		params.IsTemplate,
 		params.ID,
 	)
 
@@ -255,7 +468,10 @@ func (repo *repository) PartialUpdate(ctx context.Context, params *pkgBoards.Par
 
 const updateBackgroundCmd = `
 	UPDATE boards
-	SET background = $1
+	SET background_type     = 'image',
+		background_color    = NULL,
+		background_gradient = NULL,
+		background          = $1
 	WHERE id = $2;`
 
 func (repo *repository) UpdateBackground(ctx context.Context, id int, background string) error {
@@ -284,6 +500,580 @@ func (repo *repository) UpdateBackground(ctx context.Context, id int, background
 	return nil
 }
 
+// copiedCards joins the active cards sc of the board $1 with their copies dc on
+// the board $2. Copies keep the ranks of their lists and cards, so they are
+// matched by rank.
+const copiedCards = `
+	FROM lists sl
+	JOIN lists dl on dl.board_id = $2 AND dl.rank = sl.rank
+	JOIN cards sc on sc.list_id = sl.id
+	JOIN cards dc on dc.list_id = dl.id AND dc.rank = sc.rank`
+
+const (
+	copyBoardCmd = `
+	INSERT INTO boards (workspace_id, title, description, is_template, background_type, background_color,
+	                    background_gradient)
+	SELECT $1::int, CASE WHEN $2 = '' THEN title ELSE $2 END, description, $3::boolean,
+	       CASE WHEN background_type = 'image' THEN 'color' ELSE background_type END,
+	       CASE WHEN background_type = 'image' THEN $4 ELSE background_color END,
+	       background_gradient
+	FROM boards
+	WHERE id = $5
+	RETURNING id, workspace_id, title, description, background_type, background_color, background_gradient,
+	          background, is_template, archived_at, created_at, updated_at;`
+
+	copyListsCmd = `
+	INSERT INTO lists (board_id, title, rank)
+	SELECT $2::int, title, rank
+	FROM lists
+	WHERE board_id = $1 AND archived_at IS NULL;`
+
+	copyCardsCmd = `
+	INSERT INTO cards (list_id, title, content, rank, start_at, due_at, completed_at)
+	SELECT dl.id, sc.title, sc.content, sc.rank, sc.start_at, sc.due_at, sc.completed_at
+	FROM lists sl
+	JOIN lists dl on dl.board_id = $2 AND dl.rank = sl.rank
+	JOIN cards sc on sc.list_id = sl.id
+	WHERE sl.board_id = $1 AND sl.archived_at IS NULL AND sc.archived_at IS NULL;`
+
+	copyLabelsCmd = `
+	INSERT INTO labels (board_id, name, color)
+	SELECT $2::int, name, color
+	FROM labels
+	WHERE board_id = $1;`
+
+	// copyCardLabelsCmd attaches the copies of the labels, the first one of the
+	// same name and color.
+	copyCardLabelsCmd = `
+	INSERT INTO card_labels (card_id, label_id)
+	SELECT DISTINCT ON (dc.id, cl.label_id) dc.id, dlb.id` + copiedCards + `
+	JOIN card_labels cl on cl.card_id = sc.id
+	JOIN labels slb on slb.id = cl.label_id
+	JOIN labels dlb on dlb.board_id = $2 AND dlb.name = slb.name AND dlb.color = slb.color
+	WHERE sl.board_id = $1 AND sl.archived_at IS NULL AND sc.archived_at IS NULL
+	ORDER BY dc.id, cl.label_id, dlb.id
+	ON CONFLICT DO NOTHING;`
+
+	copiedChecklistsCmd = `
+	SELECT ch.id, dc.id, ch.title, ch.position` + copiedCards + `
+	JOIN checklists ch on ch.card_id = sc.id
+	WHERE sl.board_id = $1 AND sl.archived_at IS NULL AND sc.archived_at IS NULL
+	ORDER BY ch.id;`
+
+	copyChecklistCmd = `
+	INSERT INTO checklists (card_id, title, position)
+	VALUES ($1, $2, $3)
+	RETURNING id;`
+
+	copyChecklistItemsCmd = `
+	INSERT INTO checklist_items (checklist_id, title, done, position)
+	SELECT $2::int, title, done, position
+	FROM checklist_items
+	WHERE checklist_id = $1;`
+)
+
+func (repo *repository) Copy(ctx context.Context, params *pkgBoards.CopyParams) (models.Board, error) {
+	_, span := opentel.Tracer.Start(ctx, componentName+" "+"Copy")
+	defer span.End()
+
+	board, err := repo.copy(params)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Board{}, errors.Wrap(pkgErrors.ErrBoardNotFound, err.Error())
+		}
+		var pgErr *pq.Error
+		if errors.As(err, &pgErr) && pgErr.Constraint == "boards_workspace_id_fkey" {
+			return models.Board{}, errors.Wrap(pkgErrors.ErrWorkspaceNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Any("params", params))
+		return models.Board{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("Board copied", zap.Int("from", params.ID), zap.Any("board", board))
+	return board, nil
+}
+
+// copy copies the board, its lists and their cards, then the labels and the
+// checklists of the cards.
+func (repo *repository) copy(params *pkgBoards.CopyParams) (models.Board, error) {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		return models.Board{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	row := tx.QueryRow(copyBoardCmd, params.WorkspaceID, params.Title, params.IsTemplate,
+		models.BackgroundColors[0], params.ID)
+
+	var board models.Board
+	err = scanBoard(row, &board)
+	if err != nil {
+		return models.Board{}, err
+	}
+
+	for _, cmd := range []string{copyListsCmd, copyCardsCmd, copyLabelsCmd, copyCardLabelsCmd} {
+		_, err = tx.Exec(cmd, params.ID, board.ID)
+		if err != nil {
+			return models.Board{}, err
+		}
+	}
+
+	err = copyChecklists(tx, params.ID, board.ID)
+	if err != nil {
+		return models.Board{}, err
+	}
+
+	return board, tx.Commit()
+}
+
+func copyChecklists(tx *sql.Tx, fromBoardID, toBoardID int) error {
+	rows, err := tx.Query(copiedChecklistsCmd, fromBoardID, toBoardID)
+	if err != nil {
+		return err
+	}
+	type checklist struct {
+		id       int
+		cardID   int
+		title    string
+		position int
+	}
+	var checklists []checklist
+	for rows.Next() {
+		var ch checklist
+		err = rows.Scan(&ch.id, &ch.cardID, &ch.title, &ch.position)
+		if err != nil {
+			_ = rows.Close()
+			return err
+		}
+		checklists = append(checklists, ch)
+	}
+	_ = rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	for _, ch := range checklists {
+		var id int
+		err = tx.QueryRow(copyChecklistCmd, ch.cardID, ch.title, ch.position).Scan(&id)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(copyChecklistItemsCmd, ch.id, id)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const (
+	exportBoardCmd = `
+	SELECT title, description, is_template,
+	       CASE WHEN background_type = 'image' THEN 'color' ELSE background_type END,
+	       CASE WHEN background_type = 'image' THEN $2 ELSE background_color END,
+	       background_gradient
+	FROM boards
+	WHERE id = $1;`
+
+	exportLabelsCmd = `
+	SELECT id, name, color
+	FROM labels
+	WHERE board_id = $1
+	ORDER BY id;`
+
+	exportListsCmd = `
+	SELECT id, title
+	FROM lists
+	WHERE board_id = $1 AND archived_at IS NULL
+	ORDER BY rank;`
+
+	exportCardsCmd = `
+	SELECT c.id, c.list_id, c.title, c.content, c.start_at, c.due_at, c.completed_at
+	FROM cards c
+	JOIN lists l on l.id = c.list_id
+	WHERE l.board_id = $1 AND l.archived_at IS NULL AND c.archived_at IS NULL
+	ORDER BY l.rank, c.rank;`
+
+	exportCardLabelsCmd = `
+	SELECT cl.card_id, cl.label_id
+	FROM card_labels cl
+	JOIN cards c on c.id = cl.card_id
+	JOIN lists l on l.id = c.list_id
+	WHERE l.board_id = $1 AND l.archived_at IS NULL AND c.archived_at IS NULL
+	ORDER BY cl.label_id;`
+
+	exportChecklistsCmd = `
+	SELECT ch.id, ch.card_id, ch.title
+	FROM checklists ch
+	JOIN cards c on c.id = ch.card_id
+	JOIN lists l on l.id = c.list_id
+	WHERE l.board_id = $1 AND l.archived_at IS NULL AND c.archived_at IS NULL
+	ORDER BY ch.position, ch.id;`
+
+	exportChecklistItemsCmd = `
+	SELECT i.checklist_id, i.title, i.done
+	FROM checklist_items i
+	JOIN checklists ch on ch.id = i.checklist_id
+	JOIN cards c on c.id = ch.card_id
+	JOIN lists l on l.id = c.list_id
+	WHERE l.board_id = $1 AND l.archived_at IS NULL AND c.archived_at IS NULL
+	ORDER BY i.position, i.id;`
+)
+
+func (repo *repository) Export(ctx context.Context, id int) (models.BoardExport, error) {
+	ctx, span := opentel.Tracer.Start(ctx, componentName+" "+"Export")
+	defer span.End()
+
+	export, err := repo.export(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.BoardExport{}, errors.Wrap(pkgErrors.ErrBoardNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Int("board_id", id))
+		return models.BoardExport{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	return export, nil
+}
+
+// export reads the whole board in one snapshot. Cards, their labels and
+// checklists are read for the board at once and put in place by their ids.
+func (repo *repository) export(ctx context.Context, id int) (models.BoardExport, error) {
+	tx, err := repo.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return models.BoardExport{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var export models.BoardExport
+	var description, color, gradient sql.NullString
+	err = tx.QueryRow(exportBoardCmd, id, models.BackgroundColors[0]).Scan(&export.Title, &description,
+		&export.IsTemplate, &export.BackgroundType, &color, &gradient)
+	if err != nil {
+		return models.BoardExport{}, err
+	}
+	export.Description = description.String
+	export.BackgroundColor = nullString(color)
+	export.BackgroundGradient = nullString(gradient)
+
+	export.Labels = []models.ExportLabel{}
+	labels := map[int]int{}
+	err = scanAll(tx, exportLabelsCmd, id, func(rows *sql.Rows) error {
+		var labelID int
+		var label models.ExportLabel
+		err := rows.Scan(&labelID, &label.Name, &label.Color)
+		if err != nil {
+			return err
+		}
+
+		labels[labelID] = len(export.Labels)
+		export.Labels = append(export.Labels, label)
+		return nil
+	})
+	if err != nil {
+		return models.BoardExport{}, err
+	}
+
+	export.Lists = []models.ExportList{}
+	lists := map[int]int{}
+	err = scanAll(tx, exportListsCmd, id, func(rows *sql.Rows) error {
+		var listID int
+		list := models.ExportList{Cards: []models.ExportCard{}}
+		err := rows.Scan(&listID, &list.Title)
+		if err != nil {
+			return err
+		}
+
+		lists[listID] = len(export.Lists)
+		export.Lists = append(export.Lists, list)
+		return nil
+	})
+	if err != nil {
+		return models.BoardExport{}, err
+	}
+
+	// Cards are found by the index of their list and their index in it.
+	type place struct{ list, card int }
+	cards := map[int]place{}
+	err = scanAll(tx, exportCardsCmd, id, func(rows *sql.Rows) error {
+		var cardID, listID int
+		var content sql.NullString
+		var startAt, dueAt, completedAt sql.NullTime
+		card := models.ExportCard{Labels: []int{}, Checklists: []models.ExportChecklist{}}
+		err := rows.Scan(&cardID, &listID, &card.Title, &content, &startAt, &dueAt, &completedAt)
+		if err != nil {
+			return err
+		}
+		card.Content = content.String
+		card.StartAt = nullTime(startAt)
+		card.DueAt = nullTime(dueAt)
+		card.CompletedAt = nullTime(completedAt)
+
+		list := &export.Lists[lists[listID]]
+		cards[cardID] = place{list: lists[listID], card: len(list.Cards)}
+		list.Cards = append(list.Cards, card)
+		return nil
+	})
+	if err != nil {
+		return models.BoardExport{}, err
+	}
+	card := func(cardID int) *models.ExportCard {
+		p := cards[cardID]
+		return &export.Lists[p.list].Cards[p.card]
+	}
+
+	err = scanAll(tx, exportCardLabelsCmd, id, func(rows *sql.Rows) error {
+		var cardID, labelID int
+		err := rows.Scan(&cardID, &labelID)
+		if err != nil {
+			return err
+		}
+
+		// Labels of other boards are left on moved cards, they are not exported.
+		if label, ok := labels[labelID]; ok {
+			c := card(cardID)
+			c.Labels = append(c.Labels, label)
+		}
+		return nil
+	})
+	if err != nil {
+		return models.BoardExport{}, err
+	}
+
+	type checklistPlace struct {
+		cardID    int
+		checklist int
+	}
+	checklists := map[int]checklistPlace{}
+	err = scanAll(tx, exportChecklistsCmd, id, func(rows *sql.Rows) error {
+		var checklistID, cardID int
+		checklist := models.ExportChecklist{Items: []models.ExportChecklistItem{}}
+		err := rows.Scan(&checklistID, &cardID, &checklist.Title)
+		if err != nil {
+			return err
+		}
+
+		c := card(cardID)
+		checklists[checklistID] = checklistPlace{cardID: cardID, checklist: len(c.Checklists)}
+		c.Checklists = append(c.Checklists, checklist)
+		return nil
+	})
+	if err != nil {
+		return models.BoardExport{}, err
+	}
+
+	err = scanAll(tx, exportChecklistItemsCmd, id, func(rows *sql.Rows) error {
+		var checklistID int
+		var item models.ExportChecklistItem
+		err := rows.Scan(&checklistID, &item.Title, &item.Done)
+		if err != nil {
+			return err
+		}
+
+		p := checklists[checklistID]
+		checklist := &card(p.cardID).Checklists[p.checklist]
+		checklist.Items = append(checklist.Items, item)
+		return nil
+	})
+	if err != nil {
+		return models.BoardExport{}, err
+	}
+
+	return export, tx.Commit()
+}
+
+// scanAll runs the query with the id and scans every row of it.
+func scanAll(tx *sql.Tx, query string, id int, scan func(rows *sql.Rows) error) error {
+	rows, err := tx.Query(query, id)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	for rows.Next() {
+		err = scan(rows)
+		if err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+const (
+	importBoardCmd = `
+	INSERT INTO boards (workspace_id, title, description, is_template, background_type, background_color,
+	                    background_gradient)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	RETURNING id, workspace_id, title, description, background_type, background_color, background_gradient,
+	          background, is_template, archived_at, created_at, updated_at;`
+
+	importLabelCmd = `
+	INSERT INTO labels (board_id, name, color)
+	VALUES ($1, $2, $3)
+	RETURNING id;`
+
+	importListCmd = `
+	INSERT INTO lists (board_id, title, rank)
+	VALUES ($1, $2, $3)
+	RETURNING id;`
+
+	importCardCmd = `
+	INSERT INTO cards (list_id, title, content, rank, start_at, due_at, completed_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	RETURNING id;`
+
+	importCardLabelCmd = `
+	INSERT INTO card_labels (card_id, label_id)
+	VALUES ($1, $2)
+	ON CONFLICT DO NOTHING;`
+
+	importChecklistItemCmd = `
+	INSERT INTO checklist_items (checklist_id, title, done, position)
+	VALUES ($1, $2, $3, $4);`
+)
+
+func (repo *repository) Import(ctx context.Context, workspaceID int, export *models.BoardExport) (models.Board, error) {
+	_, span := opentel.Tracer.Start(ctx, componentName+" "+"Import")
+	defer span.End()
+
+	board, err := repo.importBoard(workspaceID, export)
+	if err != nil {
+		var pgErr *pq.Error
+		if errors.As(err, &pgErr) {
+			switch pgErr.Constraint {
+			case "boards_workspace_id_fkey":
+				return models.Board{}, errors.Wrap(pkgErrors.ErrWorkspaceNotFound, err.Error())
+			case "cards_dates_check":
+				return models.Board{}, errors.Wrap(pkgErrors.ErrInvalidCardDates, err.Error())
+			}
+		}
+
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Int("workspace_id", workspaceID))
+		return models.Board{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("Board imported", zap.Any("board", board))
+	return board, nil
+}
+
+// importBoard creates the board and its labels, then the lists with their
+// cards. Lists and cards get ranks spread evenly in the order of the export.
+func (repo *repository) importBoard(workspaceID int, export *models.BoardExport) (models.Board, error) {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		return models.Board{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	row := tx.QueryRow(importBoardCmd, workspaceID, export.Title, export.Description, export.IsTemplate,
+		export.BackgroundType, export.BackgroundColor, export.BackgroundGradient)
+
+	var board models.Board
+	err = scanBoard(row, &board)
+	if err != nil {
+		return models.Board{}, err
+	}
+
+	labelIDs := make([]int, len(export.Labels))
+	for i, label := range export.Labels {
+		err = tx.QueryRow(importLabelCmd, board.ID, label.Name, label.Color).Scan(&labelIDs[i])
+		if err != nil {
+			return models.Board{}, err
+		}
+	}
+
+	ranks := rank.Spread(len(export.Lists))
+	for i, list := range export.Lists {
+		var listID int
+		err = tx.QueryRow(importListCmd, board.ID, list.Title, ranks[i]).Scan(&listID)
+		if err != nil {
+			return models.Board{}, err
+		}
+
+		err = importCards(tx, listID, list.Cards, labelIDs)
+		if err != nil {
+			return models.Board{}, err
+		}
+	}
+
+	return board, tx.Commit()
+}
+
+func importCards(tx *sql.Tx, listID int, cards []models.ExportCard, labelIDs []int) error {
+	ranks := rank.Spread(len(cards))
+	for i, card := range cards {
+		var cardID int
+		err := tx.QueryRow(importCardCmd, listID, card.Title, card.Content, ranks[i], card.StartAt, card.DueAt,
+			card.CompletedAt).Scan(&cardID)
+		if err != nil {
+			return err
+		}
+
+		for _, label := range card.Labels {
+			_, err = tx.Exec(importCardLabelCmd, cardID, labelIDs[label])
+			if err != nil {
+				return err
+			}
+		}
+
+		for j, checklist := range card.Checklists {
+			var checklistID int
+			err = tx.QueryRow(copyChecklistCmd, cardID, checklist.Title, j+1).Scan(&checklistID)
+			if err != nil {
+				return err
+			}
+
+			for k, item := range checklist.Items {
+				_, err = tx.Exec(importChecklistItemCmd, checklistID, item.Title, item.Done, k+1)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+const setArchivedCmd = `
+	UPDATE boards
+	SET archived_at = CASE WHEN $1::timestamp IS NULL THEN NULL ELSE COALESCE(archived_at, $1) END,
+		archived_by = CASE WHEN $1::timestamp IS NULL THEN NULL WHEN archived_at IS NULL THEN $3 ELSE archived_by END
+	WHERE id = $2
+	RETURNING id, workspace_id, title, description, background_type, background_color, background_gradient,
+	          background, is_template, archived_at, created_at, updated_at;`
+
+func (repo *repository) SetArchived(ctx context.Context, id int, archivedAt *time.Time, userID int) (models.Board, error) {
+	_, span := opentel.Tracer.Start(ctx, componentName+" "+"SetArchived")
+	defer span.End()
+
+	row := repo.db.QueryRow(setArchivedCmd, archivedAt, id, userID)
+
+	var board models.Board
+	err := scanBoard(row, &board)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Board{}, errors.Wrap(pkgErrors.ErrBoardNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", setArchivedCmd),
+			zap.Int("id", id))
+		return models.Board{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("Board archiving updated", zap.Any("board", board))
+	return board, nil
+}
+
 const deleteCmd = `
 	DELETE FROM boards 
 	WHERE id = $1;`
@@ -314,15 +1104,25 @@ func (repo *repository) Delete(ctx context.Context, id int) error {
 	return nil
 }
 
-func scanBoard(row *sql.Row, board *models.Board) error {
-	var description sql.NullString
-	background := new(sql.NullString)
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanBoard(row scanner, board *models.Board) error {
+	var workspaceID sql.NullInt64
+	var description, color, gradient, background sql.NullString
+	var archivedAt sql.NullTime
 	err := row.Scan(
 		&board.ID,
-		&board.WorkspaceID,
+		&workspaceID,
 		&board.Title,
 		&description,
-		background,
+		&board.BackgroundType,
+		&color,
+		&gradient,
+		&background,
+		&board.IsTemplate,
+		&archivedAt,
 		&board.CreatedAt,
 		&board.UpdatedAt,
 	)
@@ -330,12 +1130,41 @@ func scanBoard(row *sql.Row, board *models.Board) error {
 		return err
 	}
 
+	board.BackgroundColor = nil
+	if color.Valid {
+		board.BackgroundColor = &color.String
+	}
+	board.BackgroundGradient = nil
+	if gradient.Valid {
+		board.BackgroundGradient = &gradient.String
+	}
+	board.BackgroundKey = nil
 	if background.Valid {
-		board.Background = &background.String
-	} else {
-		board.Background = nil
+		board.BackgroundKey = &background.String
+	}
+	board.Background = images.OptionalURL(board.BackgroundKey)
+	board.BackgroundThumbnails = images.ThumbnailURLs(board.BackgroundKey, images.BackgroundThumbnails)
+
+	board.ArchivedAt = nil
+	if archivedAt.Valid {
+		board.ArchivedAt = &archivedAt.Time
 	}
 
+	board.WorkspaceID = int(workspaceID.Int64)
 	board.Description = description.String
 	return nil
 }
+
+func nullString(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
+
+func nullTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
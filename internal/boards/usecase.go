@@ -7,11 +7,23 @@ import (
 
 type Usecase interface {
 	Create(ctx context.Context, params *CreateParams) (models.Board, error)
-	ListByWorkspace(ctx context.Context, workspaceID int) ([]models.Board, error)
+	ListByWorkspace(ctx context.Context, workspaceID int, archived bool) ([]models.Board, error)
 	ListByTitle(ctx context.Context, title string, userID int) ([]models.Board, error)
+	ListTemplates(ctx context.Context, userID int) ([]models.Board, error)
 	Get(ctx context.Context, id int) (models.Board, error)
+	GetFull(ctx context.Context, id int) (models.BoardFull, error)
 	FullUpdate(ctx context.Context, params *FullUpdateParams) (models.Board, error)
 	PartialUpdate(ctx context.Context, params *PartialUpdateParams) (models.Board, error)
-	UpdateBackground(ctx context.Context, id int, imgData []byte, filename string) (*models.Board, error)
+	UpdateBackground(ctx context.Context, id int, imgData []byte) (*models.Board, error)
+	Copy(ctx context.Context, params *CopyParams) (models.Board, error)
+	// Instantiate copies the template into a regular board. Boards that are no
+	// templates are not found.
+	Instantiate(ctx context.Context, params *CopyParams) (models.Board, error)
+	Export(ctx context.Context, id int) (models.BoardExport, error)
+	// Import creates a new board in the workspace from an export of the current
+	// version.
+	Import(ctx context.Context, workspaceID int, export *models.BoardExport) (models.Board, error)
+	Archive(ctx context.Context, id, userID int) (models.Board, error)
+	Unarchive(ctx context.Context, id int) (models.Board, error)
 	Delete(ctx context.Context, id int) error
 }
@@ -3,11 +3,18 @@ package usecase
 import (
 	"context"
 	"github.com/SlavaShagalov/my-trello-backend/internal/boards"
+	"github.com/SlavaShagalov/my-trello-backend/internal/events"
 	"github.com/SlavaShagalov/my-trello-backend/internal/images"
 	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/opentel"
 	"github.com/google/uuid"
-	"path/filepath"
+	"github.com/pkg/errors"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 const (
@@ -16,15 +23,19 @@ const (
 	componentName = "Boards Usecase"
 )
 
+var colorRe = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
 type usecase struct {
 	repo    boards.Repository
 	imgRepo images.Repository
+	bus     events.Bus
 }
 
-func New(repo boards.Repository, imgRepo images.Repository) boards.Usecase {
+func New(repo boards.Repository, imgRepo images.Repository, bus events.Bus) boards.Usecase {
 	return &usecase{
 		repo:    repo,
 		imgRepo: imgRepo,
+		bus:     bus,
 	}
 }
 
@@ -35,11 +46,11 @@ func (uc *usecase) Create(ctx context.Context, params *boards.CreateParams) (mod
 	return uc.repo.Create(ctx, params)
 }
 
-func (uc *usecase) ListByWorkspace(ctx context.Context, userID int) ([]models.Board, error) {
+func (uc *usecase) ListByWorkspace(ctx context.Context, workspaceID int, archived bool) ([]models.Board, error) {
 	ctx, span := opentel.Tracer.Start(ctx, componentName+" "+"ListByWorkspace")
 	defer span.End()
 
-	return uc.repo.List(ctx, userID)
+	return uc.repo.List(ctx, workspaceID, archived)
 }
 
 func (uc *usecase) ListByTitle(ctx context.Context, title string, userID int) ([]models.Board, error) {
@@ -49,6 +60,13 @@ func (uc *usecase) ListByTitle(ctx context.Context, title string, userID int) ([
 	return uc.repo.ListByTitle(ctx, title, userID)
 }
 
+func (uc *usecase) ListTemplates(ctx context.Context, userID int) ([]models.Board, error) {
+	ctx, span := opentel.Tracer.Start(ctx, componentName+" "+"ListTemplates")
+	defer span.End()
+
+	return uc.repo.ListTemplates(ctx, userID)
+}
+
 func (uc *usecase) Get(ctx context.Context, id int) (models.Board, error) {
 	ctx, span := opentel.Tracer.Start(ctx, componentName+" "+"Get")
 	defer span.End()
@@ -56,48 +74,312 @@ func (uc *usecase) Get(ctx context.Context, id int) (models.Board, error) {
 	return uc.repo.Get(ctx, id)
 }
 
+func (uc *usecase) GetFull(ctx context.Context, id int) (models.BoardFull, error) {
+	ctx, span := opentel.Tracer.Start(ctx, componentName+" "+"GetFull")
+	defer span.End()
+
+	return uc.repo.GetFull(ctx, id)
+}
+
 func (uc *usecase) FullUpdate(ctx context.Context, params *boards.FullUpdateParams) (models.Board, error) {
 	ctx, span := opentel.Tracer.Start(ctx, componentName+" "+"FullUpdate")
 	defer span.End()
 
-	return uc.repo.FullUpdate(ctx, params)
+	board, err := uc.repo.FullUpdate(ctx, params)
+	if err != nil {
+		return board, err
+	}
+
+	uc.bus.Publish(events.New(models.EventBoardUpdated, board.ID, board))
+	return board, nil
 }
 
 func (uc *usecase) PartialUpdate(ctx context.Context, params *boards.PartialUpdateParams) (models.Board, error) {
 	ctx, span := opentel.Tracer.Start(ctx, componentName+" "+"PartialUpdate")
 	defer span.End()
 
-	return uc.repo.PartialUpdate(ctx, params)
+	var oldKey *string
+	if params.UpdateBackground {
+		validated, err := validateBackground(params)
+		if err != nil {
+			return models.Board{}, err
+		}
+		params = validated
+
+		// The uploaded image is dropped when the background is switched.
+		board, err := uc.repo.Get(ctx, params.ID)
+		if err != nil {
+			return models.Board{}, err
+		}
+		oldKey = board.BackgroundKey
+	}
+
+	board, err := uc.repo.PartialUpdate(ctx, params)
+	if err != nil {
+		return board, err
+	}
+
+	if oldKey != nil {
+		_ = images.Remove(uc.imgRepo, *oldKey, images.BackgroundThumbnails)
+	}
+
+	uc.bus.Publish(events.New(models.EventBoardUpdated, board.ID, board))
+	return board, nil
 }
 
-func (uc *usecase) UpdateBackground(ctx context.Context, id int, imgData []byte, filename string) (*models.Board, error) {
+func (uc *usecase) UpdateBackground(ctx context.Context, id int, imgData []byte) (*models.Board, error) {
 	ctx, span := opentel.Tracer.Start(ctx, componentName+" "+"UpdateBackground")
 	defer span.End()
 
+	img, err := images.Prepare(imgData, images.BackgroundThumbnails)
+	if err != nil {
+		return nil, err
+	}
+
 	board, err := uc.repo.Get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	if board.Background == nil {
-		imgName := backgroundsFolder + "/" + uuid.NewString() + filepath.Ext(filename)
-		imgPath, err := uc.imgRepo.Create(imgName, imgData)
-		if err == nil {
-			err = uc.repo.UpdateBackground(ctx, id, imgPath)
-			if err == nil {
-				board.Background = &imgPath
-			}
-		}
-	} else {
-		err = uc.imgRepo.Update(*board.Background, imgData)
+	// A new background always gets a new key, so caches never serve the old one.
+	key := backgroundsFolder + "/" + uuid.NewString() + img.Ext
+	err = images.Store(uc.imgRepo, key, &img)
+	if err != nil {
+		return nil, err
 	}
 
-	return &board, err
+	err = uc.repo.UpdateBackground(ctx, id, key)
+	if err != nil {
+		_ = images.Remove(uc.imgRepo, key, images.BackgroundThumbnails)
+		return nil, err
+	}
+
+	// The board is updated already, a failure here only leaves unused files.
+	if board.BackgroundKey != nil {
+		_ = images.Remove(uc.imgRepo, *board.BackgroundKey, images.BackgroundThumbnails)
+	}
+
+	board.BackgroundType = models.BackgroundTypeImage
+	board.BackgroundColor = nil
+	board.BackgroundGradient = nil
+	board.BackgroundKey = &key
+	board.Background = images.OptionalURL(board.BackgroundKey)
+	board.BackgroundThumbnails = images.ThumbnailURLs(board.BackgroundKey, images.BackgroundThumbnails)
+	uc.bus.Publish(events.New(models.EventBoardUpdated, board.ID, board))
+	return &board, nil
+}
+
+func (uc *usecase) Copy(ctx context.Context, params *boards.CopyParams) (models.Board, error) {
+	ctx, span := opentel.Tracer.Start(ctx, componentName+" "+"Copy")
+	defer span.End()
+
+	return uc.repo.Copy(ctx, params)
+}
+
+func (uc *usecase) Instantiate(ctx context.Context, params *boards.CopyParams) (models.Board, error) {
+	ctx, span := opentel.Tracer.Start(ctx, componentName+" "+"Instantiate")
+	defer span.End()
+
+	template, err := uc.repo.Get(ctx, params.ID)
+	if errors.Is(err, pkgErrors.ErrBoardNotFound) || err == nil && !template.IsTemplate {
+		return models.Board{}, pkgErrors.ErrTemplateNotFound
+	}
+	if err != nil {
+		return models.Board{}, err
+	}
+
+	instance := *params
+	instance.IsTemplate = false
+	return uc.repo.Copy(ctx, &instance)
+}
+
+func (uc *usecase) Export(ctx context.Context, id int) (models.BoardExport, error) {
+	ctx, span := opentel.Tracer.Start(ctx, componentName+" "+"Export")
+	defer span.End()
+
+	export, err := uc.repo.Export(ctx, id)
+	if err != nil {
+		return export, err
+	}
+
+	export.Version = models.BoardExportVersion
+	export.ExportedAt = time.Now().UTC()
+	return export, nil
+}
+
+func (uc *usecase) Import(ctx context.Context, workspaceID int, export *models.BoardExport) (models.Board, error) {
+	ctx, span := opentel.Tracer.Start(ctx, componentName+" "+"Import")
+	defer span.End()
+
+	validated, err := validateExport(export)
+	if err != nil {
+		return models.Board{}, err
+	}
+
+	return uc.repo.Import(ctx, workspaceID, validated)
+}
+
+func (uc *usecase) Archive(ctx context.Context, id, userID int) (models.Board, error) {
+	ctx, span := opentel.Tracer.Start(ctx, componentName+" "+"Archive")
+	defer span.End()
+
+	now := time.Now().UTC()
+	return uc.setArchived(ctx, id, &now, userID)
+}
+
+func (uc *usecase) Unarchive(ctx context.Context, id int) (models.Board, error) {
+	ctx, span := opentel.Tracer.Start(ctx, componentName+" "+"Unarchive")
+	defer span.End()
+
+	return uc.setArchived(ctx, id, nil, 0)
+}
+
+func (uc *usecase) setArchived(ctx context.Context, id int, archivedAt *time.Time, userID int) (models.Board, error) {
+	board, err := uc.repo.SetArchived(ctx, id, archivedAt, userID)
+	if err != nil {
+		return board, err
+	}
+
+	uc.bus.Publish(events.New(models.EventBoardUpdated, board.ID, board))
+	return board, nil
 }
 
 func (uc *usecase) Delete(ctx context.Context, id int) error {
 	ctx, span := opentel.Tracer.Start(ctx, componentName+" "+"Delete")
 	defer span.End()
 
-	return uc.repo.Delete(ctx, id)
+	board, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = uc.repo.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if board.BackgroundKey != nil {
+		_ = images.Remove(uc.imgRepo, *board.BackgroundKey, images.BackgroundThumbnails)
+	}
+
+	uc.bus.Publish(events.New(models.EventBoardDeleted, id, map[string]int{"id": id}))
+	return nil
+}
+
+// validateBackground checks the background a board is switched to. The color
+// is lowercased and the value of the other type is dropped.
+func validateBackground(params *boards.PartialUpdateParams) (*boards.PartialUpdateParams, error) {
+	validated := *params
+	switch params.BackgroundType {
+	case models.BackgroundTypeColor:
+		if params.BackgroundColor == nil {
+			return nil, pkgErrors.ErrInvalidBackgroundColor
+		}
+		color := strings.ToLower(strings.TrimSpace(*params.BackgroundColor))
+		if !colorRe.MatchString(color) {
+			return nil, pkgErrors.ErrInvalidBackgroundColor
+		}
+		validated.BackgroundColor = &color
+		validated.BackgroundGradient = nil
+	case models.BackgroundTypeGradient:
+		if params.BackgroundGradient == nil || !models.IsBackgroundGradient(*params.BackgroundGradient) {
+			return nil, pkgErrors.ErrInvalidBackgroundGradient
+		}
+		validated.BackgroundColor = nil
+	default:
+		return nil, pkgErrors.ErrInvalidBackgroundType
+	}
+	return &validated, nil
+}
+
+// validateExport checks the export against the current version and the rules
+// the board would be created by otherwise. Backgrounds, label colors and
+// checklist titles are normalized like in the updates.
+func validateExport(export *models.BoardExport) (*models.BoardExport, error) {
+	if export.Version != models.BoardExportVersion {
+		return nil, pkgErrors.ErrBadExportVersion
+	}
+
+	validated := *export
+	background, err := validateBackground(&boards.PartialUpdateParams{
+		BackgroundType:     export.BackgroundType,
+		BackgroundColor:    export.BackgroundColor,
+		BackgroundGradient: export.BackgroundGradient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	validated.BackgroundColor = background.BackgroundColor
+	validated.BackgroundGradient = background.BackgroundGradient
+
+	validated.Labels = make([]models.ExportLabel, len(export.Labels))
+	for i, label := range export.Labels {
+		label.Name = strings.TrimSpace(label.Name)
+		if utf8.RuneCountInString(label.Name) > constants.MaxLabelNameLen {
+			return nil, pkgErrors.ErrTooLongLabelName
+		}
+		label.Color = strings.ToLower(strings.TrimSpace(label.Color))
+		if !colorRe.MatchString(label.Color) {
+			return nil, pkgErrors.ErrInvalidLabelColor
+		}
+		validated.Labels[i] = label
+	}
+
+	validated.Lists = make([]models.ExportList, len(export.Lists))
+	for i, list := range export.Lists {
+		cards := make([]models.ExportCard, len(list.Cards))
+		for j, card := range list.Cards {
+			attached := make(map[int]bool, len(card.Labels))
+			for _, label := range card.Labels {
+				if label < 0 || label >= len(export.Labels) || attached[label] {
+					return nil, pkgErrors.ErrBadExportLabel
+				}
+				attached[label] = true
+			}
+
+			card.Checklists, err = validateChecklists(card.Checklists)
+			if err != nil {
+				return nil, err
+			}
+			cards[j] = card
+		}
+		list.Cards = cards
+		validated.Lists[i] = list
+	}
+
+	return &validated, nil
+}
+
+func validateChecklists(checklists []models.ExportChecklist) ([]models.ExportChecklist, error) {
+	validated := make([]models.ExportChecklist, len(checklists))
+	for i, checklist := range checklists {
+		title, err := validateChecklistTitle(checklist.Title)
+		if err != nil {
+			return nil, err
+		}
+		checklist.Title = title
+
+		items := make([]models.ExportChecklistItem, len(checklist.Items))
+		for j, item := range checklist.Items {
+			item.Title, err = validateChecklistTitle(item.Title)
+			if err != nil {
+				return nil, err
+			}
+			items[j] = item
+		}
+		checklist.Items = items
+		validated[i] = checklist
+	}
+	return validated, nil
+}
+
+func validateChecklistTitle(title string) (string, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return "", pkgErrors.ErrEmptyChecklistTitle
+	}
+	if utf8.RuneCountInString(title) > constants.MaxChecklistTitleLen {
+		return "", pkgErrors.ErrTooLongChecklistTitle
+	}
+	return title, nil
 }
@@ -1,21 +1,32 @@
 package usecase
 
 import (
+	"context"
 	pkgBoards "github.com/SlavaShagalov/my-trello-backend/internal/boards"
 	"github.com/SlavaShagalov/my-trello-backend/internal/boards/mocks"
+	eventsMocks "github.com/SlavaShagalov/my-trello-backend/internal/events/mocks"
 	imgMocks "github.com/SlavaShagalov/my-trello-backend/internal/images/mocks"
 	"github.com/SlavaShagalov/my-trello-backend/internal/models"
 	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/opentel"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace/noop"
+	"os"
 	"reflect"
 	"testing"
 )
 
+func TestMain(m *testing.M) {
+	opentel.Tracer = noop.NewTracerProvider().Tracer("")
+	os.Exit(m.Run())
+}
+
 func TestUsecase_Create(t *testing.T) {
 	type fields struct {
 		repo    *mocks.MockRepository
 		imgRepo *imgMocks.MockRepository
+		bus     *eventsMocks.MockBus
 		params  *pkgBoards.CreateParams
 		board   *models.Board
 	}
@@ -30,7 +41,7 @@ func TestUsecase_Create(t *testing.T) {
 	tests := map[string]testCase{
 		"normal": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().Create(f.params).Return(*f.board, nil)
+				f.repo.EXPECT().Create(gomock.Any(), f.params).Return(*f.board, nil)
 			},
 			params: &pkgBoards.CreateParams{
 				Title:       "University",
@@ -47,7 +58,7 @@ func TestUsecase_Create(t *testing.T) {
 		},
 		"workspace not found": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().Create(f.params).Return(*f.board, pkgErrors.ErrWorkspaceNotFound)
+				f.repo.EXPECT().Create(gomock.Any(), f.params).Return(*f.board, pkgErrors.ErrWorkspaceNotFound)
 			},
 			params: &pkgBoards.CreateParams{Title: "University", Description: "University Board", WorkspaceID: 27},
 			board:  models.Board{},
@@ -55,7 +66,7 @@ func TestUsecase_Create(t *testing.T) {
 		},
 		"storages error": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().Create(f.params).Return(*f.board, pkgErrors.ErrDb)
+				f.repo.EXPECT().Create(gomock.Any(), f.params).Return(*f.board, pkgErrors.ErrDb)
 			},
 			params: &pkgBoards.CreateParams{Title: "University", Description: "University Board", WorkspaceID: 27},
 			board:  models.Board{},
@@ -74,18 +85,19 @@ func TestUsecase_Create(t *testing.T) {
 			f := fields{
 				repo:    mocks.NewMockRepository(ctrl),
 				imgRepo: imgMocks.NewMockRepository(ctrl),
+				bus:     eventsMocks.NewMockBus(ctrl),
 				params:  test.params, board: &test.board,
 			}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := New(f.repo, f.imgRepo)
-			board, err := uc.Create(test.params)
+			uc := New(f.repo, f.imgRepo, f.bus)
+			board, err := uc.Create(context.Background(), test.params)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if board != test.board {
+			if !reflect.DeepEqual(board, test.board) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.board, board)
 			}
 		})
@@ -96,6 +108,7 @@ func TestUsecase_List(t *testing.T) {
 	type fields struct {
 		repo        *mocks.MockRepository
 		imgRepo     *imgMocks.MockRepository
+		bus         *eventsMocks.MockBus
 		workspaceID int
 		boards      []models.Board
 	}
@@ -110,7 +123,7 @@ func TestUsecase_List(t *testing.T) {
 	tests := map[string]testCase{
 		"normal": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().List(f.workspaceID).Return(f.boards, nil)
+				f.repo.EXPECT().List(gomock.Any(), f.workspaceID, false).Return(f.boards, nil)
 			},
 			workspaceID: 27,
 			boards: []models.Board{
@@ -122,7 +135,7 @@ func TestUsecase_List(t *testing.T) {
 		},
 		"empty result": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().List(f.workspaceID).Return(f.boards, nil)
+				f.repo.EXPECT().List(gomock.Any(), f.workspaceID, false).Return(f.boards, nil)
 			},
 			workspaceID: 27,
 			boards:      []models.Board{},
@@ -130,7 +143,7 @@ func TestUsecase_List(t *testing.T) {
 		},
 		"board not found": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().List(f.workspaceID).Return(f.boards, pkgErrors.ErrWorkspaceNotFound)
+				f.repo.EXPECT().List(gomock.Any(), f.workspaceID, false).Return(f.boards, pkgErrors.ErrWorkspaceNotFound)
 			},
 			workspaceID: 27,
 			boards:      nil,
@@ -138,7 +151,7 @@ func TestUsecase_List(t *testing.T) {
 		},
 		"storages error": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().List(f.workspaceID).Return(f.boards, pkgErrors.ErrDb)
+				f.repo.EXPECT().List(gomock.Any(), f.workspaceID, false).Return(f.boards, pkgErrors.ErrDb)
 			},
 			workspaceID: 27,
 			boards:      nil,
@@ -157,13 +170,14 @@ func TestUsecase_List(t *testing.T) {
 			f := fields{
 				repo:        mocks.NewMockRepository(ctrl),
 				imgRepo:     imgMocks.NewMockRepository(ctrl),
+				bus:         eventsMocks.NewMockBus(ctrl),
 				workspaceID: test.workspaceID, boards: test.boards}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			serv := New(f.repo, f.imgRepo)
-			boards, err := serv.ListByWorkspace(test.workspaceID)
+			serv := New(f.repo, f.imgRepo, f.bus)
+			boards, err := serv.ListByWorkspace(context.Background(), test.workspaceID, false)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
@@ -178,6 +192,7 @@ func TestUsecase_Get(t *testing.T) {
 	type fields struct {
 		repo    *mocks.MockRepository
 		imgRepo *imgMocks.MockRepository
+		bus     *eventsMocks.MockBus
 		id      int
 		board   *models.Board
 	}
@@ -192,7 +207,7 @@ func TestUsecase_Get(t *testing.T) {
 	tests := map[string]testCase{
 		"normal": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().Get(f.id).Return(*f.board, nil)
+				f.repo.EXPECT().Get(gomock.Any(), f.id).Return(*f.board, nil)
 			},
 			id: 21,
 			board: models.Board{
@@ -205,7 +220,7 @@ func TestUsecase_Get(t *testing.T) {
 		},
 		"board not found": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().Get(f.id).Return(*f.board, pkgErrors.ErrBoardNotFound)
+				f.repo.EXPECT().Get(gomock.Any(), f.id).Return(*f.board, pkgErrors.ErrBoardNotFound)
 			},
 			id:    21,
 			board: models.Board{},
@@ -213,7 +228,7 @@ func TestUsecase_Get(t *testing.T) {
 		},
 		"storages error": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().Get(f.id).Return(*f.board, pkgErrors.ErrDb)
+				f.repo.EXPECT().Get(gomock.Any(), f.id).Return(*f.board, pkgErrors.ErrDb)
 			},
 			id:    21,
 			board: models.Board{},
@@ -232,17 +247,18 @@ func TestUsecase_Get(t *testing.T) {
 			f := fields{
 				repo:    mocks.NewMockRepository(ctrl),
 				imgRepo: imgMocks.NewMockRepository(ctrl),
+				bus:     eventsMocks.NewMockBus(ctrl),
 				id:      test.id, board: &test.board}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := New(f.repo, f.imgRepo)
-			board, err := uc.Get(test.id)
+			uc := New(f.repo, f.imgRepo, f.bus)
+			board, err := uc.Get(context.Background(), test.id)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if board != test.board {
+			if !reflect.DeepEqual(board, test.board) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.board, board)
 			}
 		})
@@ -253,6 +269,7 @@ func TestFullUpdate(t *testing.T) {
 	type fields struct {
 		repo    *mocks.MockRepository
 		imgRepo *imgMocks.MockRepository
+		bus     *eventsMocks.MockBus
 		params  *pkgBoards.FullUpdateParams
 		board   *models.Board
 	}
@@ -267,7 +284,8 @@ func TestFullUpdate(t *testing.T) {
 	tests := map[string]testCase{
 		"normal": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().FullUpdate(f.params).Return(*f.board, nil)
+				f.repo.EXPECT().FullUpdate(gomock.Any(), f.params).Return(*f.board, nil)
+				f.bus.EXPECT().Publish(gomock.Any())
 			},
 			params: &pkgBoards.FullUpdateParams{
 				ID:          21,
@@ -291,17 +309,18 @@ func TestFullUpdate(t *testing.T) {
 			f := fields{
 				repo:    mocks.NewMockRepository(ctrl),
 				imgRepo: imgMocks.NewMockRepository(ctrl),
+				bus:     eventsMocks.NewMockBus(ctrl),
 				params:  test.params, board: &test.board}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := New(f.repo, f.imgRepo)
-			board, err := uc.FullUpdate(test.params)
+			uc := New(f.repo, f.imgRepo, f.bus)
+			board, err := uc.FullUpdate(context.Background(), test.params)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if board != test.board {
+			if !reflect.DeepEqual(board, test.board) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.board, board)
 			}
 		})
@@ -312,6 +331,7 @@ func TestPartialUpdate(t *testing.T) {
 	type fields struct {
 		repo    *mocks.MockRepository
 		imgRepo *imgMocks.MockRepository
+		bus     *eventsMocks.MockBus
 		params  *pkgBoards.PartialUpdateParams
 		board   *models.Board
 	}
@@ -326,7 +346,8 @@ func TestPartialUpdate(t *testing.T) {
 	tests := map[string]testCase{
 		"normal": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().PartialUpdate(f.params).Return(*f.board, nil)
+				f.repo.EXPECT().PartialUpdate(gomock.Any(), f.params).Return(*f.board, nil)
+				f.bus.EXPECT().Publish(gomock.Any())
 			},
 			params: &pkgBoards.PartialUpdateParams{
 				ID:                21,
@@ -353,17 +374,18 @@ func TestPartialUpdate(t *testing.T) {
 			f := fields{
 				repo:    mocks.NewMockRepository(ctrl),
 				imgRepo: imgMocks.NewMockRepository(ctrl),
+				bus:     eventsMocks.NewMockBus(ctrl),
 				params:  test.params, board: &test.board}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := New(f.repo, f.imgRepo)
-			board, err := uc.PartialUpdate(test.params)
+			uc := New(f.repo, f.imgRepo, f.bus)
+			board, err := uc.PartialUpdate(context.Background(), test.params)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if board != test.board {
+			if !reflect.DeepEqual(board, test.board) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.board, board)
 			}
 		})
@@ -374,6 +396,7 @@ func TestUsecase_Delete(t *testing.T) {
 	type fields struct {
 		repo    *mocks.MockRepository
 		imgRepo *imgMocks.MockRepository
+		bus     *eventsMocks.MockBus
 		id      int
 	}
 
@@ -386,14 +409,20 @@ func TestUsecase_Delete(t *testing.T) {
 	tests := map[string]testCase{
 		"normal": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().Delete(f.id).Return(nil)
+				key := "backgrounds/old.png"
+				f.repo.EXPECT().Get(gomock.Any(), f.id).Return(models.Board{ID: f.id, BackgroundKey: &key}, nil)
+				f.repo.EXPECT().Delete(gomock.Any(), f.id).Return(nil)
+				f.imgRepo.EXPECT().Delete(key).Return(nil)
+				f.imgRepo.EXPECT().Delete("backgrounds/old_small.png").Return(nil)
+				f.imgRepo.EXPECT().Delete("backgrounds/old_medium.png").Return(nil)
+				f.bus.EXPECT().Publish(gomock.Any())
 			},
 			id:  21,
 			err: nil,
 		},
 		"board not found": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().Delete(f.id).Return(pkgErrors.ErrBoardNotFound)
+				f.repo.EXPECT().Get(gomock.Any(), f.id).Return(models.Board{}, pkgErrors.ErrBoardNotFound)
 			},
 			id:  21,
 			err: pkgErrors.ErrBoardNotFound,
@@ -411,13 +440,88 @@ func TestUsecase_Delete(t *testing.T) {
 			f := fields{
 				repo:    mocks.NewMockRepository(ctrl),
 				imgRepo: imgMocks.NewMockRepository(ctrl),
+				bus:     eventsMocks.NewMockBus(ctrl),
 				id:      test.id}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := New(f.repo, f.imgRepo)
-			err := uc.Delete(test.id)
+			uc := New(f.repo, f.imgRepo, f.bus)
+			err := uc.Delete(context.Background(), test.id)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_Import(t *testing.T) {
+	color := models.BackgroundColors[0]
+	export := func(labels ...int) *models.BoardExport {
+		return &models.BoardExport{
+			Version:         models.BoardExportVersion,
+			Title:           "Imported",
+			BackgroundType:  models.BackgroundTypeColor,
+			BackgroundColor: &color,
+			Labels: []models.ExportLabel{
+				{Name: "Bug", Color: "#EB5A46"},
+				{Name: "Feature", Color: "#61bd4f"},
+			},
+			Lists: []models.ExportList{
+				{Title: "To do", Cards: []models.ExportCard{{Title: "Card", Labels: labels}}},
+			},
+		}
+	}
+
+	type testCase struct {
+		prepare func(repo *mocks.MockRepository)
+		export  *models.BoardExport
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(repo *mocks.MockRepository) {
+				repo.EXPECT().Import(gomock.Any(), 27, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ int, validated *models.BoardExport) (models.Board, error) {
+						if validated.Labels[0].Color != "#eb5a46" {
+							t.Errorf("label color not normalized: %s", validated.Labels[0].Color)
+						}
+						return models.Board{ID: 21, WorkspaceID: 27, Title: "Imported"}, nil
+					})
+			},
+			export: export(0, 1),
+			err:    nil,
+		},
+		"label out of range": {
+			export: export(2),
+			err:    pkgErrors.ErrBadExportLabel,
+		},
+		"negative label": {
+			export: export(-1),
+			err:    pkgErrors.ErrBadExportLabel,
+		},
+		"duplicate label": {
+			export: export(1, 0, 1),
+			err:    pkgErrors.ErrBadExportLabel,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockRepository(ctrl)
+			if test.prepare != nil {
+				test.prepare(repo)
+			}
+
+			uc := New(repo, imgMocks.NewMockRepository(ctrl), eventsMocks.NewMockBus(ctrl))
+			_, err := uc.Import(context.Background(), 27, test.export)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
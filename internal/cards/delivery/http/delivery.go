@@ -1,37 +1,58 @@
 package http
 
 import (
+	pAccess "github.com/SlavaShagalov/my-trello-backend/internal/access"
 	pCards "github.com/SlavaShagalov/my-trello-backend/internal/cards"
 	mw "github.com/SlavaShagalov/my-trello-backend/internal/middleware"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
 	pErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
 	pHTTP "github.com/SlavaShagalov/my-trello-backend/internal/pkg/http"
 	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
 	"net/http"
 	"strconv"
+	"time"
 )
 
 type delivery struct {
-	uc  pCards.Usecase
-	log *zap.Logger
+	uc       pCards.Usecase
+	accessUC pAccess.Usecase
+	log      *zap.Logger
 }
 
-func RegisterHandlers(mux *mux.Router, uc pCards.Usecase, log *zap.Logger, checkAuth mw.Middleware, metrics mw.Middleware) {
+func RegisterHandlers(mux *mux.Router, uc pCards.Usecase, accessUC pAccess.Usecase, log *zap.Logger,
+	checkAuth mw.Middleware, metrics mw.Middleware) {
 	del := delivery{
-		uc:  uc,
-		log: log,
+		uc:       uc,
+		accessUC: accessUC,
+		log:      log,
 	}
 
 	const (
+		boardCardsPrefix = "/boards/{id}/cards"
+		boardCardsPath   = constants.ApiPrefix + boardCardsPrefix
+
 		listCardsPrefix = "/lists/{id}/cards"
 		listCardsPath   = constants.ApiPrefix + listCardsPrefix
 
 		cardsPrefix = "/cards"
 		cardsPath   = constants.ApiPrefix + cardsPrefix
 		cardPath    = cardsPath + "/{id}"
+
+		cardCompletePath   = cardPath + "/complete"
+		cardIncompletePath = cardPath + "/incomplete"
+		cardArchivePath    = cardPath + "/archive"
+		cardUnarchivePath  = cardPath + "/unarchive"
+		cardMovePath       = cardPath + "/move"
+
+		myCardsPrefix = "/users/me/cards"
+		myCardsPath   = constants.ApiPrefix + myCardsPrefix
 	)
 
+	mux.HandleFunc(boardCardsPath, metrics(checkAuth(del.listByBoard))).Methods(http.MethodGet)
+
 	mux.HandleFunc(listCardsPath, metrics(checkAuth(del.create))).Methods(http.MethodPost)
 	mux.HandleFunc(listCardsPath, metrics(checkAuth(del.listByList))).Methods(http.MethodGet)
 
@@ -41,6 +62,14 @@ func RegisterHandlers(mux *mux.Router, uc pCards.Usecase, log *zap.Logger, check
 	mux.HandleFunc(cardPath, metrics(checkAuth(del.get))).Methods(http.MethodGet)
 	mux.HandleFunc(cardPath, metrics(checkAuth(del.partialUpdate))).Methods(http.MethodPatch)
 	mux.HandleFunc(cardPath, metrics(checkAuth(del.delete))).Methods(http.MethodDelete)
+
+	mux.HandleFunc(cardCompletePath, metrics(checkAuth(del.complete))).Methods(http.MethodPost)
+	mux.HandleFunc(cardIncompletePath, metrics(checkAuth(del.incomplete))).Methods(http.MethodPost)
+	mux.HandleFunc(cardArchivePath, metrics(checkAuth(del.archive))).Methods(http.MethodPost)
+	mux.HandleFunc(cardUnarchivePath, metrics(checkAuth(del.unarchive))).Methods(http.MethodPost)
+	mux.HandleFunc(cardMovePath, metrics(checkAuth(del.move))).Methods(http.MethodPost)
+
+	mux.HandleFunc(myCardsPath, metrics(checkAuth(del.listMine))).Methods(http.MethodGet)
 }
 
 // create godoc
@@ -55,6 +84,7 @@ func RegisterHandlers(mux *mux.Router, uc pCards.Usecase, log *zap.Logger, check
 //	@Success		200				{object}	CreateResponse	"Created card data."
 //	@Failure		400				{object}	http.JSONError
 //	@Failure		401				{object}	http.JSONError
+//	@Failure		403				{object}	http.JSONError
 //	@Failure		405
 //	@Failure		500
 //	@Router			/lists/{id}/cards [post]
@@ -68,6 +98,18 @@ func (del *delivery) create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckList(userID, listID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
 	body, err := pHTTP.ReadBody(r, del.log)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
@@ -86,6 +128,20 @@ func (del *delivery) create(w http.ResponseWriter, r *http.Request) {
 		Content: request.Content,
 		ListID:  listID,
 	}
+	if request.StartAt != nil {
+		params.StartAt, err = parseDate(*request.StartAt)
+		if err != nil {
+			pHTTP.HandleError(w, r, err)
+			return
+		}
+	}
+	if request.DueAt != nil {
+		params.DueAt, err = parseDate(*request.DueAt)
+		if err != nil {
+			pHTTP.HandleError(w, r, err)
+			return
+		}
+	}
 
 	card, err := del.uc.Create(&params)
 	if err != nil {
@@ -103,10 +159,16 @@ func (del *delivery) create(w http.ResponseWriter, r *http.Request) {
 //	@Description	Returns cards by card id
 //	@Tags			lists
 //	@Produce		json
-//	@Param			id	path		int				true	"Board ID"
-//	@Success		200	{object}	CardResponse	"Lists data"
+//	@Param			id			path		int				true	"Board ID"
+//	@Param			due_before	query		string			false	"Only cards due before the time (RFC 3339)"
+//	@Param			due_after	query		string			false	"Only cards due after the time (RFC 3339)"
+//	@Param			overdue		query		bool			false	"Only incomplete cards past their due date"
+//	@Param			completed	query		bool			false	"Only completed or incomplete cards"
+//	@Param			archived	query		bool			false	"Archived cards instead of active ones"
+//	@Success		200			{object}	CardResponse	"Lists data"
 //	@Failure		400	{object}	http.JSONError
 //	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
 //	@Failure		405
 //	@Failure		500
 //	@Router			/lists/{id}/cards [get]
@@ -120,7 +182,83 @@ func (del *delivery) listByList(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	cards, err := del.uc.ListByList(listID)
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckList(userID, listID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	filter, err := parseFilter(r)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	cards, err := del.uc.ListByList(listID, filter)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newListResponse(cards)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// listByBoard godoc
+//
+//	@Summary		Returns cards of the board
+//	@Description	Returns cards of all lists of the board ordered by list and card position
+//	@Tags			boards
+//	@Produce		json
+//	@Param			id			path		int				true	"Board ID"
+//	@Param			due_before	query		string			false	"Only cards due before the time (RFC 3339)"
+//	@Param			due_after	query		string			false	"Only cards due after the time (RFC 3339)"
+//	@Param			overdue		query		bool			false	"Only incomplete cards past their due date"
+//	@Param			completed	query		bool			false	"Only completed or incomplete cards"
+//	@Param			archived	query		bool			false	"Archived cards instead of active ones"
+//	@Success		200			{object}	CardResponse	"Cards data"
+//	@Failure		400			{object}	http.JSONError
+//	@Failure		401			{object}	http.JSONError
+//	@Failure		403			{object}	http.JSONError
+//	@Failure		404			{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/boards/{id}/cards [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) listByBoard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, boardID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	filter, err := parseFilter(r)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	cards, err := del.uc.ListByBoard(boardID, filter)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
 		return
@@ -164,6 +302,38 @@ func (del *delivery) list(w http.ResponseWriter, r *http.Request) {
 	pHTTP.SendJSON(w, r, http.StatusOK, response)
 }
 
+// listMine godoc
+//
+//	@Summary		Returns cards assigned to current user
+//	@Description	Returns cards assigned to current user across workspaces, grouped by board
+//	@Tags			users
+//	@Produce		json
+//	@Param			sort	query		string				false	"position (default) or due"
+//	@Success		200		{object}	boardCardsResponse	"Cards data"
+//	@Failure		400		{object}	http.JSONError
+//	@Failure		401		{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/users/me/cards [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) listMine(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	boards, err := del.uc.ListByAssignee(userID, r.URL.Query().Get("sort"))
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newBoardCardsResponse(boards)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
 // get godoc
 //
 //	@Summary		Returns card by id
@@ -174,6 +344,7 @@ func (del *delivery) list(w http.ResponseWriter, r *http.Request) {
 //	@Success		200	{object}	getResponse	"Card data"
 //	@Failure		400	{object}	http.JSONError
 //	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
 //	@Failure		404	{object}	http.JSONError
 //	@Failure		405
 //	@Failure		500
@@ -188,6 +359,18 @@ func (del *delivery) get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
 	card, err := del.uc.Get(cardID)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
@@ -201,7 +384,9 @@ func (del *delivery) get(w http.ResponseWriter, r *http.Request) {
 // partialUpdate godoc
 //
 //	@Summary		Partial update of card
-//	@Description	Partial update of card
+//	@Description	Partial update of card. An empty start_at or due_at clears the date. Changing list_id, position,
+//	@Description	before_id or after_id moves the card like POST /cards/{id}/move, to the end of the new list
+//	@Description	without a position or neighbors.
 //	@Tags			cards
 //	@Accept			json
 //	@Produce		json
@@ -210,6 +395,7 @@ func (del *delivery) get(w http.ResponseWriter, r *http.Request) {
 //	@Success		200				{object}	getResponse				"Updated card data."
 //	@Failure		400				{object}	http.JSONError
 //	@Failure		401				{object}	http.JSONError
+//	@Failure		403				{object}	http.JSONError
 //	@Failure		405
 //	@Failure		500
 //	@Router			/cards/{id}  [patch]
@@ -223,6 +409,18 @@ func (del *delivery) partialUpdate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
 	body, err := pHTTP.ReadBody(r, del.log)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
@@ -247,12 +445,35 @@ func (del *delivery) partialUpdate(w http.ResponseWriter, r *http.Request) {
 	}
 	params.UpdateListID = request.ListID != nil
 	if params.UpdateListID {
+		err = del.accessUC.CheckList(userID, *request.ListID, pAccess.Write)
+		if err != nil {
+			pHTTP.HandleError(w, r, err)
+			return
+		}
 		params.ListID = *request.ListID
 	}
 	params.UpdatePosition = request.Position != nil
 	if params.UpdatePosition {
 		params.Position = *request.Position
 	}
+	params.BeforeID = request.BeforeID
+	params.AfterID = request.AfterID
+	params.UpdateStartAt = request.StartAt != nil
+	if params.UpdateStartAt {
+		params.StartAt, err = parseDate(*request.StartAt)
+		if err != nil {
+			pHTTP.HandleError(w, r, err)
+			return
+		}
+	}
+	params.UpdateDueAt = request.DueAt != nil
+	if params.UpdateDueAt {
+		params.DueAt, err = parseDate(*request.DueAt)
+		if err != nil {
+			pHTTP.HandleError(w, r, err)
+			return
+		}
+	}
 
 	card, err := del.uc.PartialUpdate(&params)
 	if err != nil {
@@ -264,6 +485,89 @@ func (del *delivery) partialUpdate(w http.ResponseWriter, r *http.Request) {
 	pHTTP.SendJSON(w, r, http.StatusOK, response)
 }
 
+// move godoc
+//
+//	@Summary		Move card
+//	@Description	Move card to the position of a list, possibly on another board of the same workspace. Positions
+//	@Description	start at 1, an absent or too large one puts the card last. Instead of the position, before_id puts
+//	@Description	the card right after that card and after_id right before it. With both, the cards must be adjacent.
+//	@Description	Only the moved card is written.
+//	@Tags			cards
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		int			true	"Card ID"
+//	@Param			MoveData	body		MoveRequest	true	"Target list and position"
+//	@Success		200			{object}	getResponse	"Moved card data."
+//	@Failure		400			{object}	http.JSONError
+//	@Failure		401			{object}	http.JSONError
+//	@Failure		403			{object}	http.JSONError
+//	@Failure		404			{object}	http.JSONError
+//	@Failure		405
+//	@Failure		409			{object}	http.JSONError
+//	@Failure		500
+//	@Router			/cards/{id}/move [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) move(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	body, err := pHTTP.ReadBody(r, del.log)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	var request MoveRequest
+	err = request.UnmarshalJSON(body)
+	if err != nil {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckList(userID, request.ListID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	params := pCards.MoveParams{
+		ID:       cardID,
+		ListID:   request.ListID,
+		Position: pCards.LastPosition,
+		BeforeID: request.BeforeID,
+		AfterID:  request.AfterID,
+	}
+	if request.Position != nil {
+		params.Position = *request.Position
+	}
+
+	card, err := del.uc.Move(&params)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newGetResponse(&card)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
 // delete godoc
 //
 //	@Summary		Delete card by id
@@ -274,6 +578,7 @@ func (del *delivery) partialUpdate(w http.ResponseWriter, r *http.Request) {
 //	@Success		204	"Card deleted successfully"
 //	@Failure		400	{object}	http.JSONError
 //	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
 //	@Failure		404	{object}	http.JSONError
 //	@Failure		405
 //	@Failure		500
@@ -288,6 +593,18 @@ func (del *delivery) delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
 	err = del.uc.Delete(cardID)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
@@ -296,3 +613,171 @@ func (del *delivery) delete(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// complete godoc
+//
+//	@Summary		Mark card as completed
+//	@Description	Mark card as completed. Completing a completed card keeps its completion time.
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id	path		int			true	"Card ID"
+//	@Success		200	{object}	getResponse	"Updated card data."
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/complete [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) complete(w http.ResponseWriter, r *http.Request) {
+	del.setState(w, r, del.uc.Complete)
+}
+
+// incomplete godoc
+//
+//	@Summary		Mark card as incomplete
+//	@Description	Mark card as incomplete
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id	path		int			true	"Card ID"
+//	@Success		200	{object}	getResponse	"Updated card data."
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/incomplete [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) incomplete(w http.ResponseWriter, r *http.Request) {
+	del.setState(w, r, del.uc.Incomplete)
+}
+
+// setState applies one of the state changes to the card and replies with the updated card.
+func (del *delivery) setState(w http.ResponseWriter, r *http.Request, set func(id int) (models.Card, error)) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	card, err := set(cardID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newGetResponse(&card)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// archive godoc
+//
+//	@Summary		Archive card
+//	@Description	Archive card. It leaves the listings and the following cards of its list move up.
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id	path		int			true	"Card ID"
+//	@Success		200	{object}	getResponse	"Updated card data."
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/archive [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) archive(w http.ResponseWriter, r *http.Request) {
+	// setState refuses requests without the user.
+	userID, _ := r.Context().Value(mw.ContextUserID).(int)
+	del.setState(w, r, func(id int) (models.Card, error) {
+		return del.uc.Archive(id, userID)
+	})
+}
+
+// unarchive godoc
+//
+//	@Summary		Restore archived card
+//	@Description	Restore archived card between the cards it was archived from.
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id	path		int			true	"Card ID"
+//	@Success		200	{object}	getResponse	"Updated card data."
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/unarchive [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) unarchive(w http.ResponseWriter, r *http.Request) {
+	del.setState(w, r, del.uc.Unarchive)
+}
+
+// parseDate parses an RFC 3339 timestamp into UTC. An empty string means no date.
+func parseDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.Wrap(pErrors.ErrBadCardDate, err.Error())
+	}
+	t = t.UTC()
+	return &t, nil
+}
+
+func parseFilter(r *http.Request) (*pCards.Filter, error) {
+	query := r.URL.Query()
+	filter := pCards.Filter{}
+
+	var err error
+	filter.DueBefore, err = parseDate(query.Get("due_before"))
+	if err != nil {
+		return nil, err
+	}
+	filter.DueAfter, err = parseDate(query.Get("due_after"))
+	if err != nil {
+		return nil, err
+	}
+
+	if value := query.Get("overdue"); value != "" {
+		filter.Overdue, err = strconv.ParseBool(value)
+		if err != nil {
+			return nil, errors.Wrap(pErrors.ErrBadCardFilter, err.Error())
+		}
+	}
+	if value := query.Get("completed"); value != "" {
+		completed, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, errors.Wrap(pErrors.ErrBadCardFilter, err.Error())
+		}
+		filter.Completed = &completed
+	}
+	filter.Archived, err = pHTTP.ParseArchived(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &filter, nil
+}
@@ -8,9 +8,13 @@ import (
 //go:generate easyjson -all -snake_case models.go
 
 // API requests
+// Dates are RFC 3339 strings rather than time.Time, so that an empty string
+// can clear a date: easyjson skips null values like absent ones.
 type CreateRequest struct {
-	Title   string `json:"title"`
-	Content string `json:"content"`
+	Title   string  `json:"title"`
+	Content string  `json:"content"`
+	StartAt *string `json:"start_at"`
+	DueAt   *string `json:"due_at"`
 }
 
 type PartialUpdateRequest struct {
@@ -18,6 +22,19 @@ type PartialUpdateRequest struct {
 	Content  *string `json:"content"`
 	Position *int    `json:"position"`
 	ListID   *int    `json:"list_id"`
+	BeforeID int     `json:"before_id"`
+	AfterID  int     `json:"after_id"`
+	StartAt  *string `json:"start_at"`
+	DueAt    *string `json:"due_at"`
+}
+
+// MoveRequest puts the card after the card BeforeID or before the card AfterID
+// when either is set, at the position otherwise, and last when it is absent.
+type MoveRequest struct {
+	ListID   int  `json:"list_id"`
+	Position *int `json:"position"`
+	BeforeID int  `json:"before_id"`
+	AfterID  int  `json:"after_id"`
 }
 
 // API responses
@@ -31,46 +48,78 @@ func newListResponse(cards []models.Card) *CardResponse {
 	}
 }
 
+type boardCardsResponse struct {
+	Boards []models.BoardCards `json:"boards"`
+}
+
+func newBoardCardsResponse(boards []models.BoardCards) *boardCardsResponse {
+	return &boardCardsResponse{
+		Boards: boards,
+	}
+}
+
 type CreateResponse struct {
-	ID        int       `json:"id"`
-	ListID    int       `json:"list_id"`
-	Title     string    `json:"title"`
-	Content   string    `json:"content"`
-	Position  int       `json:"position"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID                int                      `json:"id"`
+	ListID            int                      `json:"list_id"`
+	Title             string                   `json:"title"`
+	Content           string                   `json:"content"`
+	Position          int                      `json:"position"`
+	ChecklistProgress models.ChecklistProgress `json:"checklist_progress"`
+	CommentsCount     int                      `json:"comments_count"`
+	StartAt           *time.Time               `json:"start_at"`
+	DueAt             *time.Time               `json:"due_at"`
+	CompletedAt       *time.Time               `json:"completed_at"`
+	CreatedAt         time.Time                `json:"created_at"`
+	UpdatedAt         time.Time                `json:"updated_at"`
 }
 
 func newCreateResponse(card *models.Card) *CreateResponse {
 	return &CreateResponse{
-		ID:        card.ID,
-		ListID:    card.ListID,
-		Title:     card.Title,
-		Content:   card.Content,
-		Position:  card.Position,
-		CreatedAt: card.CreatedAt,
-		UpdatedAt: card.UpdatedAt,
+		ID:                card.ID,
+		ListID:            card.ListID,
+		Title:             card.Title,
+		Content:           card.Content,
+		Position:          card.Position,
+		ChecklistProgress: card.ChecklistProgress,
+		CommentsCount:     card.CommentsCount,
+		StartAt:           card.StartAt,
+		DueAt:             card.DueAt,
+		CompletedAt:       card.CompletedAt,
+		CreatedAt:         card.CreatedAt,
+		UpdatedAt:         card.UpdatedAt,
 	}
 }
 
 type getResponse struct {
-	ID        int       `json:"id"`
-	ListID    int       `json:"list_id"`
-	Title     string    `json:"title"`
-	Content   string    `json:"content"`
-	Position  int       `json:"position"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID                int                      `json:"id"`
+	ListID            int                      `json:"list_id"`
+	Title             string                   `json:"title"`
+	Content           string                   `json:"content"`
+	Position          int                      `json:"position"`
+	ChecklistProgress models.ChecklistProgress `json:"checklist_progress"`
+	CommentsCount     int                      `json:"comments_count"`
+	StartAt           *time.Time               `json:"start_at"`
+	DueAt             *time.Time               `json:"due_at"`
+	CompletedAt       *time.Time               `json:"completed_at"`
+	ArchivedAt        *time.Time               `json:"archived_at"`
+	CreatedAt         time.Time                `json:"created_at"`
+	UpdatedAt         time.Time                `json:"updated_at"`
 }
 
 func newGetResponse(card *models.Card) *getResponse {
 	return &getResponse{
-		ID:        card.ID,
-		ListID:    card.ListID,
-		Title:     card.Title,
-		Content:   card.Content,
-		Position:  card.Position,
-		CreatedAt: card.CreatedAt,
-		UpdatedAt: card.UpdatedAt,
+		ID:                card.ID,
+		ListID:            card.ListID,
+		Title:             card.Title,
+		Content:           card.Content,
+		Position:          card.Position,
+		ChecklistProgress: card.ChecklistProgress,
+		CommentsCount:     card.CommentsCount,
+		StartAt:           card.StartAt,
+		DueAt:             card.DueAt,
+		CompletedAt:       card.CompletedAt,
+		ArchivedAt:        card.ArchivedAt,
+		CreatedAt:         card.CreatedAt,
+		UpdatedAt:         card.UpdatedAt,
 	}
 }
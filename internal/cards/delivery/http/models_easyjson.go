@@ -8,6 +8,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -47,6 +48,58 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDel
 			out.Content = string(in.String())
 		case "position":
 			out.Position = int(in.Int())
+		case "checklist_progress":
+			easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels(in, &out.ChecklistProgress)
+		case "comments_count":
+			out.CommentsCount = int(in.Int())
+		case "start_at":
+			if in.IsNull() {
+				in.Skip()
+				out.StartAt = nil
+			} else {
+				if out.StartAt == nil {
+					out.StartAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.StartAt).UnmarshalJSON(data))
+				}
+			}
+		case "due_at":
+			if in.IsNull() {
+				in.Skip()
+				out.DueAt = nil
+			} else {
+				if out.DueAt == nil {
+					out.DueAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DueAt).UnmarshalJSON(data))
+				}
+			}
+		case "completed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.CompletedAt = nil
+			} else {
+				if out.CompletedAt == nil {
+					out.CompletedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CompletedAt).UnmarshalJSON(data))
+				}
+			}
+		case "archived_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ArchivedAt = nil
+			} else {
+				if out.ArchivedAt == nil {
+					out.ArchivedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ArchivedAt).UnmarshalJSON(data))
+				}
+			}
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
@@ -94,6 +147,52 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDel
 		out.RawString(prefix)
 		out.Int(int(in.Position))
 	}
+	{
+		const prefix string = ",\"checklist_progress\":"
+		out.RawString(prefix)
+		easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels(out, in.ChecklistProgress)
+	}
+	{
+		const prefix string = ",\"comments_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.CommentsCount))
+	}
+	{
+		const prefix string = ",\"start_at\":"
+		out.RawString(prefix)
+		if in.StartAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.StartAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"due_at\":"
+		out.RawString(prefix)
+		if in.DueAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.DueAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"completed_at\":"
+		out.RawString(prefix)
+		if in.CompletedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.CompletedAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"archived_at\":"
+		out.RawString(prefix)
+		if in.ArchivedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ArchivedAt).MarshalJSON())
+		}
+	}
 	{
 		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
@@ -130,7 +229,872 @@ func (v *getResponse) UnmarshalJSON(data []byte) error {
 func (v *getResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp1(in *jlexer.Lexer, out *PartialUpdateRequest) {
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels(in *jlexer.Lexer, out *models.ChecklistProgress) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "done":
+			out.Done = int(in.Int())
+		case "total":
+			out.Total = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels(out *jwriter.Writer, in models.ChecklistProgress) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"done\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Done))
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp1(in *jlexer.Lexer, out *boardCardsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "boards":
+			if in.IsNull() {
+				in.Skip()
+				out.Boards = nil
+			} else {
+				in.Delim('[')
+				if out.Boards == nil {
+					if !in.IsDelim(']') {
+						out.Boards = make([]models.BoardCards, 0, 1)
+					} else {
+						out.Boards = []models.BoardCards{}
+					}
+				} else {
+					out.Boards = (out.Boards)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 models.BoardCards
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels1(in, &v1)
+					out.Boards = append(out.Boards, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp1(out *jwriter.Writer, in boardCardsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"boards\":"
+		out.RawString(prefix[1:])
+		if in.Boards == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Boards {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels1(out, v3)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v boardCardsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v boardCardsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *boardCardsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *boardCardsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp1(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels1(in *jlexer.Lexer, out *models.BoardCards) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "board_id":
+			out.BoardID = int(in.Int())
+		case "board_title":
+			out.BoardTitle = string(in.String())
+		case "workspace_id":
+			out.WorkspaceID = int(in.Int())
+		case "cards":
+			if in.IsNull() {
+				in.Skip()
+				out.Cards = nil
+			} else {
+				in.Delim('[')
+				if out.Cards == nil {
+					if !in.IsDelim(']') {
+						out.Cards = make([]models.AssignedCard, 0, 0)
+					} else {
+						out.Cards = []models.AssignedCard{}
+					}
+				} else {
+					out.Cards = (out.Cards)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 models.AssignedCard
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels2(in, &v4)
+					out.Cards = append(out.Cards, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels1(out *jwriter.Writer, in models.BoardCards) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.BoardID))
+	}
+	{
+		const prefix string = ",\"board_title\":"
+		out.RawString(prefix)
+		out.String(string(in.BoardTitle))
+	}
+	{
+		const prefix string = ",\"workspace_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.WorkspaceID))
+	}
+	{
+		const prefix string = ",\"cards\":"
+		out.RawString(prefix)
+		if in.Cards == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Cards {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels2(out, v6)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels2(in *jlexer.Lexer, out *models.AssignedCard) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "list_title":
+			out.ListTitle = string(in.String())
+		case "id":
+			out.ID = int(in.Int())
+		case "list_id":
+			out.ListID = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "content":
+			out.Content = string(in.String())
+		case "position":
+			out.Position = int(in.Int())
+		case "labels":
+			if in.IsNull() {
+				in.Skip()
+				out.Labels = nil
+			} else {
+				in.Delim('[')
+				if out.Labels == nil {
+					if !in.IsDelim(']') {
+						out.Labels = make([]models.Label, 0, 0)
+					} else {
+						out.Labels = []models.Label{}
+					}
+				} else {
+					out.Labels = (out.Labels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 models.Label
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels3(in, &v7)
+					out.Labels = append(out.Labels, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "assignees":
+			if in.IsNull() {
+				in.Skip()
+				out.Assignees = nil
+			} else {
+				in.Delim('[')
+				if out.Assignees == nil {
+					if !in.IsDelim(']') {
+						out.Assignees = make([]models.Assignee, 0, 0)
+					} else {
+						out.Assignees = []models.Assignee{}
+					}
+				} else {
+					out.Assignees = (out.Assignees)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v8 models.Assignee
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels4(in, &v8)
+					out.Assignees = append(out.Assignees, v8)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "checklist_progress":
+			easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels(in, &out.ChecklistProgress)
+		case "comments_count":
+			out.CommentsCount = int(in.Int())
+		case "start_at":
+			if in.IsNull() {
+				in.Skip()
+				out.StartAt = nil
+			} else {
+				if out.StartAt == nil {
+					out.StartAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.StartAt).UnmarshalJSON(data))
+				}
+			}
+		case "due_at":
+			if in.IsNull() {
+				in.Skip()
+				out.DueAt = nil
+			} else {
+				if out.DueAt == nil {
+					out.DueAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DueAt).UnmarshalJSON(data))
+				}
+			}
+		case "completed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.CompletedAt = nil
+			} else {
+				if out.CompletedAt == nil {
+					out.CompletedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CompletedAt).UnmarshalJSON(data))
+				}
+			}
+		case "archived_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ArchivedAt = nil
+			} else {
+				if out.ArchivedAt == nil {
+					out.ArchivedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ArchivedAt).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels2(out *jwriter.Writer, in models.AssignedCard) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"list_title\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ListTitle))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"list_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ListID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix)
+		out.String(string(in.Content))
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Int(int(in.Position))
+	}
+	{
+		const prefix string = ",\"labels\":"
+		out.RawString(prefix)
+		if in.Labels == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v9, v10 := range in.Labels {
+				if v9 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels3(out, v10)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"assignees\":"
+		out.RawString(prefix)
+		if in.Assignees == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Assignees {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels4(out, v12)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"checklist_progress\":"
+		out.RawString(prefix)
+		easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels(out, in.ChecklistProgress)
+	}
+	{
+		const prefix string = ",\"comments_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.CommentsCount))
+	}
+	{
+		const prefix string = ",\"start_at\":"
+		out.RawString(prefix)
+		if in.StartAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.StartAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"due_at\":"
+		out.RawString(prefix)
+		if in.DueAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.DueAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"completed_at\":"
+		out.RawString(prefix)
+		if in.CompletedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.CompletedAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"archived_at\":"
+		out.RawString(prefix)
+		if in.ArchivedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ArchivedAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels4(in *jlexer.Lexer, out *models.Assignee) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "username":
+			out.Username = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "avatar":
+			if in.IsNull() {
+				in.Skip()
+				out.Avatar = nil
+			} else {
+				if out.Avatar == nil {
+					out.Avatar = new(string)
+				}
+				*out.Avatar = string(in.String())
+			}
+		case "assigned_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.AssignedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels4(out *jwriter.Writer, in models.Assignee) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"avatar\":"
+		out.RawString(prefix)
+		if in.Avatar == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Avatar))
+		}
+	}
+	{
+		const prefix string = ",\"assigned_at\":"
+		out.RawString(prefix)
+		out.Raw((in.AssignedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels3(in *jlexer.Lexer, out *models.Label) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "board_id":
+			out.BoardID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "color":
+			out.Color = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels3(out *jwriter.Writer, in models.Label) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.BoardID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"color\":"
+		out.RawString(prefix)
+		out.String(string(in.Color))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp2(in *jlexer.Lexer, out *PartialUpdateRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			if in.IsNull() {
+				in.Skip()
+				out.Title = nil
+			} else {
+				if out.Title == nil {
+					out.Title = new(string)
+				}
+				*out.Title = string(in.String())
+			}
+		case "content":
+			if in.IsNull() {
+				in.Skip()
+				out.Content = nil
+			} else {
+				if out.Content == nil {
+					out.Content = new(string)
+				}
+				*out.Content = string(in.String())
+			}
+		case "position":
+			if in.IsNull() {
+				in.Skip()
+				out.Position = nil
+			} else {
+				if out.Position == nil {
+					out.Position = new(int)
+				}
+				*out.Position = int(in.Int())
+			}
+		case "list_id":
+			if in.IsNull() {
+				in.Skip()
+				out.ListID = nil
+			} else {
+				if out.ListID == nil {
+					out.ListID = new(int)
+				}
+				*out.ListID = int(in.Int())
+			}
+		case "before_id":
+			out.BeforeID = int(in.Int())
+		case "after_id":
+			out.AfterID = int(in.Int())
+		case "start_at":
+			if in.IsNull() {
+				in.Skip()
+				out.StartAt = nil
+			} else {
+				if out.StartAt == nil {
+					out.StartAt = new(string)
+				}
+				*out.StartAt = string(in.String())
+			}
+		case "due_at":
+			if in.IsNull() {
+				in.Skip()
+				out.DueAt = nil
+			} else {
+				if out.DueAt == nil {
+					out.DueAt = new(string)
+				}
+				*out.DueAt = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp2(out *jwriter.Writer, in PartialUpdateRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		if in.Title == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Title))
+		}
+	}
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix)
+		if in.Content == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Content))
+		}
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		if in.Position == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.Position))
+		}
+	}
+	{
+		const prefix string = ",\"list_id\":"
+		out.RawString(prefix)
+		if in.ListID == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.ListID))
+		}
+	}
+	{
+		const prefix string = ",\"before_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.BeforeID))
+	}
+	{
+		const prefix string = ",\"after_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.AfterID))
+	}
+	{
+		const prefix string = ",\"start_at\":"
+		out.RawString(prefix)
+		if in.StartAt == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.StartAt))
+		}
+	}
+	{
+		const prefix string = ",\"due_at\":"
+		out.RawString(prefix)
+		if in.DueAt == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.DueAt))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PartialUpdateRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PartialUpdateRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PartialUpdateRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PartialUpdateRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp2(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp3(in *jlexer.Lexer, out *MoveRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -149,26 +1113,8 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDel
 			continue
 		}
 		switch key {
-		case "title":
-			if in.IsNull() {
-				in.Skip()
-				out.Title = nil
-			} else {
-				if out.Title == nil {
-					out.Title = new(string)
-				}
-				*out.Title = string(in.String())
-			}
-		case "content":
-			if in.IsNull() {
-				in.Skip()
-				out.Content = nil
-			} else {
-				if out.Content == nil {
-					out.Content = new(string)
-				}
-				*out.Content = string(in.String())
-			}
+		case "list_id":
+			out.ListID = int(in.Int())
 		case "position":
 			if in.IsNull() {
 				in.Skip()
@@ -179,16 +1125,10 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDel
 				}
 				*out.Position = int(in.Int())
 			}
-		case "list_id":
-			if in.IsNull() {
-				in.Skip()
-				out.ListID = nil
-			} else {
-				if out.ListID == nil {
-					out.ListID = new(int)
-				}
-				*out.ListID = int(in.Int())
-			}
+		case "before_id":
+			out.BeforeID = int(in.Int())
+		case "after_id":
+			out.AfterID = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -199,27 +1139,14 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDel
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp1(out *jwriter.Writer, in PartialUpdateRequest) {
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp3(out *jwriter.Writer, in MoveRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"title\":"
+		const prefix string = ",\"list_id\":"
 		out.RawString(prefix[1:])
-		if in.Title == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Title))
-		}
-	}
-	{
-		const prefix string = ",\"content\":"
-		out.RawString(prefix)
-		if in.Content == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Content))
-		}
+		out.Int(int(in.ListID))
 	}
 	{
 		const prefix string = ",\"position\":"
@@ -231,41 +1158,42 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDel
 		}
 	}
 	{
-		const prefix string = ",\"list_id\":"
+		const prefix string = ",\"before_id\":"
 		out.RawString(prefix)
-		if in.ListID == nil {
-			out.RawString("null")
-		} else {
-			out.Int(int(*in.ListID))
-		}
+		out.Int(int(in.BeforeID))
+	}
+	{
+		const prefix string = ",\"after_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.AfterID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PartialUpdateRequest) MarshalJSON() ([]byte, error) {
+func (v MoveRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp1(&w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PartialUpdateRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp1(w, v)
+func (v MoveRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PartialUpdateRequest) UnmarshalJSON(data []byte) error {
+func (v *MoveRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp1(&r, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PartialUpdateRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp1(l, v)
+func (v *MoveRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp3(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp2(in *jlexer.Lexer, out *CreateResponse) {
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp4(in *jlexer.Lexer, out *CreateResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -294,6 +1222,46 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDel
 			out.Content = string(in.String())
 		case "position":
 			out.Position = int(in.Int())
+		case "checklist_progress":
+			easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels(in, &out.ChecklistProgress)
+		case "comments_count":
+			out.CommentsCount = int(in.Int())
+		case "start_at":
+			if in.IsNull() {
+				in.Skip()
+				out.StartAt = nil
+			} else {
+				if out.StartAt == nil {
+					out.StartAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.StartAt).UnmarshalJSON(data))
+				}
+			}
+		case "due_at":
+			if in.IsNull() {
+				in.Skip()
+				out.DueAt = nil
+			} else {
+				if out.DueAt == nil {
+					out.DueAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DueAt).UnmarshalJSON(data))
+				}
+			}
+		case "completed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.CompletedAt = nil
+			} else {
+				if out.CompletedAt == nil {
+					out.CompletedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CompletedAt).UnmarshalJSON(data))
+				}
+			}
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
@@ -312,7 +1280,7 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDel
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp2(out *jwriter.Writer, in CreateResponse) {
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp4(out *jwriter.Writer, in CreateResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -341,6 +1309,43 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDel
 		out.RawString(prefix)
 		out.Int(int(in.Position))
 	}
+	{
+		const prefix string = ",\"checklist_progress\":"
+		out.RawString(prefix)
+		easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels(out, in.ChecklistProgress)
+	}
+	{
+		const prefix string = ",\"comments_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.CommentsCount))
+	}
+	{
+		const prefix string = ",\"start_at\":"
+		out.RawString(prefix)
+		if in.StartAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.StartAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"due_at\":"
+		out.RawString(prefix)
+		if in.DueAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.DueAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"completed_at\":"
+		out.RawString(prefix)
+		if in.CompletedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.CompletedAt).MarshalJSON())
+		}
+	}
 	{
 		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
@@ -357,27 +1362,27 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDel
 // MarshalJSON supports json.Marshaler interface
 func (v CreateResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp2(&w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp2(w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp2(&r, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp2(l, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp4(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp3(in *jlexer.Lexer, out *CreateRequest) {
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp5(in *jlexer.Lexer, out *CreateRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -400,6 +1405,26 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDel
 			out.Title = string(in.String())
 		case "content":
 			out.Content = string(in.String())
+		case "start_at":
+			if in.IsNull() {
+				in.Skip()
+				out.StartAt = nil
+			} else {
+				if out.StartAt == nil {
+					out.StartAt = new(string)
+				}
+				*out.StartAt = string(in.String())
+			}
+		case "due_at":
+			if in.IsNull() {
+				in.Skip()
+				out.DueAt = nil
+			} else {
+				if out.DueAt == nil {
+					out.DueAt = new(string)
+				}
+				*out.DueAt = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -410,7 +1435,7 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDel
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp3(out *jwriter.Writer, in CreateRequest) {
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp5(out *jwriter.Writer, in CreateRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -424,33 +1449,51 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDel
 		out.RawString(prefix)
 		out.String(string(in.Content))
 	}
+	{
+		const prefix string = ",\"start_at\":"
+		out.RawString(prefix)
+		if in.StartAt == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.StartAt))
+		}
+	}
+	{
+		const prefix string = ",\"due_at\":"
+		out.RawString(prefix)
+		if in.DueAt == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.DueAt))
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v CreateRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp3(&w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp3(w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp3(&r, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp3(l, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp5(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp4(in *jlexer.Lexer, out *CardResponse) {
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp6(in *jlexer.Lexer, out *CardResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -485,9 +1528,9 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDel
 					out.Cards = (out.Cards)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 models.Card
-					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels(in, &v1)
-					out.Cards = append(out.Cards, v1)
+					var v13 models.Card
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels5(in, &v13)
+					out.Cards = append(out.Cards, v13)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -502,7 +1545,7 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDel
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp4(out *jwriter.Writer, in CardResponse) {
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp6(out *jwriter.Writer, in CardResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -513,11 +1556,11 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDel
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v2, v3 := range in.Cards {
-				if v2 > 0 {
+			for v14, v15 := range in.Cards {
+				if v14 > 0 {
 					out.RawByte(',')
 				}
-				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels(out, v3)
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels5(out, v15)
 			}
 			out.RawByte(']')
 		}
@@ -528,27 +1571,27 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDel
 // MarshalJSON supports json.Marshaler interface
 func (v CardResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp4(&w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CardResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp4(w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CardResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp4(&r, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CardResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp4(l, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalCardsDeliveryHttp6(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels(in *jlexer.Lexer, out *models.Card) {
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels5(in *jlexer.Lexer, out *models.Card) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -577,6 +1620,104 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels(i
 			out.Content = string(in.String())
 		case "position":
 			out.Position = int(in.Int())
+		case "labels":
+			if in.IsNull() {
+				in.Skip()
+				out.Labels = nil
+			} else {
+				in.Delim('[')
+				if out.Labels == nil {
+					if !in.IsDelim(']') {
+						out.Labels = make([]models.Label, 0, 0)
+					} else {
+						out.Labels = []models.Label{}
+					}
+				} else {
+					out.Labels = (out.Labels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 models.Label
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels3(in, &v16)
+					out.Labels = append(out.Labels, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "assignees":
+			if in.IsNull() {
+				in.Skip()
+				out.Assignees = nil
+			} else {
+				in.Delim('[')
+				if out.Assignees == nil {
+					if !in.IsDelim(']') {
+						out.Assignees = make([]models.Assignee, 0, 0)
+					} else {
+						out.Assignees = []models.Assignee{}
+					}
+				} else {
+					out.Assignees = (out.Assignees)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v17 models.Assignee
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels4(in, &v17)
+					out.Assignees = append(out.Assignees, v17)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "checklist_progress":
+			easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels(in, &out.ChecklistProgress)
+		case "comments_count":
+			out.CommentsCount = int(in.Int())
+		case "start_at":
+			if in.IsNull() {
+				in.Skip()
+				out.StartAt = nil
+			} else {
+				if out.StartAt == nil {
+					out.StartAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.StartAt).UnmarshalJSON(data))
+				}
+			}
+		case "due_at":
+			if in.IsNull() {
+				in.Skip()
+				out.DueAt = nil
+			} else {
+				if out.DueAt == nil {
+					out.DueAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DueAt).UnmarshalJSON(data))
+				}
+			}
+		case "completed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.CompletedAt = nil
+			} else {
+				if out.CompletedAt == nil {
+					out.CompletedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CompletedAt).UnmarshalJSON(data))
+				}
+			}
+		case "archived_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ArchivedAt = nil
+			} else {
+				if out.ArchivedAt == nil {
+					out.ArchivedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ArchivedAt).UnmarshalJSON(data))
+				}
+			}
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
@@ -595,7 +1736,7 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels(i
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels(out *jwriter.Writer, in models.Card) {
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels5(out *jwriter.Writer, in models.Card) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -624,6 +1765,84 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels(o
 		out.RawString(prefix)
 		out.Int(int(in.Position))
 	}
+	{
+		const prefix string = ",\"labels\":"
+		out.RawString(prefix)
+		if in.Labels == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v18, v19 := range in.Labels {
+				if v18 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels3(out, v19)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"assignees\":"
+		out.RawString(prefix)
+		if in.Assignees == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.Assignees {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels4(out, v21)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"checklist_progress\":"
+		out.RawString(prefix)
+		easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels(out, in.ChecklistProgress)
+	}
+	{
+		const prefix string = ",\"comments_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.CommentsCount))
+	}
+	{
+		const prefix string = ",\"start_at\":"
+		out.RawString(prefix)
+		if in.StartAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.StartAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"due_at\":"
+		out.RawString(prefix)
+		if in.DueAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.DueAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"completed_at\":"
+		out.RawString(prefix)
+		if in.CompletedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.CompletedAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"archived_at\":"
+		out.RawString(prefix)
+		if in.ArchivedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ArchivedAt).MarshalJSON())
+		}
+	}
 	{
 		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
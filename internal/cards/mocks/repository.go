@@ -6,6 +6,7 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	cards "github.com/SlavaShagalov/my-trello-backend/internal/cards"
 	models "github.com/SlavaShagalov/my-trello-backend/internal/models"
@@ -94,19 +95,49 @@ func (mr *MockRepositoryMockRecorder) Get(id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRepository)(nil).Get), id)
 }
 
+// ListByAssignee mocks base method.
+func (m *MockRepository) ListByAssignee(userID int, sort string) ([]models.AssignedCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAssignee", userID, sort)
+	ret0, _ := ret[0].([]models.AssignedCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAssignee indicates an expected call of ListByAssignee.
+func (mr *MockRepositoryMockRecorder) ListByAssignee(userID, sort interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAssignee", reflect.TypeOf((*MockRepository)(nil).ListByAssignee), userID, sort)
+}
+
+// ListByBoard mocks base method.
+func (m *MockRepository) ListByBoard(boardID int, filter *cards.Filter) ([]models.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByBoard", boardID, filter)
+	ret0, _ := ret[0].([]models.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByBoard indicates an expected call of ListByBoard.
+func (mr *MockRepositoryMockRecorder) ListByBoard(boardID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByBoard", reflect.TypeOf((*MockRepository)(nil).ListByBoard), boardID, filter)
+}
+
 // ListByList mocks base method.
-func (m *MockRepository) ListByList(listID int) ([]models.Card, error) {
+func (m *MockRepository) ListByList(listID int, filter *cards.Filter) ([]models.Card, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListByList", listID)
+	ret := m.ctrl.Call(m, "ListByList", listID, filter)
 	ret0, _ := ret[0].([]models.Card)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListByList indicates an expected call of ListByList.
-func (mr *MockRepositoryMockRecorder) ListByList(listID interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) ListByList(listID, filter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByList", reflect.TypeOf((*MockRepository)(nil).ListByList), listID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByList", reflect.TypeOf((*MockRepository)(nil).ListByList), listID, filter)
 }
 
 // ListByTitle mocks base method.
@@ -124,6 +155,21 @@ func (mr *MockRepositoryMockRecorder) ListByTitle(title, userID interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTitle", reflect.TypeOf((*MockRepository)(nil).ListByTitle), title, userID)
 }
 
+// Move mocks base method.
+func (m *MockRepository) Move(params *cards.MoveParams) (models.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", params)
+	ret0, _ := ret[0].(models.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockRepositoryMockRecorder) Move(params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockRepository)(nil).Move), params)
+}
+
 // PartialUpdate mocks base method.
 func (m *MockRepository) PartialUpdate(params *cards.PartialUpdateParams) (models.Card, error) {
 	m.ctrl.T.Helper()
@@ -138,3 +184,33 @@ func (mr *MockRepositoryMockRecorder) PartialUpdate(params interface{}) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PartialUpdate", reflect.TypeOf((*MockRepository)(nil).PartialUpdate), params)
 }
+
+// SetArchived mocks base method.
+func (m *MockRepository) SetArchived(id int, archivedAt *time.Time, userID int) (models.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetArchived", id, archivedAt, userID)
+	ret0, _ := ret[0].(models.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetArchived indicates an expected call of SetArchived.
+func (mr *MockRepositoryMockRecorder) SetArchived(id, archivedAt, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetArchived", reflect.TypeOf((*MockRepository)(nil).SetArchived), id, archivedAt, userID)
+}
+
+// SetCompleted mocks base method.
+func (m *MockRepository) SetCompleted(id int, completedAt *time.Time) (models.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCompleted", id, completedAt)
+	ret0, _ := ret[0].(models.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCompleted indicates an expected call of SetCompleted.
+func (mr *MockRepositoryMockRecorder) SetCompleted(id, completedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCompleted", reflect.TypeOf((*MockRepository)(nil).SetCompleted), id, completedAt)
+}
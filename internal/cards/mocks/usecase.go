@@ -35,6 +35,36 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// Archive mocks base method.
+func (m *MockUsecase) Archive(id, userID int) (models.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", id, userID)
+	ret0, _ := ret[0].(models.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockUsecaseMockRecorder) Archive(id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockUsecase)(nil).Archive), id, userID)
+}
+
+// Complete mocks base method.
+func (m *MockUsecase) Complete(id int) (models.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", id)
+	ret0, _ := ret[0].(models.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockUsecaseMockRecorder) Complete(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockUsecase)(nil).Complete), id)
+}
+
 // Create mocks base method.
 func (m *MockUsecase) Create(params *cards.CreateParams) (models.Card, error) {
 	m.ctrl.T.Helper()
@@ -94,19 +124,64 @@ func (mr *MockUsecaseMockRecorder) Get(id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUsecase)(nil).Get), id)
 }
 
+// Incomplete mocks base method.
+func (m *MockUsecase) Incomplete(id int) (models.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Incomplete", id)
+	ret0, _ := ret[0].(models.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Incomplete indicates an expected call of Incomplete.
+func (mr *MockUsecaseMockRecorder) Incomplete(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Incomplete", reflect.TypeOf((*MockUsecase)(nil).Incomplete), id)
+}
+
+// ListByAssignee mocks base method.
+func (m *MockUsecase) ListByAssignee(userID int, sort string) ([]models.BoardCards, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAssignee", userID, sort)
+	ret0, _ := ret[0].([]models.BoardCards)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAssignee indicates an expected call of ListByAssignee.
+func (mr *MockUsecaseMockRecorder) ListByAssignee(userID, sort interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAssignee", reflect.TypeOf((*MockUsecase)(nil).ListByAssignee), userID, sort)
+}
+
+// ListByBoard mocks base method.
+func (m *MockUsecase) ListByBoard(boardID int, filter *cards.Filter) ([]models.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByBoard", boardID, filter)
+	ret0, _ := ret[0].([]models.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByBoard indicates an expected call of ListByBoard.
+func (mr *MockUsecaseMockRecorder) ListByBoard(boardID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByBoard", reflect.TypeOf((*MockUsecase)(nil).ListByBoard), boardID, filter)
+}
+
 // ListByList mocks base method.
-func (m *MockUsecase) ListByList(listID int) ([]models.Card, error) {
+func (m *MockUsecase) ListByList(listID int, filter *cards.Filter) ([]models.Card, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListByList", listID)
+	ret := m.ctrl.Call(m, "ListByList", listID, filter)
 	ret0, _ := ret[0].([]models.Card)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListByList indicates an expected call of ListByList.
-func (mr *MockUsecaseMockRecorder) ListByList(listID interface{}) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) ListByList(listID, filter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByList", reflect.TypeOf((*MockUsecase)(nil).ListByList), listID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByList", reflect.TypeOf((*MockUsecase)(nil).ListByList), listID, filter)
 }
 
 // ListByTitle mocks base method.
@@ -124,6 +199,21 @@ func (mr *MockUsecaseMockRecorder) ListByTitle(title, userID interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTitle", reflect.TypeOf((*MockUsecase)(nil).ListByTitle), title, userID)
 }
 
+// Move mocks base method.
+func (m *MockUsecase) Move(params *cards.MoveParams) (models.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", params)
+	ret0, _ := ret[0].(models.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockUsecaseMockRecorder) Move(params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockUsecase)(nil).Move), params)
+}
+
 // PartialUpdate mocks base method.
 func (m *MockUsecase) PartialUpdate(params *cards.PartialUpdateParams) (models.Card, error) {
 	m.ctrl.T.Helper()
@@ -138,3 +228,18 @@ func (mr *MockUsecaseMockRecorder) PartialUpdate(params interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PartialUpdate", reflect.TypeOf((*MockUsecase)(nil).PartialUpdate), params)
 }
+
+// Unarchive mocks base method.
+func (m *MockUsecase) Unarchive(id int) (models.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unarchive", id)
+	ret0, _ := ret[0].(models.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unarchive indicates an expected call of Unarchive.
+func (mr *MockUsecaseMockRecorder) Unarchive(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unarchive", reflect.TypeOf((*MockUsecase)(nil).Unarchive), id)
+}
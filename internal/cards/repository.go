@@ -1,19 +1,23 @@
 package cards
 
-import "github.com/SlavaShagalov/my-trello-backend/internal/models"
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"math"
+	"time"
+)
 
 type CreateParams struct {
 	Title   string
 	Content string
 	ListID  int
+	StartAt *time.Time
+	DueAt   *time.Time
 }
 
 type FullUpdateParams struct {
-	ID       int
-	Title    string
-	Content  string
-	Position int
-	ListID   int
+	ID      int
+	Title   string
+	Content string
 }
 
 type PartialUpdateParams struct {
@@ -26,14 +30,66 @@ type PartialUpdateParams struct {
 	UpdatePosition bool
 	ListID         int
 	UpdateListID   bool
+	StartAt        *time.Time
+	UpdateStartAt  bool
+	DueAt          *time.Time
+	UpdateDueAt    bool
+	BeforeID       int
+	AfterID        int
+}
+
+// MoveParams put the card at Position of the list ListID, or between the cards
+// BeforeID and AfterID when either is set. Positions before the first or past
+// the last one put the card first or last. The fields of Update, when set, are
+// updated with the move at once; its list and position are not used.
+type MoveParams struct {
+	ID       int
+	ListID   int
+	Position int
+	BeforeID int
+	AfterID  int
+	Update   *PartialUpdateParams
 }
 
+// LastPosition moves the card to the end of the list.
+const LastPosition = math.MaxInt32
+
+// Filter narrows card listings down. Nil fields are not applied. Archived lists
+// archived cards instead of active ones, listings of a board skip the cards of
+// its archived lists otherwise.
+type Filter struct {
+	DueBefore *time.Time
+	DueAfter  *time.Time
+	Overdue   bool
+	Completed *bool
+	Archived  bool
+}
+
+// Orders of assigned cards.
+const (
+	// SortByPosition keeps board, list and card order.
+	SortByPosition = "position"
+	// SortByDue puts the nearest due dates first and cards without one last.
+	SortByDue = "due"
+)
+
 type Repository interface {
 	Create(params *CreateParams) (models.Card, error)
-	ListByList(listID int) ([]models.Card, error)
+	ListByList(listID int, filter *Filter) ([]models.Card, error)
+	ListByBoard(boardID int, filter *Filter) ([]models.Card, error)
 	ListByTitle(title string, userID int) ([]models.Card, error)
+	ListByAssignee(userID int, sort string) ([]models.AssignedCard, error)
 	Get(id int) (models.Card, error)
 	FullUpdate(params *FullUpdateParams) (models.Card, error)
 	PartialUpdate(params *PartialUpdateParams) (models.Card, error)
+	// Move moves the active card to an active list of the same workspace. Only
+	// the rank of the card changes.
+	Move(params *MoveParams) (models.Card, error)
+	// SetCompleted marks the card completed at completedAt, or incomplete when it is nil.
+	// An already completed card keeps its original completion time.
+	SetCompleted(id int, completedAt *time.Time) (models.Card, error)
+	// SetArchived archives the card by the user at archivedAt, or restores it when
+	// archivedAt is nil. An already archived card keeps its original archiving.
+	SetArchived(id int, archivedAt *time.Time, userID int) (models.Card, error)
 	Delete(id int) error
 }
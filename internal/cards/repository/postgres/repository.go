@@ -3,12 +3,15 @@ package postgres
 import (
 	"database/sql"
 	pkgCards "github.com/SlavaShagalov/my-trello-backend/internal/cards"
+	"github.com/SlavaShagalov/my-trello-backend/internal/images"
 	"github.com/SlavaShagalov/my-trello-backend/internal/models"
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
 	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/rank"
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
+	"time"
 )
 
 type repository struct {
@@ -20,94 +23,297 @@ func New(db *sql.DB, log *zap.Logger) pkgCards.Repository {
 	return &repository{db: db, log: log}
 }
 
-const createCmd = `
-	INSERT INTO cards (list_id, title, content, position)
-	VALUES ($1, $2, $3, (SELECT COALESCE(MAX(position), 0) + 1
-							FROM cards
-						  	WHERE list_id = $1))
-	RETURNING id, list_id, title, content, position, created_at, updated_at;`
+// countCols count done and total checklist items and comments of the card
+// aliased as c.
+const countCols = `
+	(SELECT count(*) FILTER (WHERE i.done)
+	 FROM checklist_items i
+	 JOIN checklists cl on cl.id = i.checklist_id
+	 WHERE cl.card_id = c.id),
+	(SELECT count(*)
+	 FROM checklist_items i
+	 JOIN checklists cl on cl.id = i.checklist_id
+	 WHERE cl.card_id = c.id),
+	(SELECT count(*) FROM comments cm WHERE cm.card_id = c.id)`
+
+// positionCol counts the position of the card aliased as c among the active
+// cards of its list.
+const positionCol = `
+	(SELECT count(*) + 1
+	 FROM cards p
+	 WHERE p.list_id = c.list_id AND p.archived_at IS NULL AND p.rank < c.rank AND p.id <> c.id)`
+
+const (
+	lastRankCmd = `
+	SELECT max(rank)
+	FROM cards
+	WHERE list_id = $1;`
+
+	createCmd = `
+	INSERT INTO cards AS c (list_id, title, content, start_at, due_at, rank)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING id, list_id, title, content,` + positionCol + `, start_at, due_at, completed_at, archived_at,
+	          created_at, updated_at,` +
+		countCols + `;`
+)
 
-func (repo *repository) Create(params *pkgCards.CreateParams) (models.Card, error) {
-	row := repo.db.QueryRow(createCmd, params.ListID, params.Title, params.Content)
+// maxRankAttempts bounds retries of writes whose rank was taken by another
+// card meanwhile. Writes to the same place get the same rank and one of them
+// wins each time, so the bound is about the number of simultaneous writers.
+const maxRankAttempts = 10
 
-	var card models.Card
-	err := scanCard(row, &card)
-	if err != nil {
-		pgErr, ok := err.(*pq.Error)
-		if !ok {
-			repo.log.Error("Cannot convert err to pq.Error", zap.Error(err))
-			return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+func (repo *repository) Create(params *pkgCards.CreateParams) (models.Card, error) {
+	for attempt := 1; ; attempt++ {
+		card, err := repo.create(params)
+		if isRankConflict(err) && attempt < maxRankAttempts {
+			continue
 		}
-		if pgErr.Constraint == "cards_list_id_fkey" || pgErr.Code == "23502" {
-			return models.Card{}, errors.Wrap(pkgErrors.ErrListNotFound, err.Error())
+		if err != nil {
+			pgErr, ok := err.(*pq.Error)
+			if !ok {
+				repo.log.Error("Cannot convert err to pq.Error", zap.Error(err))
+				return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+			}
+			if pgErr.Constraint == "cards_list_id_fkey" || pgErr.Code == "23502" {
+				return models.Card{}, errors.Wrap(pkgErrors.ErrListNotFound, err.Error())
+			}
+			if pgErr.Constraint == "cards_dates_check" {
+				return models.Card{}, errors.Wrap(pkgErrors.ErrInvalidCardDates, err.Error())
+			}
+
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", createCmd),
+				zap.Any("create_params", params))
+			return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
 		}
 
-		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", createCmd),
-			zap.Any("create_params", params))
-		return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		repo.log.Debug("New card created", zap.Any("card", card))
+		return card, nil
 	}
+}
 
-	repo.log.Debug("New card created", zap.Any("card", card))
-	return card, nil
+// create puts the card after all cards of the list, the archived ones too.
+func (repo *repository) create(params *pkgCards.CreateParams) (models.Card, error) {
+	var last sql.NullString
+	err := repo.db.QueryRow(lastRankCmd, params.ListID).Scan(&last)
+	if err != nil {
+		return models.Card{}, err
+	}
+
+	row := repo.db.QueryRow(createCmd, params.ListID, params.Title, params.Content, params.StartAt, params.DueAt,
+		rank.After(last.String))
+
+	var card models.Card
+	err = scanCard(row, &card)
+	return card, err
 }
 
 const listCmd = `
-	SELECT id, list_id, title, content, position, created_at, updated_at
-	FROM cards
+	SELECT id, list_id, title, content,` + positionCol + `, start_at, due_at, completed_at, archived_at, created_at,
+	       updated_at,` + countCols + `
+	FROM cards c
 	WHERE list_id = $1
-	ORDER BY position;`
-
-func (repo *repository) ListByList(listID int) ([]models.Card, error) {
-	rows, err := repo.db.Query(listCmd, listID)
+	  AND ($2::timestamp IS NULL OR due_at < $2)
+	  AND ($3::timestamp IS NULL OR due_at > $3)
+	  AND ($4::timestamp IS NULL OR (due_at < $4 AND completed_at IS NULL))
+	  AND ($5::boolean IS NULL OR (completed_at IS NOT NULL) = $5)
+	  AND (archived_at IS NOT NULL) = $6
+	ORDER BY archived_at DESC, rank;`
+
+func (repo *repository) ListByList(listID int, filter *pkgCards.Filter) ([]models.Card, error) {
+	cards, err := repo.list(listCmd, listID, filter)
 	if err != nil {
 		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listCmd),
-			zap.Int("list_id", listID))
+			zap.Int("list_id", listID), zap.Any("filter", filter))
 		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
 	}
+	return cards, nil
+}
+
+const listByBoardCmd = `
+	SELECT c.id, c.list_id, c.title, c.content,` + positionCol + `, c.start_at, c.due_at, c.completed_at,
+	       c.archived_at, c.created_at, c.updated_at,` + countCols + `
+	FROM cards c
+	JOIN lists l on l.id = c.list_id
+	WHERE l.board_id = $1
+	  AND ($2::timestamp IS NULL OR c.due_at < $2)
+	  AND ($3::timestamp IS NULL OR c.due_at > $3)
+	  AND ($4::timestamp IS NULL OR (c.due_at < $4 AND c.completed_at IS NULL))
+	  AND ($5::boolean IS NULL OR (c.completed_at IS NOT NULL) = $5)
+	  AND (c.archived_at IS NOT NULL) = $6
+	  AND ($6 OR l.archived_at IS NULL)
+	ORDER BY c.archived_at DESC, l.rank, c.rank;`
+
+func (repo *repository) ListByBoard(boardID int, filter *pkgCards.Filter) ([]models.Card, error) {
+	cards, err := repo.list(listByBoardCmd, boardID, filter)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listByBoardCmd),
+			zap.Int("board_id", boardID), zap.Any("filter", filter))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	return cards, nil
+}
+
+// list runs one of the filtered listing queries and fills labels of the found cards.
+func (repo *repository) list(cmd string, id int, filter *pkgCards.Filter) ([]models.Card, error) {
+	if filter == nil {
+		filter = &pkgCards.Filter{}
+	}
+	var overdueAt *time.Time
+	if filter.Overdue {
+		now := time.Now().UTC()
+		overdueAt = &now
+	}
+
+	rows, err := repo.db.Query(cmd, id, filter.DueBefore, filter.DueAfter, overdueAt, filter.Completed,
+		filter.Archived)
+	if err != nil {
+		return nil, err
+	}
 	defer func() {
 		_ = rows.Close()
 	}()
 
 	cards := []models.Card{}
-	var card models.Card
-	var content sql.NullString
 	for rows.Next() {
-		err = rows.Scan(
-			&card.ID,
-			&card.ListID,
-			&card.Title,
-			&content,
-			&card.Position,
-			&card.CreatedAt,
-			&card.UpdatedAt,
-		)
+		var card models.Card
+		err = scanCard(rows, &card)
 		if err != nil {
-			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", listCmd),
-				zap.Int("list_id", listID))
-			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+			return nil, err
 		}
 
-		card.Content = content.String
 		cards = append(cards, card)
 	}
 
+	ptrs := make([]*models.Card, len(cards))
+	for i := range cards {
+		ptrs[i] = &cards[i]
+	}
+	err = repo.fill(ptrs)
+	if err != nil {
+		return nil, err
+	}
+
 	return cards, nil
 }
 
+// fill attaches labels and assignees to the cards, a single query for each.
+func (repo *repository) fill(cards []*models.Card) error {
+	if len(cards) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(cards))
+	byID := make(map[int]*models.Card, len(cards))
+	for i, card := range cards {
+		card.Labels = []models.Label{}
+		card.Assignees = []models.Assignee{}
+		ids[i] = int64(card.ID)
+		byID[card.ID] = card
+	}
+
+	err := repo.fillLabels(ids, byID)
+	if err != nil {
+		return err
+	}
+	return repo.fillAssignees(ids, byID)
+}
+
+const listLabelsCmd = `
+	SELECT cl.card_id, lb.id, lb.board_id, lb.name, lb.color, lb.created_at, lb.updated_at
+	FROM card_labels cl
+	JOIN labels lb on lb.id = cl.label_id
+	WHERE cl.card_id = ANY($1)
+	ORDER BY lb.id;`
+
+func (repo *repository) fillLabels(ids []int64, byID map[int]*models.Card) error {
+	rows, err := repo.db.Query(listLabelsCmd, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var cardID int
+	var label models.Label
+	for rows.Next() {
+		err = rows.Scan(
+			&cardID,
+			&label.ID,
+			&label.BoardID,
+			&label.Name,
+			&label.Color,
+			&label.CreatedAt,
+			&label.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		if card, ok := byID[cardID]; ok {
+			card.Labels = append(card.Labels, label)
+		}
+	}
+
+	return nil
+}
+
+const listAssigneesCmd = `
+	SELECT a.card_id, u.id, u.username, u.name, u.avatar, a.created_at
+	FROM card_assignees a
+	JOIN users u on u.id = a.user_id
+	WHERE a.card_id = ANY($1)
+	ORDER BY a.created_at, u.id;`
+
+func (repo *repository) fillAssignees(ids []int64, byID map[int]*models.Card) error {
+	rows, err := repo.db.Query(listAssigneesCmd, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var cardID int
+	for rows.Next() {
+		var assignee models.Assignee
+		err = rows.Scan(
+			&cardID,
+			&assignee.UserID,
+			&assignee.Username,
+			&assignee.Name,
+			&assignee.Avatar,
+			&assignee.AssignedAt,
+		)
+		if err != nil {
+			return err
+		}
+		assignee.Avatar = images.OptionalURL(assignee.Avatar)
+
+		if card, ok := byID[cardID]; ok {
+			card.Assignees = append(card.Assignees, assignee)
+		}
+	}
+
+	return nil
+}
+
 const listByTitleCmd = `
-	SELECT c.id, c.list_id, c.title, c.content, c.position, c.created_at, c.updated_at
+	SELECT c.id, c.list_id, c.title, c.content,` + positionCol + `, c.start_at, c.due_at, c.completed_at,
+	       c.archived_at, c.created_at, c.updated_at,` + countCols + `
 	FROM cards c
 	JOIN lists l on l.id = c.list_id
 	JOIN boards b on b.id = l.board_id
-	JOIN workspaces w on w.id = b.workspace_id
-	WHERE lower(c.title) LIKE lower('%' || $1 || '%') AND w.user_id = $2
-	ORDER BY position;`
+	JOIN workspace_members m on m.workspace_id = b.workspace_id
+	WHERE lower(c.title) LIKE lower('%' || $1 || '%') AND m.user_id = $2
+	  AND c.archived_at IS NULL AND l.archived_at IS NULL AND b.archived_at IS NULL
+	ORDER BY l.board_id, l.rank, c.rank;`
 
-func (repo *repository) ListByTitle(title string, listID int) ([]models.Card, error) {
-	rows, err := repo.db.Query(listByTitleCmd, title, listID)
+func (repo *repository) ListByTitle(title string, userID int) ([]models.Card, error) {
+	rows, err := repo.db.Query(listByTitleCmd, title, userID)
 	if err != nil {
-		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql", listByTitleCmd),
-			zap.String("title", title), zap.Int("list_id", listID))
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listByTitleCmd),
+			zap.String("title", title), zap.Int("user_id", userID))
 		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
 	}
 	defer func() {
@@ -115,34 +321,90 @@ func (repo *repository) ListByTitle(title string, listID int) ([]models.Card, er
 	}()
 
 	cards := []models.Card{}
-	var card models.Card
-	var content sql.NullString
 	for rows.Next() {
-		err = rows.Scan(
-			&card.ID,
-			&card.ListID,
-			&card.Title,
-			&content,
-			&card.Position,
-			&card.CreatedAt,
-			&card.UpdatedAt,
-		)
+		var card models.Card
+		err = scanCard(rows, &card)
 		if err != nil {
-			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql", listByTitleCmd),
-				zap.Int("list_id", listID))
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", listByTitleCmd),
+				zap.String("title", title), zap.Int("user_id", userID))
 			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
 		}
 
-		card.Content = content.String
 		cards = append(cards, card)
 	}
 
+	ptrs := make([]*models.Card, len(cards))
+	for i := range cards {
+		ptrs[i] = &cards[i]
+	}
+	err = repo.fill(ptrs)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Int("user_id", userID))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	return cards, nil
+}
+
+// listByAssigneeCmd leaves $2 false to keep board, list and card order.
+const listByAssigneeCmd = `
+	SELECT c.id, c.list_id, c.title, c.content,` + positionCol + `, c.start_at, c.due_at, c.completed_at,
+	       c.archived_at, c.created_at, c.updated_at,` + countCols + `,
+	       l.title, b.id, b.title, b.workspace_id
+	FROM card_assignees a
+	JOIN cards c on c.id = a.card_id
+	JOIN lists l on l.id = c.list_id
+	JOIN boards b on b.id = l.board_id
+	WHERE a.user_id = $1
+	  AND c.archived_at IS NULL AND l.archived_at IS NULL AND b.archived_at IS NULL
+	ORDER BY CASE WHEN $2 THEN c.due_at END NULLS LAST, b.workspace_id, b.id, l.rank, c.rank;`
+
+func (repo *repository) ListByAssignee(userID int, sort string) ([]models.AssignedCard, error) {
+	rows, err := repo.db.Query(listByAssigneeCmd, userID, sort == pkgCards.SortByDue)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listByAssigneeCmd),
+			zap.Int("user_id", userID), zap.String("sort", sort))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	cards := []models.AssignedCard{}
+	for rows.Next() {
+		var card models.AssignedCard
+		err = scanCard(withExtra{row: rows, extra: []interface{}{
+			&card.ListTitle,
+			&card.BoardID,
+			&card.BoardTitle,
+			&card.WorkspaceID,
+		}}, &card.Card)
+		if err != nil {
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", listByAssigneeCmd),
+				zap.Int("user_id", userID), zap.String("sort", sort))
+			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+
+		cards = append(cards, card)
+	}
+
+	ptrs := make([]*models.Card, len(cards))
+	for i := range cards {
+		ptrs[i] = &cards[i].Card
+	}
+	err = repo.fill(ptrs)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Int("user_id", userID))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
 	return cards, nil
 }
 
 const getCmd = `
-	SELECT id, list_id, title, content, position, created_at, updated_at
-	FROM cards
+	SELECT id, list_id, title, content,` + positionCol + `, start_at, due_at, completed_at, archived_at, created_at,
+	       updated_at,` + countCols + `
+	FROM cards c
 	WHERE id = $1;`
 
 func (repo *repository) Get(id int) (models.Card, error) {
@@ -164,20 +426,24 @@ func (repo *repository) Get(id int) (models.Card, error) {
 }
 
 const fullUpdateCmd = `
-	UPDATE cards
-	SET title    = $1,
-	    content  = $2,
-		position = $3,
-		list_id  = $4
-	WHERE id = $5
-	RETURNING id, list_id, title, content, position, created_at, updated_at;`
+	UPDATE cards c
+	SET title   = $1,
+		content = $2
+	WHERE id = $3
+	RETURNING id, list_id, title, content,` + positionCol + `, start_at, due_at, completed_at, archived_at,
+	          created_at, updated_at,` +
+	countCols + `;`
 
 func (repo *repository) FullUpdate(params *pkgCards.FullUpdateParams) (models.Card, error) {
-	row := repo.db.QueryRow(fullUpdateCmd, params.Title, params.Content, params.Position, params.ListID, params.ID)
+	row := repo.db.QueryRow(fullUpdateCmd, params.Title, params.Content, params.ID)
 
 	var card models.Card
 	err := scanCard(row, &card)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Card{}, errors.Wrap(pkgErrors.ErrCardNotFound, err.Error())
+		}
+
 		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", fullUpdateCmd),
 			zap.Any("params", params))
 		return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
@@ -188,55 +454,449 @@ func (repo *repository) FullUpdate(params *pkgCards.FullUpdateParams) (models.Ca
 }
 
 const partialUpdateCmd = `
-	UPDATE cards
+	UPDATE cards c
 	SET title    = CASE WHEN $1::boolean THEN $2 ELSE title END,
 		content  = CASE WHEN $3::boolean THEN $4 ELSE content END,
-		position = CASE WHEN $5::boolean THEN $6 ELSE position END,
-		list_id  = CASE WHEN $7::boolean THEN $8 ELSE list_id END
+		start_at = CASE WHEN $5::boolean THEN $6 ELSE start_at END,
+		due_at   = CASE WHEN $7::boolean THEN $8 ELSE due_at END
 	WHERE id = $9
-	RETURNING id, list_id, title, content, position, created_at, updated_at;`
-
-const partialUpdateAfterCmd = `
-	CALL update_cards_positions($1, $2);`
+	RETURNING id, list_id, title, content,` + positionCol + `, start_at, due_at, completed_at, archived_at,
+	          created_at, updated_at,` +
+	countCols + `;`
 
+// PartialUpdate updates the card in place. List and position changes go through Move.
 func (repo *repository) PartialUpdate(params *pkgCards.PartialUpdateParams) (models.Card, error) {
-	if params.UpdatePosition {
-		_, err := repo.db.Exec(partialUpdateAfterCmd, params.Position, params.ID)
-		if err != nil {
-			return models.Card{}, err
+	row := repo.db.QueryRow(partialUpdateCmd, partialUpdateArgs(params)...)
+
+	var card models.Card
+	err := scanCard(row, &card)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Card{}, pkgErrors.ErrCardNotFound
+		}
+
+		pgErr, _ := err.(*pq.Error)
+		if pgErr != nil && pgErr.Constraint == "cards_dates_check" {
+			return models.Card{}, errors.Wrap(pkgErrors.ErrInvalidCardDates, err.Error())
 		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", partialUpdateCmd),
+			zap.Any("params", params))
+		return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
 	}
 
-	row := repo.db.QueryRow(partialUpdateCmd,
+	repo.log.Debug("Card partial updated", zap.Any("card", card))
+	return card, nil
+}
+
+func partialUpdateArgs(params *pkgCards.PartialUpdateParams) []interface{} {
+	return []interface{}{
 		params.UpdateTitle,
 		params.Title,
 		params.UpdateContent,
 		params.Content,
-		params.UpdatePosition,
-		params.Position,
-		params.UpdateListID,
-		params.ListID,
+		params.UpdateStartAt,
+		params.StartAt,
+		params.UpdateDueAt,
+		params.DueAt,
 		params.ID,
-	)
+	}
+}
+
+const (
+	cardListCmd = `
+	SELECT list_id
+	FROM cards
+	WHERE id = $1;`
+
+	// lockListsCmd locks the source and target lists in the order of their ids.
+	// Moves lock lists before cards, so that moves between the same lists in both
+	// directions do not deadlock. The lists are not archived or moved meanwhile.
+	lockListsCmd = `
+	SELECT l.id, b.workspace_id, l.archived_at IS NOT NULL
+	FROM lists l
+	JOIN boards b on b.id = l.board_id
+	WHERE l.id IN ($1, $2)
+	ORDER BY l.id
+	FOR UPDATE OF l;`
+
+	lockCardCmd = `
+	SELECT list_id, archived_at IS NOT NULL
+	FROM cards
+	WHERE id = $1
+	FOR UPDATE;`
+
+	// neighborRankCmd returns the rank of the active card $1 of the list $2
+	// other than the moved card $3.
+	neighborRankCmd = `
+	SELECT rank
+	FROM cards
+	WHERE id = $1 AND list_id = $2 AND archived_at IS NULL AND id <> $3;`
+
+	// precedingRankCmd returns the rank of the last of the first $3 active cards
+	// of the list $1 without the moved card $2.
+	precedingRankCmd = `
+	SELECT max(rank)
+	FROM (SELECT rank
+		  FROM cards
+		  WHERE list_id = $1 AND archived_at IS NULL AND id <> $2
+		  ORDER BY rank
+		  LIMIT $3) r;`
+
+	// prevRankCmd returns the greatest rank of the list $1 below $3.
+	prevRankCmd = `
+	SELECT max(rank)
+	FROM cards
+	WHERE list_id = $1 AND id <> $2 AND rank < $3;`
+
+	// nextRankCmd returns the least rank of the list $1 above $3, or the least
+	// one when $3 is NULL, of all and of active cards. Archived cards keep their
+	// ranks, so the new rank must not take theirs.
+	nextRankCmd = `
+	SELECT min(rank), min(rank) FILTER (WHERE archived_at IS NULL)
+	FROM cards
+	WHERE list_id = $1 AND id <> $2 AND ($3::varchar IS NULL OR rank > $3);`
+
+	moveCmd = `
+	UPDATE cards c
+	SET list_id = $1,
+		rank    = $2
+	WHERE id = $3
+	RETURNING id, list_id, title, content,` + positionCol + `, start_at, due_at, completed_at, archived_at,
+	          created_at, updated_at,` +
+		countCols + `;`
+
+	// moveLabelsCmd creates on the board of the card $1 the labels of the card
+	// from another board that the board has no label of the same name and color
+	// for.
+	moveLabelsCmd = `
+	INSERT INTO labels (board_id, name, color)
+	SELECT DISTINCT l.board_id, lb.name, lb.color
+	FROM cards c
+	JOIN lists l on l.id = c.list_id
+	JOIN card_labels cl on cl.card_id = c.id
+	JOIN labels lb on lb.id = cl.label_id
+	WHERE c.id = $1 AND lb.board_id <> l.board_id
+	  AND NOT EXISTS(SELECT 1 FROM labels t WHERE t.board_id = l.board_id AND t.name = lb.name AND t.color = lb.color);`
+
+	// moveCardLabelsCmd attaches to the card $1 the first label of its board of
+	// the same name and color as each of its labels from another board.
+	moveCardLabelsCmd = `
+	INSERT INTO card_labels (card_id, label_id)
+	SELECT DISTINCT ON (lb.id) c.id, t.id
+	FROM cards c
+	JOIN lists l on l.id = c.list_id
+	JOIN card_labels cl on cl.card_id = c.id
+	JOIN labels lb on lb.id = cl.label_id
+	JOIN labels t on t.board_id = l.board_id AND t.name = lb.name AND t.color = lb.color
+	WHERE c.id = $1 AND lb.board_id <> l.board_id
+	ORDER BY lb.id, t.id
+	ON CONFLICT DO NOTHING;`
+
+	// detachLabelsCmd detaches from the card $1 the labels of other boards.
+	detachLabelsCmd = `
+	DELETE FROM card_labels cl
+	USING cards c, lists l, labels lb
+	WHERE cl.card_id = $1 AND c.id = cl.card_id AND l.id = c.list_id AND lb.id = cl.label_id
+	  AND lb.board_id <> l.board_id;`
+)
+
+// errMovedMeanwhile is returned when the card was moved by another transaction
+// between reading its list and locking it.
+var errMovedMeanwhile = errors.New("card moved meanwhile")
+
+func (repo *repository) Move(params *pkgCards.MoveParams) (models.Card, error) {
+	for attempt := 1; ; attempt++ {
+		card, err := repo.move(params)
+		if (isRankConflict(err) || errors.Is(err, errMovedMeanwhile)) && attempt < maxRankAttempts {
+			continue
+		}
+		if isRankConflict(err) || errors.Is(err, errMovedMeanwhile) {
+			repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", moveCmd),
+				zap.Any("params", params))
+			return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+		return card, err
+	}
+}
+
+// move gives the card a rank between its new neighbors. The lists and the card
+// are locked, so the checks hold until the commit. Only the row of the card is
+// written.
+func (repo *repository) move(params *pkgCards.MoveParams) (models.Card, error) {
+	var fromListID int
+	err := repo.db.QueryRow(cardListCmd, params.ID).Scan(&fromListID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Card{}, errors.Wrap(pkgErrors.ErrCardNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", cardListCmd),
+			zap.Any("params", params))
+		return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	tx, err := repo.db.Begin()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Any("params", params))
+		return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	err = repo.lockLists(tx, fromListID, params.ListID)
+	if err != nil {
+		return models.Card{}, err
+	}
+
+	var listID int
+	var archived bool
+	err = tx.QueryRow(lockCardCmd, params.ID).Scan(&listID, &archived)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Card{}, errors.Wrap(pkgErrors.ErrCardNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", lockCardCmd),
+			zap.Any("params", params))
+		return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	if listID != fromListID {
+		return models.Card{}, errMovedMeanwhile
+	}
+	if archived {
+		return models.Card{}, pkgErrors.ErrCardArchived
+	}
+
+	newRank, err := rankAt(tx, params)
+	if err != nil {
+		if isRankConflict(err) || errors.Is(err, pkgErrors.ErrBadCardNeighbors) {
+			return models.Card{}, err
+		}
+
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Any("params", params))
+		return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	row := tx.QueryRow(moveCmd, params.ListID, newRank, params.ID)
+
+	var card models.Card
+	err = scanCard(row, &card)
+	if err != nil {
+		if isRankConflict(err) {
+			return models.Card{}, err
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", moveCmd),
+			zap.Any("params", params))
+		return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	err = repo.moveLabels(tx, card.ID)
+	if err != nil {
+		return models.Card{}, err
+	}
+
+	if params.Update != nil {
+		update := *params.Update
+		update.ID = params.ID
+		err = scanCard(tx.QueryRow(partialUpdateCmd, partialUpdateArgs(&update)...), &card)
+		if err != nil {
+			var pgErr *pq.Error
+			if errors.As(err, &pgErr) && pgErr.Constraint == "cards_dates_check" {
+				return models.Card{}, errors.Wrap(pkgErrors.ErrInvalidCardDates, err.Error())
+			}
+
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", partialUpdateCmd),
+				zap.Any("params", params))
+			return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Any("params", params))
+		return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("Card moved", zap.Int("from_list_id", fromListID), zap.Any("card", card))
+	return card, nil
+}
+
+// moveLabels replaces the labels of a card moved to another board with the
+// labels of the same name and color of its new board, created when missing.
+func (repo *repository) moveLabels(tx *sql.Tx, cardID int) error {
+	for _, cmd := range []string{moveLabelsCmd, moveCardLabelsCmd, detachLabelsCmd} {
+		_, err := tx.Exec(cmd, cardID)
+		if err != nil {
+			repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", cmd),
+				zap.Int("card_id", cardID))
+			return errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+	}
+	return nil
+}
+
+// lockLists locks the lists and checks that the card can be moved from one to
+// the other.
+func (repo *repository) lockLists(tx *sql.Tx, fromListID, toListID int) error {
+	rows, err := tx.Query(lockListsCmd, fromListID, toListID)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", lockListsCmd),
+			zap.Int("from_list_id", fromListID), zap.Int("to_list_id", toListID))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	workspaces := make(map[int]int, 2)
+	var toArchived bool
+	for rows.Next() {
+		var listID, workspaceID int
+		var archived bool
+		err = rows.Scan(&listID, &workspaceID, &archived)
+		if err != nil {
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", lockListsCmd),
+				zap.Int("from_list_id", fromListID), zap.Int("to_list_id", toListID))
+			return errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+		workspaces[listID] = workspaceID
+		if listID == toListID {
+			toArchived = archived
+		}
+	}
+	if err = rows.Err(); err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", lockListsCmd),
+			zap.Int("from_list_id", fromListID), zap.Int("to_list_id", toListID))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	toWorkspaceID, ok := workspaces[toListID]
+	if !ok {
+		return pkgErrors.ErrListNotFound
+	}
+	if toWorkspaceID != workspaces[fromListID] {
+		return pkgErrors.ErrCardMoveOtherWorkspace
+	}
+	if toArchived {
+		return pkgErrors.ErrListArchived
+	}
+	return nil
+}
+
+// rankAt returns a rank that puts the card where params say.
+func rankAt(tx *sql.Tx, params *pkgCards.MoveParams) (string, error) {
+	var prev, next, nextActive sql.NullString
+	var err error
+	switch {
+	case params.BeforeID != 0:
+		err = tx.QueryRow(neighborRankCmd, params.BeforeID, params.ListID, params.ID).Scan(&prev)
+	case params.AfterID != 0:
+		var after string
+		err = tx.QueryRow(neighborRankCmd, params.AfterID, params.ListID, params.ID).Scan(&after)
+		if err == nil {
+			err = tx.QueryRow(prevRankCmd, params.ListID, params.ID, after).Scan(&prev)
+		}
+	default:
+		position := params.Position
+		if position < 1 {
+			position = 1
+		}
+		err = tx.QueryRow(precedingRankCmd, params.ListID, params.ID, position-1).Scan(&prev)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", pkgErrors.ErrBadCardNeighbors
+	}
+	if err != nil {
+		return "", err
+	}
+
+	err = tx.QueryRow(nextRankCmd, params.ListID, params.ID, prev).Scan(&next, &nextActive)
+	if err != nil {
+		return "", err
+	}
+
+	if params.BeforeID != 0 && params.AfterID != 0 {
+		// The cards must be adjacent, but archived cards may lie between them.
+		var after string
+		err = tx.QueryRow(neighborRankCmd, params.AfterID, params.ListID, params.ID).Scan(&after)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return "", err
+		}
+		if err != nil || nextActive.String != after {
+			return "", pkgErrors.ErrBadCardNeighbors
+		}
+	}
+
+	return rank.Between(prev.String, next.String)
+}
+
+// isRankConflict reports whether the rank was taken by another card, or the
+// ranks around it were rebalanced, meanwhile.
+func isRankConflict(err error) bool {
+	var pgErr *pq.Error
+	if errors.As(err, &pgErr) {
+		return pgErr.Constraint == "cards_rank_key"
+	}
+	return errors.Is(err, rank.ErrOrder)
+}
+
+const setCompletedCmd = `
+	UPDATE cards c
+	SET completed_at = CASE WHEN $1::timestamp IS NULL THEN NULL ELSE COALESCE(completed_at, $1) END
+	WHERE id = $2
+	RETURNING id, list_id, title, content,` + positionCol + `, start_at, due_at, completed_at, archived_at,
+	          created_at, updated_at,` +
+	countCols + `;`
+
+func (repo *repository) SetCompleted(id int, completedAt *time.Time) (models.Card, error) {
+	row := repo.db.QueryRow(setCompletedCmd, completedAt, id)
 
 	var card models.Card
 	err := scanCard(row, &card)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return models.Card{}, pkgErrors.ErrCardNotFound
+			return models.Card{}, errors.Wrap(pkgErrors.ErrCardNotFound, err.Error())
 		}
 
-		pgErr, _ := err.(*pq.Error)
-		if pgErr.Constraint == "cards_list_id_fkey" || pgErr.Code == "23502" {
-			return models.Card{}, errors.Wrap(pkgErrors.ErrListNotFound, err.Error())
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", setCompletedCmd),
+			zap.Int("id", id))
+		return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("Card completion updated", zap.Any("card", card))
+	return card, nil
+}
+
+// setArchivedCmd keeps the rank of the card, so a restored card gets back
+// between the cards it was archived from.
+const setArchivedCmd = `
+	UPDATE cards c
+	SET archived_at = CASE WHEN $1::timestamp IS NULL THEN NULL ELSE COALESCE(archived_at, $1) END,
+		archived_by = CASE WHEN $1::timestamp IS NULL THEN NULL WHEN archived_at IS NULL THEN $3 ELSE archived_by END
+	WHERE id = $2
+	RETURNING id, list_id, title, content,` + positionCol + `, start_at, due_at, completed_at, archived_at,
+	          created_at, updated_at,` +
+	countCols + `;`
+
+func (repo *repository) SetArchived(id int, archivedAt *time.Time, userID int) (models.Card, error) {
+	row := repo.db.QueryRow(setArchivedCmd, archivedAt, id, userID)
+
+	var card models.Card
+	err := scanCard(row, &card)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Card{}, errors.Wrap(pkgErrors.ErrCardNotFound, err.Error())
 		}
 
-		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", partialUpdateCmd),
-			zap.Any("params", params))
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", setArchivedCmd),
+			zap.Int("id", id))
 		return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
 	}
 
-	repo.log.Debug("Card partial updated", zap.Any("card", card))
+	repo.log.Debug("Card archiving updated", zap.Any("card", card))
 	return card, nil
 }
 
@@ -267,21 +927,54 @@ func (repo *repository) Delete(id int) error {
 	return nil
 }
 
-func scanCard(row *sql.Row, card *models.Card) error {
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// withExtra scans the columns that follow the card ones into extra.
+type withExtra struct {
+	row   scanner
+	extra []interface{}
+}
+
+func (s withExtra) Scan(dest ...interface{}) error {
+	return s.row.Scan(append(dest, s.extra...)...)
+}
+
+func scanCard(row scanner, card *models.Card) error {
 	var content sql.NullString
+	var startAt, dueAt, completedAt, archivedAt sql.NullTime
 	err := row.Scan(
 		&card.ID,
 		&card.ListID,
 		&card.Title,
 		&content,
 		&card.Position,
+		&startAt,
+		&dueAt,
+		&completedAt,
+		&archivedAt,
 		&card.CreatedAt,
 		&card.UpdatedAt,
+		&card.ChecklistProgress.Done,
+		&card.ChecklistProgress.Total,
+		&card.CommentsCount,
 	)
 	if err != nil {
 		return err
 	}
 
 	card.Content = content.String
+	card.StartAt = nullTime(startAt)
+	card.DueAt = nullTime(dueAt)
+	card.CompletedAt = nullTime(completedAt)
+	card.ArchivedAt = nullTime(archivedAt)
 	return nil
 }
+
+func nullTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
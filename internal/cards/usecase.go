@@ -6,10 +6,20 @@ import (
 
 type Usecase interface {
 	Create(params *CreateParams) (models.Card, error)
-	ListByList(listID int) ([]models.Card, error)
+	ListByList(listID int, filter *Filter) ([]models.Card, error)
+	ListByBoard(boardID int, filter *Filter) ([]models.Card, error)
 	ListByTitle(title string, userID int) ([]models.Card, error)
+	// ListByAssignee groups cards assigned to the user by board. Boards go in
+	// the order of their first card. An empty sort means SortByPosition.
+	ListByAssignee(userID int, sort string) ([]models.BoardCards, error)
 	Get(id int) (models.Card, error)
 	FullUpdate(params *FullUpdateParams) (models.Card, error)
+	// PartialUpdate moves the card first when its list or position changes.
 	PartialUpdate(params *PartialUpdateParams) (models.Card, error)
+	Move(params *MoveParams) (models.Card, error)
+	Complete(id int) (models.Card, error)
+	Incomplete(id int) (models.Card, error)
+	Archive(id, userID int) (models.Card, error)
+	Unarchive(id int) (models.Card, error)
 	Delete(id int) error
 }
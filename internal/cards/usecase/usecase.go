@@ -2,41 +2,231 @@ package usecase
 
 import (
 	"github.com/SlavaShagalov/my-trello-backend/internal/cards"
+	"github.com/SlavaShagalov/my-trello-backend/internal/events"
+	"github.com/SlavaShagalov/my-trello-backend/internal/lists"
 	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"time"
 )
 
 type usecase struct {
-	repo cards.Repository
+	repo      cards.Repository
+	listsRepo lists.Repository
+	bus       events.Bus
 }
 
-func New(repo cards.Repository) cards.Usecase {
-	return &usecase{repo: repo}
+func New(repo cards.Repository, listsRepo lists.Repository, bus events.Bus) cards.Usecase {
+	return &usecase{
+		repo:      repo,
+		listsRepo: listsRepo,
+		bus:       bus,
+	}
 }
 
 func (uc *usecase) Create(params *cards.CreateParams) (models.Card, error) {
-	return uc.repo.Create(params)
+	card, err := uc.repo.Create(params)
+	if err != nil {
+		return card, err
+	}
+
+	uc.publish(models.EventCardCreated, card.ListID, card)
+	return card, nil
+}
+
+func (uc *usecase) ListByList(listID int, filter *cards.Filter) ([]models.Card, error) {
+	return uc.repo.ListByList(listID, filter)
 }
 
-func (uc *usecase) ListByList(userID int) ([]models.Card, error) {
-	return uc.repo.ListByList(userID)
+func (uc *usecase) ListByBoard(boardID int, filter *cards.Filter) ([]models.Card, error) {
+	return uc.repo.ListByBoard(boardID, filter)
 }
 
 func (uc *usecase) ListByTitle(title string, userID int) ([]models.Card, error) {
 	return uc.repo.ListByTitle(title, userID)
 }
 
+func (uc *usecase) ListByAssignee(userID int, sort string) ([]models.BoardCards, error) {
+	switch sort {
+	case "":
+		sort = cards.SortByPosition
+	case cards.SortByPosition, cards.SortByDue:
+	default:
+		return nil, pkgErrors.ErrBadCardSort
+	}
+
+	assigned, err := uc.repo.ListByAssignee(userID, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	boards := []models.BoardCards{}
+	index := make(map[int]int)
+	for _, card := range assigned {
+		i, ok := index[card.BoardID]
+		if !ok {
+			i = len(boards)
+			index[card.BoardID] = i
+			boards = append(boards, models.BoardCards{
+				BoardID:     card.BoardID,
+				BoardTitle:  card.BoardTitle,
+				WorkspaceID: card.WorkspaceID,
+			})
+		}
+		boards[i].Cards = append(boards[i].Cards, card)
+	}
+
+	return boards, nil
+}
+
 func (uc *usecase) Get(id int) (models.Card, error) {
 	return uc.repo.Get(id)
 }
 
 func (uc *usecase) FullUpdate(params *cards.FullUpdateParams) (models.Card, error) {
-	return uc.repo.FullUpdate(params)
+	card, err := uc.repo.FullUpdate(params)
+	if err != nil {
+		return card, err
+	}
+
+	uc.publish(models.EventCardUpdated, card.ListID, card)
+	return card, nil
 }
 
 func (uc *usecase) PartialUpdate(params *cards.PartialUpdateParams) (models.Card, error) {
-	return uc.repo.PartialUpdate(params)
+	if params.UpdateListID || params.UpdatePosition || params.BeforeID != 0 || params.AfterID != 0 {
+		from, err := uc.repo.Get(params.ID)
+		if err != nil {
+			return from, err
+		}
+
+		moveParams := cards.MoveParams{
+			ID:       params.ID,
+			ListID:   from.ListID,
+			Position: cards.LastPosition,
+			BeforeID: params.BeforeID,
+			AfterID:  params.AfterID,
+		}
+		if params.UpdateListID {
+			moveParams.ListID = params.ListID
+		}
+		if params.UpdatePosition {
+			moveParams.Position = params.Position
+		}
+		updated := params.UpdateTitle || params.UpdateContent || params.UpdateStartAt || params.UpdateDueAt
+		if updated {
+			// The fields are updated with the move at once.
+			moveParams.Update = params
+		}
+		card, err := uc.move(&moveParams, from.ListID)
+		if err != nil {
+			return card, err
+		}
+
+		if updated {
+			uc.publish(models.EventCardUpdated, card.ListID, card)
+		}
+		return card, nil
+	}
+
+	card, err := uc.repo.PartialUpdate(params)
+	if err != nil {
+		return card, err
+	}
+
+	uc.publish(models.EventCardUpdated, card.ListID, card)
+	return card, nil
+}
+
+func (uc *usecase) Move(params *cards.MoveParams) (models.Card, error) {
+	from, err := uc.repo.Get(params.ID)
+	if err != nil {
+		return from, err
+	}
+
+	return uc.move(params, from.ListID)
+}
+
+// move moves the card and notifies the boards of both lists when the card
+// leaves its board.
+func (uc *usecase) move(params *cards.MoveParams, fromListID int) (models.Card, error) {
+	card, err := uc.repo.Move(params)
+	if err != nil {
+		return card, err
+	}
+
+	list, err := uc.listsRepo.Get(card.ListID)
+	if err != nil {
+		return card, nil
+	}
+	uc.bus.Publish(events.New(models.EventCardMoved, list.BoardID, card))
+	if fromListID != card.ListID {
+		fromList, err := uc.listsRepo.Get(fromListID)
+		if err == nil && fromList.BoardID != list.BoardID {
+			uc.bus.Publish(events.New(models.EventCardMoved, fromList.BoardID, card))
+		}
+	}
+	return card, nil
+}
+
+func (uc *usecase) Complete(id int) (models.Card, error) {
+	now := time.Now().UTC()
+	return uc.setCompleted(id, &now)
+}
+
+func (uc *usecase) Incomplete(id int) (models.Card, error) {
+	return uc.setCompleted(id, nil)
+}
+
+func (uc *usecase) setCompleted(id int, completedAt *time.Time) (models.Card, error) {
+	card, err := uc.repo.SetCompleted(id, completedAt)
+	if err != nil {
+		return card, err
+	}
+
+	uc.publish(models.EventCardUpdated, card.ListID, card)
+	return card, nil
+}
+
+func (uc *usecase) Archive(id, userID int) (models.Card, error) {
+	now := time.Now().UTC()
+	return uc.setArchived(id, &now, userID)
+}
+
+func (uc *usecase) Unarchive(id int) (models.Card, error) {
+	return uc.setArchived(id, nil, 0)
+}
+
+func (uc *usecase) setArchived(id int, archivedAt *time.Time, userID int) (models.Card, error) {
+	card, err := uc.repo.SetArchived(id, archivedAt, userID)
+	if err != nil {
+		return card, err
+	}
+
+	uc.publish(models.EventCardUpdated, card.ListID, card)
+	return card, nil
 }
 
 func (uc *usecase) Delete(id int) error {
-	return uc.repo.Delete(id)
+	card, err := uc.repo.Get(id)
+	if err != nil {
+		return err
+	}
+
+	err = uc.repo.Delete(id)
+	if err != nil {
+		return err
+	}
+
+	uc.publish(models.EventCardDeleted, card.ListID, map[string]int{"id": card.ID, "list_id": card.ListID})
+	return nil
+}
+
+// publish sends the event to the board the list belongs to. The change is
+// already stored at this point, so a failed lookup only drops the event.
+func (uc *usecase) publish(eventType string, listID int, data interface{}) {
+	list, err := uc.listsRepo.Get(listID)
+	if err != nil {
+		return
+	}
+	uc.bus.Publish(events.New(eventType, list.BoardID, data))
 }
@@ -1,21 +1,27 @@
 package usecase
 
 import (
+	"fmt"
 	pkgCards "github.com/SlavaShagalov/my-trello-backend/internal/cards"
 	"github.com/SlavaShagalov/my-trello-backend/internal/cards/mocks"
+	eventsMocks "github.com/SlavaShagalov/my-trello-backend/internal/events/mocks"
+	listsMocks "github.com/SlavaShagalov/my-trello-backend/internal/lists/mocks"
 	"github.com/SlavaShagalov/my-trello-backend/internal/models"
 	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestUsecase_Create(t *testing.T) {
 	type fields struct {
-		repo   *mocks.MockRepository
-		params *pkgCards.CreateParams
-		card   *models.Card
+		repo      *mocks.MockRepository
+		listsRepo *listsMocks.MockRepository
+		bus       *eventsMocks.MockBus
+		params    *pkgCards.CreateParams
+		card      *models.Card
 	}
 
 	type testCase struct {
@@ -29,6 +35,8 @@ func TestUsecase_Create(t *testing.T) {
 		"normal": {
 			prepare: func(f *fields) {
 				f.repo.EXPECT().Create(f.params).Return(*f.card, nil)
+				f.listsRepo.EXPECT().Get(27).Return(models.List{ID: 27, BoardID: 9}, nil)
+				f.bus.EXPECT().Publish(event{models.EventCardCreated, 9})
 			},
 			params: &pkgCards.CreateParams{
 				Title:   "Lab 1",
@@ -70,17 +78,18 @@ func TestUsecase_Create(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			f := fields{repo: mocks.NewMockRepository(ctrl), params: test.params, card: &test.card}
+			f := fields{repo: mocks.NewMockRepository(ctrl), listsRepo: listsMocks.NewMockRepository(ctrl),
+				bus: eventsMocks.NewMockBus(ctrl), params: test.params, card: &test.card}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := New(f.repo)
+			uc := New(f.repo, f.listsRepo, f.bus)
 			card, err := uc.Create(test.params)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if card != test.card {
+			if !reflect.DeepEqual(card, test.card) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.card, card)
 			}
 		})
@@ -104,7 +113,7 @@ func TestUsecase_List(t *testing.T) {
 	tests := map[string]testCase{
 		"normal": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().ListByList(f.listID).Return(f.cards, nil)
+				f.repo.EXPECT().ListByList(f.listID, nil).Return(f.cards, nil)
 			},
 			listID: 27,
 			cards: []models.Card{
@@ -116,7 +125,7 @@ func TestUsecase_List(t *testing.T) {
 		},
 		"empty result": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().ListByList(f.listID).Return(f.cards, nil)
+				f.repo.EXPECT().ListByList(f.listID, nil).Return(f.cards, nil)
 			},
 			listID: 27,
 			cards:  []models.Card{},
@@ -124,7 +133,7 @@ func TestUsecase_List(t *testing.T) {
 		},
 		"list not found": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().ListByList(f.listID).Return(f.cards, pkgErrors.ErrListNotFound)
+				f.repo.EXPECT().ListByList(f.listID, nil).Return(f.cards, pkgErrors.ErrListNotFound)
 			},
 			listID: 27,
 			cards:  nil,
@@ -132,7 +141,7 @@ func TestUsecase_List(t *testing.T) {
 		},
 		"storages error": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().ListByList(f.listID).Return(f.cards, pkgErrors.ErrDb)
+				f.repo.EXPECT().ListByList(f.listID, nil).Return(f.cards, pkgErrors.ErrDb)
 			},
 			listID: 27,
 			cards:  nil,
@@ -153,8 +162,8 @@ func TestUsecase_List(t *testing.T) {
 				test.prepare(&f)
 			}
 
-			serv := New(f.repo)
-			cards, err := serv.ListByList(test.listID)
+			serv := New(f.repo, nil, nil)
+			cards, err := serv.ListByList(test.listID, nil)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
@@ -219,12 +228,12 @@ func TestUsecase_Get(t *testing.T) {
 				test.prepare(&f)
 			}
 
-			uc := New(f.repo)
+			uc := New(f.repo, nil, nil)
 			card, err := uc.Get(test.id)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if card != test.card {
+			if !reflect.DeepEqual(card, test.card) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.card, card)
 			}
 		})
@@ -233,9 +242,11 @@ func TestUsecase_Get(t *testing.T) {
 
 func TestFullUpdate(t *testing.T) {
 	type fields struct {
-		repo   *mocks.MockRepository
-		params *pkgCards.FullUpdateParams
-		card   *models.Card
+		repo      *mocks.MockRepository
+		listsRepo *listsMocks.MockRepository
+		bus       *eventsMocks.MockBus
+		params    *pkgCards.FullUpdateParams
+		card      *models.Card
 	}
 
 	type testCase struct {
@@ -249,13 +260,13 @@ func TestFullUpdate(t *testing.T) {
 		"normal": {
 			prepare: func(f *fields) {
 				f.repo.EXPECT().FullUpdate(f.params).Return(*f.card, nil)
+				f.listsRepo.EXPECT().Get(27).Return(models.List{ID: 27, BoardID: 9}, nil)
+				f.bus.EXPECT().Publish(event{models.EventCardUpdated, 9})
 			},
 			params: &pkgCards.FullUpdateParams{
-				ID:       21,
-				Title:    "Lab 1",
-				Content:  "Надо сделать",
-				Position: 41,
-				ListID:   27,
+				ID:      21,
+				Title:   "Lab 1",
+				Content: "Надо сделать",
 			},
 			card: models.Card{ID: 21, ListID: 27, Title: "Lab 1", Content: "Надо сделать", Position: 41},
 			err:  nil,
@@ -270,17 +281,18 @@ func TestFullUpdate(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			f := fields{repo: mocks.NewMockRepository(ctrl), params: test.params, card: &test.card}
+			f := fields{repo: mocks.NewMockRepository(ctrl), listsRepo: listsMocks.NewMockRepository(ctrl),
+				bus: eventsMocks.NewMockBus(ctrl), params: test.params, card: &test.card}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := New(f.repo)
+			uc := New(f.repo, f.listsRepo, f.bus)
 			card, err := uc.FullUpdate(test.params)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if card != test.card {
+			if !reflect.DeepEqual(card, test.card) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.card, card)
 			}
 		})
@@ -289,9 +301,11 @@ func TestFullUpdate(t *testing.T) {
 
 func TestPartialUpdate(t *testing.T) {
 	type fields struct {
-		repo   *mocks.MockRepository
-		params *pkgCards.PartialUpdateParams
-		card   *models.Card
+		repo      *mocks.MockRepository
+		listsRepo *listsMocks.MockRepository
+		bus       *eventsMocks.MockBus
+		params    *pkgCards.PartialUpdateParams
+		card      *models.Card
 	}
 
 	type testCase struct {
@@ -304,7 +318,12 @@ func TestPartialUpdate(t *testing.T) {
 	tests := map[string]testCase{
 		"normal": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().PartialUpdate(f.params).Return(*f.card, nil)
+				f.repo.EXPECT().Get(21).Return(models.Card{ID: 21, ListID: 27, Position: 3}, nil)
+				f.repo.EXPECT().Move(&pkgCards.MoveParams{ID: 21, ListID: 27, Position: 41, Update: f.params}).
+					Return(*f.card, nil)
+				f.listsRepo.EXPECT().Get(27).Return(models.List{ID: 27, BoardID: 9}, nil).Times(2)
+				f.bus.EXPECT().Publish(event{models.EventCardMoved, 9})
+				f.bus.EXPECT().Publish(event{models.EventCardUpdated, 9})
 			},
 			params: &pkgCards.PartialUpdateParams{
 				ID:             21,
@@ -326,6 +345,56 @@ func TestPartialUpdate(t *testing.T) {
 			},
 			err: nil,
 		},
+		"neighbors only": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(21).Return(models.Card{ID: 21, ListID: 27, Position: 3}, nil)
+				f.repo.EXPECT().Move(&pkgCards.MoveParams{ID: 21, ListID: 27, Position: pkgCards.LastPosition,
+					BeforeID: 30, AfterID: 31}).Return(*f.card, nil)
+				f.listsRepo.EXPECT().Get(27).Return(models.List{ID: 27, BoardID: 9}, nil).Times(1)
+				f.bus.EXPECT().Publish(event{models.EventCardMoved, 9})
+			},
+			params: &pkgCards.PartialUpdateParams{ID: 21, BeforeID: 30, AfterID: 31},
+			card:   models.Card{ID: 21, ListID: 27, Position: 5},
+			err:    nil,
+		},
+		"move with invalid dates": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(21).Return(models.Card{ID: 21, ListID: 27, Position: 3}, nil)
+				f.repo.EXPECT().Move(&pkgCards.MoveParams{ID: 21, ListID: 28, Position: pkgCards.LastPosition,
+					Update: f.params}).Return(models.Card{}, pkgErrors.ErrInvalidCardDates)
+			},
+			params: &pkgCards.PartialUpdateParams{
+				ID:            21,
+				ListID:        28,
+				UpdateListID:  true,
+				StartAt:       &time.Time{},
+				UpdateStartAt: true,
+			},
+			card: models.Card{},
+			err:  pkgErrors.ErrInvalidCardDates,
+		},
+		"list only": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(21).Return(models.Card{ID: 21, ListID: 27, Position: 3}, nil)
+				f.repo.EXPECT().Move(&pkgCards.MoveParams{ID: 21, ListID: 28, Position: pkgCards.LastPosition}).
+					Return(*f.card, nil)
+				f.listsRepo.EXPECT().Get(28).Return(models.List{ID: 28, BoardID: 9}, nil)
+				f.listsRepo.EXPECT().Get(27).Return(models.List{ID: 27, BoardID: 9}, nil)
+				f.bus.EXPECT().Publish(event{models.EventCardMoved, 9})
+			},
+			params: &pkgCards.PartialUpdateParams{
+				ID:           21,
+				ListID:       28,
+				UpdateListID: true,
+			},
+			card: models.Card{
+				ID:       21,
+				ListID:   28,
+				Title:    "Lab 1",
+				Position: 5,
+			},
+			err: nil,
+		},
 	}
 
 	for name, test := range tests {
@@ -336,17 +405,100 @@ func TestPartialUpdate(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			f := fields{repo: mocks.NewMockRepository(ctrl), params: test.params, card: &test.card}
+			f := fields{repo: mocks.NewMockRepository(ctrl), listsRepo: listsMocks.NewMockRepository(ctrl),
+				bus: eventsMocks.NewMockBus(ctrl), params: test.params, card: &test.card}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := New(f.repo)
+			uc := New(f.repo, f.listsRepo, f.bus)
 			card, err := uc.PartialUpdate(test.params)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if card != test.card {
+			if !reflect.DeepEqual(card, test.card) {
+				t.Errorf("\nExpected: %v\nGot: %v", test.card, card)
+			}
+		})
+	}
+}
+
+func TestUsecase_Move(t *testing.T) {
+	type fields struct {
+		repo      *mocks.MockRepository
+		listsRepo *listsMocks.MockRepository
+		bus       *eventsMocks.MockBus
+		params    *pkgCards.MoveParams
+	}
+
+	type testCase struct {
+		prepare func(f *fields)
+		params  *pkgCards.MoveParams
+		card    models.Card
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"within board": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(21).Return(models.Card{ID: 21, ListID: 27}, nil)
+				f.repo.EXPECT().Move(f.params).Return(models.Card{ID: 21, ListID: 28, Position: 2}, nil)
+				f.listsRepo.EXPECT().Get(28).Return(models.List{ID: 28, BoardID: 9}, nil)
+				f.listsRepo.EXPECT().Get(27).Return(models.List{ID: 27, BoardID: 9}, nil)
+				f.bus.EXPECT().Publish(event{models.EventCardMoved, 9})
+			},
+			params: &pkgCards.MoveParams{ID: 21, ListID: 28, Position: 2},
+			card:   models.Card{ID: 21, ListID: 28, Position: 2},
+		},
+		"to other board": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(21).Return(models.Card{ID: 21, ListID: 27}, nil)
+				f.repo.EXPECT().Move(f.params).Return(models.Card{ID: 21, ListID: 35, Position: 1}, nil)
+				f.listsRepo.EXPECT().Get(35).Return(models.List{ID: 35, BoardID: 12}, nil)
+				f.listsRepo.EXPECT().Get(27).Return(models.List{ID: 27, BoardID: 9}, nil)
+				f.bus.EXPECT().Publish(event{models.EventCardMoved, 12})
+				f.bus.EXPECT().Publish(event{models.EventCardMoved, 9})
+			},
+			params: &pkgCards.MoveParams{ID: 21, ListID: 35, Position: 1},
+			card:   models.Card{ID: 21, ListID: 35, Position: 1},
+		},
+		"other workspace": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(21).Return(models.Card{ID: 21, ListID: 27}, nil)
+				f.repo.EXPECT().Move(f.params).Return(models.Card{}, pkgErrors.ErrCardMoveOtherWorkspace)
+			},
+			params: &pkgCards.MoveParams{ID: 21, ListID: 3, Position: 1},
+			err:    pkgErrors.ErrCardMoveOtherWorkspace,
+		},
+		"card not found": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(21).Return(models.Card{}, pkgErrors.ErrCardNotFound)
+			},
+			params: &pkgCards.MoveParams{ID: 21, ListID: 28, Position: 1},
+			err:    pkgErrors.ErrCardNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), listsRepo: listsMocks.NewMockRepository(ctrl),
+				bus: eventsMocks.NewMockBus(ctrl), params: test.params}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo, f.listsRepo, f.bus)
+			card, err := uc.Move(test.params)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			if !reflect.DeepEqual(card, test.card) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.card, card)
 			}
 		})
@@ -355,8 +507,10 @@ func TestPartialUpdate(t *testing.T) {
 
 func TestUsecase_Delete(t *testing.T) {
 	type fields struct {
-		repo *mocks.MockRepository
-		id   int
+		repo      *mocks.MockRepository
+		listsRepo *listsMocks.MockRepository
+		bus       *eventsMocks.MockBus
+		id        int
 	}
 
 	type testCase struct {
@@ -368,14 +522,17 @@ func TestUsecase_Delete(t *testing.T) {
 	tests := map[string]testCase{
 		"normal": {
 			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(f.id).Return(models.Card{ID: 21, ListID: 27}, nil)
 				f.repo.EXPECT().Delete(f.id).Return(nil)
+				f.listsRepo.EXPECT().Get(27).Return(models.List{ID: 27, BoardID: 9}, nil)
+				f.bus.EXPECT().Publish(event{models.EventCardDeleted, 9})
 			},
 			id:  21,
 			err: nil,
 		},
 		"card not found": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().Delete(f.id).Return(pkgErrors.ErrCardNotFound)
+				f.repo.EXPECT().Get(f.id).Return(models.Card{}, pkgErrors.ErrCardNotFound)
 			},
 			id:  21,
 			err: pkgErrors.ErrCardNotFound,
@@ -390,12 +547,13 @@ func TestUsecase_Delete(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			f := fields{repo: mocks.NewMockRepository(ctrl), id: test.id}
+			f := fields{repo: mocks.NewMockRepository(ctrl), listsRepo: listsMocks.NewMockRepository(ctrl),
+				bus: eventsMocks.NewMockBus(ctrl), id: test.id}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := New(f.repo)
+			uc := New(f.repo, f.listsRepo, f.bus)
 			err := uc.Delete(test.id)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
@@ -403,3 +561,221 @@ func TestUsecase_Delete(t *testing.T) {
 		})
 	}
 }
+
+// event matches a published event by its type and board.
+type event struct {
+	eventType string
+	boardID   int
+}
+
+func (e event) Matches(x interface{}) bool {
+	got, ok := x.(*models.Event)
+	return ok && got.Type == e.eventType && got.BoardID == e.boardID
+}
+
+func (e event) String() string {
+	return fmt.Sprintf("%s event of board %d", e.eventType, e.boardID)
+}
+
+func TestUsecase_Complete(t *testing.T) {
+	completedAt := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		repo      *mocks.MockRepository
+		listsRepo *listsMocks.MockRepository
+		bus       *eventsMocks.MockBus
+		id        int
+		card      *models.Card
+	}
+
+	type testCase struct {
+		prepare func(f *fields)
+		id      int
+		card    models.Card
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().SetCompleted(f.id, gomock.Not(gomock.Nil())).Return(*f.card, nil)
+				f.listsRepo.EXPECT().Get(27).Return(models.List{ID: 27, BoardID: 9}, nil)
+				f.bus.EXPECT().Publish(event{models.EventCardUpdated, 9})
+			},
+			id:   21,
+			card: models.Card{ID: 21, ListID: 27, Title: "Lab 1", CompletedAt: &completedAt},
+			err:  nil,
+		},
+		"card not found": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().SetCompleted(f.id, gomock.Not(gomock.Nil())).
+					Return(models.Card{}, pkgErrors.ErrCardNotFound)
+			},
+			id:   21,
+			card: models.Card{},
+			err:  pkgErrors.ErrCardNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repo:      mocks.NewMockRepository(ctrl),
+				listsRepo: listsMocks.NewMockRepository(ctrl),
+				bus:       eventsMocks.NewMockBus(ctrl),
+				id:        test.id,
+				card:      &test.card,
+			}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo, f.listsRepo, f.bus)
+			card, err := uc.Complete(test.id)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			if !reflect.DeepEqual(card, test.card) {
+				t.Errorf("\nExpected: %v\nGot: %v", test.card, card)
+			}
+		})
+	}
+}
+
+func TestUsecase_Incomplete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockRepository(ctrl)
+	listsRepo := listsMocks.NewMockRepository(ctrl)
+	bus := eventsMocks.NewMockBus(ctrl)
+
+	card := models.Card{ID: 21, ListID: 27, Title: "Lab 1"}
+	repo.EXPECT().SetCompleted(21, gomock.Nil()).Return(card, nil)
+	listsRepo.EXPECT().Get(27).Return(models.List{ID: 27, BoardID: 9}, nil)
+	bus.EXPECT().Publish(event{models.EventCardUpdated, 9})
+
+	uc := New(repo, listsRepo, bus)
+	got, err := uc.Incomplete(21)
+	if err != nil {
+		t.Errorf("\nExpected: nil\nGot: %s", err)
+	}
+	if !reflect.DeepEqual(got, card) {
+		t.Errorf("\nExpected: %v\nGot: %v", card, got)
+	}
+}
+
+func TestUsecase_ListByAssignee(t *testing.T) {
+	assigned := []models.AssignedCard{
+		{Card: models.Card{ID: 5}, ListTitle: "Todo", BoardID: 2, BoardTitle: "Release", WorkspaceID: 1},
+		{Card: models.Card{ID: 9}, ListTitle: "Backlog", BoardID: 4, BoardTitle: "Support", WorkspaceID: 2},
+		{Card: models.Card{ID: 6}, ListTitle: "Doing", BoardID: 2, BoardTitle: "Release", WorkspaceID: 1},
+	}
+
+	type testCase struct {
+		prepare func(repo *mocks.MockRepository)
+		sort    string
+		boards  []models.BoardCards
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"grouped by board": {
+			prepare: func(repo *mocks.MockRepository) {
+				repo.EXPECT().ListByAssignee(1, pkgCards.SortByDue).Return(assigned, nil)
+			},
+			sort: pkgCards.SortByDue,
+			boards: []models.BoardCards{
+				{BoardID: 2, BoardTitle: "Release", WorkspaceID: 1,
+					Cards: []models.AssignedCard{assigned[0], assigned[2]}},
+				{BoardID: 4, BoardTitle: "Support", WorkspaceID: 2,
+					Cards: []models.AssignedCard{assigned[1]}},
+			},
+			err: nil,
+		},
+		"default sort": {
+			prepare: func(repo *mocks.MockRepository) {
+				repo.EXPECT().ListByAssignee(1, pkgCards.SortByPosition).Return([]models.AssignedCard{}, nil)
+			},
+			sort:   "",
+			boards: []models.BoardCards{},
+			err:    nil,
+		},
+		"bad sort": {
+			sort:   "title",
+			boards: nil,
+			err:    pkgErrors.ErrBadCardSort,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockRepository(ctrl)
+			if test.prepare != nil {
+				test.prepare(repo)
+			}
+
+			uc := New(repo, listsMocks.NewMockRepository(ctrl), eventsMocks.NewMockBus(ctrl))
+			boards, err := uc.ListByAssignee(1, test.sort)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			if !reflect.DeepEqual(boards, test.boards) {
+				t.Errorf("\nExpected: %v\nGot: %v", test.boards, boards)
+			}
+		})
+	}
+}
+
+func TestUsecase_Archive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockRepository(ctrl)
+	listsRepo := listsMocks.NewMockRepository(ctrl)
+	bus := eventsMocks.NewMockBus(ctrl)
+
+	archivedAt := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	card := models.Card{ID: 21, ListID: 27, Title: "Lab 1", ArchivedAt: &archivedAt}
+	repo.EXPECT().SetArchived(21, gomock.Not(gomock.Nil()), 3).Return(card, nil)
+	listsRepo.EXPECT().Get(27).Return(models.List{ID: 27, BoardID: 9}, nil)
+	bus.EXPECT().Publish(event{models.EventCardUpdated, 9})
+
+	uc := New(repo, listsRepo, bus)
+	got, err := uc.Archive(21, 3)
+	if err != nil {
+		t.Errorf("\nExpected: nil\nGot: %s", err)
+	}
+	if !reflect.DeepEqual(got, card) {
+		t.Errorf("\nExpected: %v\nGot: %v", card, got)
+	}
+}
+
+func TestUsecase_Unarchive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockRepository(ctrl)
+	listsRepo := listsMocks.NewMockRepository(ctrl)
+	bus := eventsMocks.NewMockBus(ctrl)
+
+	repo.EXPECT().SetArchived(21, gomock.Nil(), 0).Return(models.Card{}, pkgErrors.ErrCardNotFound)
+
+	uc := New(repo, listsRepo, bus)
+	_, err := uc.Unarchive(21)
+	if !errors.Is(err, pkgErrors.ErrCardNotFound) {
+		t.Errorf("\nExpected: %s\nGot: %s", pkgErrors.ErrCardNotFound, err)
+	}
+}
@@ -0,0 +1,562 @@
+package http
+
+import (
+	pAccess "github.com/SlavaShagalov/my-trello-backend/internal/access"
+	pChecklists "github.com/SlavaShagalov/my-trello-backend/internal/checklists"
+	mw "github.com/SlavaShagalov/my-trello-backend/internal/middleware"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pHTTP "github.com/SlavaShagalov/my-trello-backend/internal/pkg/http"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+type delivery struct {
+	uc       pChecklists.Usecase
+	accessUC pAccess.Usecase
+	log      *zap.Logger
+}
+
+func RegisterHandlers(mux *mux.Router, uc pChecklists.Usecase, accessUC pAccess.Usecase, log *zap.Logger,
+	checkAuth mw.Middleware, metrics mw.Middleware) {
+	del := delivery{
+		uc:       uc,
+		accessUC: accessUC,
+		log:      log,
+	}
+
+	const (
+		cardChecklistsPrefix = "/cards/{id}/checklists"
+		cardChecklistsPath   = constants.ApiPrefix + cardChecklistsPrefix
+		checklistPath        = cardChecklistsPath + "/{checklist_id}"
+
+		itemsPath       = checklistPath + "/items"
+		itemPath        = itemsPath + "/{item_id}"
+		itemConvertPath = itemPath + "/convert"
+	)
+
+	mux.HandleFunc(cardChecklistsPath, metrics(checkAuth(del.create))).Methods(http.MethodPost)
+	mux.HandleFunc(cardChecklistsPath, metrics(checkAuth(del.listByCard))).Methods(http.MethodGet)
+	mux.HandleFunc(checklistPath, metrics(checkAuth(del.partialUpdate))).Methods(http.MethodPatch)
+	mux.HandleFunc(checklistPath, metrics(checkAuth(del.delete))).Methods(http.MethodDelete)
+
+	mux.HandleFunc(itemsPath, metrics(checkAuth(del.createItem))).Methods(http.MethodPost)
+	mux.HandleFunc(itemPath, metrics(checkAuth(del.partialUpdateItem))).Methods(http.MethodPatch)
+	mux.HandleFunc(itemPath, metrics(checkAuth(del.deleteItem))).Methods(http.MethodDelete)
+	mux.HandleFunc(itemConvertPath, metrics(checkAuth(del.convertItem))).Methods(http.MethodPost)
+}
+
+// create godoc
+//
+//	@Summary		Create a new checklist
+//	@Description	Create a new checklist at the end of the card checklists
+//	@Tags			cards
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		int					true	"Card ID"
+//	@Param			ChecklistData	body		createRequest		true	"Checklist data"
+//	@Success		200				{object}	models.Checklist	"Created checklist data."
+//	@Failure		400				{object}	http.JSONError
+//	@Failure		401				{object}	http.JSONError
+//	@Failure		403				{object}	http.JSONError
+//	@Failure		404				{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/checklists [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) create(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	body, err := pHTTP.ReadBody(r, del.log)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	var request createRequest
+	err = request.UnmarshalJSON(body)
+	if err != nil {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	params := pChecklists.CreateParams{
+		CardID: cardID,
+		Title:  request.Title,
+	}
+
+	checklist, err := del.uc.Create(&params)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	pHTTP.SendJSON(w, r, http.StatusOK, &checklist)
+}
+
+// listByCard godoc
+//
+//	@Summary		Returns checklists of card
+//	@Description	Returns checklists of the card with their items
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id	path		int				true	"Card ID"
+//	@Success		200	{object}	listResponse	"Checklists data"
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/checklists [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) listByCard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	checklists, err := del.uc.ListByCard(cardID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newListResponse(checklists)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// partialUpdate godoc
+//
+//	@Summary		Rename checklist
+//	@Description	Rename checklist
+//	@Tags			cards
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		int						true	"Card ID"
+//	@Param			checklist_id	path		int						true	"Checklist ID"
+//	@Param			ChecklistData	body		partialUpdateRequest	true	"Checklist data to update"
+//	@Success		200				{object}	models.Checklist		"Updated checklist data."
+//	@Failure		400				{object}	http.JSONError
+//	@Failure		401				{object}	http.JSONError
+//	@Failure		403				{object}	http.JSONError
+//	@Failure		404				{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/checklists/{checklist_id} [patch]
+//
+//	@Security		cookieAuth
+func (del *delivery) partialUpdate(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	checklistID, err := strconv.Atoi(vars["checklist_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	body, err := pHTTP.ReadBody(r, del.log)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	var request partialUpdateRequest
+	err = request.UnmarshalJSON(body)
+	if err != nil {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	params := pChecklists.PartialUpdateParams{ID: checklistID}
+	params.UpdateTitle = request.Title != nil
+	if params.UpdateTitle {
+		params.Title = *request.Title
+	}
+
+	checklist, err := del.uc.PartialUpdate(cardID, &params)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	pHTTP.SendJSON(w, r, http.StatusOK, &checklist)
+}
+
+// delete godoc
+//
+//	@Summary		Delete checklist
+//	@Description	Delete checklist with its items
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id				path	int	true	"Card ID"
+//	@Param			checklist_id	path	int	true	"Checklist ID"
+//	@Success		204				"Checklist deleted successfully"
+//	@Failure		400				{object}	http.JSONError
+//	@Failure		401				{object}	http.JSONError
+//	@Failure		403				{object}	http.JSONError
+//	@Failure		404				{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/checklists/{checklist_id} [delete]
+//
+//	@Security		cookieAuth
+func (del *delivery) delete(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	checklistID, err := strconv.Atoi(vars["checklist_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	err = del.uc.Delete(cardID, checklistID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// createItem godoc
+//
+//	@Summary		Add checklist item
+//	@Description	Add an item at the end of the checklist
+//	@Tags			cards
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		int						true	"Card ID"
+//	@Param			checklist_id	path		int						true	"Checklist ID"
+//	@Param			ItemData		body		createItemRequest		true	"Item data"
+//	@Success		200				{object}	models.ChecklistItem	"Created item data."
+//	@Failure		400				{object}	http.JSONError
+//	@Failure		401				{object}	http.JSONError
+//	@Failure		403				{object}	http.JSONError
+//	@Failure		404				{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/checklists/{checklist_id}/items [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) createItem(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	checklistID, err := strconv.Atoi(vars["checklist_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	body, err := pHTTP.ReadBody(r, del.log)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	var request createItemRequest
+	err = request.UnmarshalJSON(body)
+	if err != nil {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	params := pChecklists.CreateItemParams{
+		ChecklistID: checklistID,
+		Title:       request.Title,
+	}
+
+	item, err := del.uc.CreateItem(cardID, &params)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	pHTTP.SendJSON(w, r, http.StatusOK, &item)
+}
+
+// partialUpdateItem godoc
+//
+//	@Summary		Update checklist item
+//	@Description	Check, rename or move the item within its checklist
+//	@Tags			cards
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		int							true	"Card ID"
+//	@Param			checklist_id	path		int							true	"Checklist ID"
+//	@Param			item_id			path		int							true	"Item ID"
+//	@Param			ItemData		body		partialUpdateItemRequest	true	"Item data to update"
+//	@Success		200				{object}	models.ChecklistItem		"Updated item data."
+//	@Failure		400				{object}	http.JSONError
+//	@Failure		401				{object}	http.JSONError
+//	@Failure		403				{object}	http.JSONError
+//	@Failure		404				{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/checklists/{checklist_id}/items/{item_id} [patch]
+//
+//	@Security		cookieAuth
+func (del *delivery) partialUpdateItem(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	checklistID, err := strconv.Atoi(vars["checklist_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	itemID, err := strconv.Atoi(vars["item_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	body, err := pHTTP.ReadBody(r, del.log)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	var request partialUpdateItemRequest
+	err = request.UnmarshalJSON(body)
+	if err != nil {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	params := pChecklists.PartialUpdateItemParams{ID: itemID}
+	params.UpdateTitle = request.Title != nil
+	if params.UpdateTitle {
+		params.Title = *request.Title
+	}
+	params.UpdateDone = request.Done != nil
+	if params.UpdateDone {
+		params.Done = *request.Done
+	}
+	params.UpdatePosition = request.Position != nil
+	if params.UpdatePosition {
+		params.Position = *request.Position
+	}
+
+	item, err := del.uc.PartialUpdateItem(cardID, checklistID, &params)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	pHTTP.SendJSON(w, r, http.StatusOK, &item)
+}
+
+// deleteItem godoc
+//
+//	@Summary		Delete checklist item
+//	@Description	Delete checklist item
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id				path	int	true	"Card ID"
+//	@Param			checklist_id	path	int	true	"Checklist ID"
+//	@Param			item_id			path	int	true	"Item ID"
+//	@Success		204				"Item deleted successfully"
+//	@Failure		400				{object}	http.JSONError
+//	@Failure		401				{object}	http.JSONError
+//	@Failure		403				{object}	http.JSONError
+//	@Failure		404				{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/checklists/{checklist_id}/items/{item_id} [delete]
+//
+//	@Security		cookieAuth
+func (del *delivery) deleteItem(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	checklistID, err := strconv.Atoi(vars["checklist_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	itemID, err := strconv.Atoi(vars["item_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	err = del.uc.DeleteItem(cardID, checklistID, itemID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// convertItem godoc
+//
+//	@Summary		Convert checklist item to card
+//	@Description	Replace the item with a card of the same title at the end of the card's list
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id				path		int			true	"Card ID"
+//	@Param			checklist_id	path		int			true	"Checklist ID"
+//	@Param			item_id			path		int			true	"Item ID"
+//	@Success		200				{object}	models.Card	"Created card data."
+//	@Failure		400				{object}	http.JSONError
+//	@Failure		401				{object}	http.JSONError
+//	@Failure		403				{object}	http.JSONError
+//	@Failure		404				{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/checklists/{checklist_id}/items/{item_id}/convert [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) convertItem(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	checklistID, err := strconv.Atoi(vars["checklist_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	itemID, err := strconv.Atoi(vars["item_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	card, err := del.uc.ConvertItem(cardID, checklistID, itemID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	pHTTP.SendJSON(w, r, http.StatusOK, &card)
+}
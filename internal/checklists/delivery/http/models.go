@@ -0,0 +1,37 @@
+package http
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+)
+
+//go:generate easyjson -all -snake_case models.go
+
+// API requests
+type createRequest struct {
+	Title string `json:"title"`
+}
+
+type partialUpdateRequest struct {
+	Title *string `json:"title"`
+}
+
+type createItemRequest struct {
+	Title string `json:"title"`
+}
+
+type partialUpdateItemRequest struct {
+	Title    *string `json:"title"`
+	Done     *bool   `json:"done"`
+	Position *int    `json:"position"`
+}
+
+// API responses
+type listResponse struct {
+	Checklists []models.Checklist `json:"checklists"`
+}
+
+func newListResponse(checklists []models.Checklist) *listResponse {
+	return &listResponse{
+		Checklists: checklists,
+	}
+}
@@ -0,0 +1,184 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/checklists/repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	checklists "github.com/SlavaShagalov/my-trello-backend/internal/checklists"
+	models "github.com/SlavaShagalov/my-trello-backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ConvertItem mocks base method.
+func (m *MockRepository) ConvertItem(id int) (models.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConvertItem", id)
+	ret0, _ := ret[0].(models.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConvertItem indicates an expected call of ConvertItem.
+func (mr *MockRepositoryMockRecorder) ConvertItem(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertItem", reflect.TypeOf((*MockRepository)(nil).ConvertItem), id)
+}
+
+// Create mocks base method.
+func (m *MockRepository) Create(params *checklists.CreateParams) (models.Checklist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", params)
+	ret0, _ := ret[0].(models.Checklist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder) Create(params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), params)
+}
+
+// CreateItem mocks base method.
+func (m *MockRepository) CreateItem(params *checklists.CreateItemParams) (models.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateItem", params)
+	ret0, _ := ret[0].(models.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateItem indicates an expected call of CreateItem.
+func (mr *MockRepositoryMockRecorder) CreateItem(params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItem", reflect.TypeOf((*MockRepository)(nil).CreateItem), params)
+}
+
+// Delete mocks base method.
+func (m *MockRepository) Delete(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRepositoryMockRecorder) Delete(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), id)
+}
+
+// DeleteItem mocks base method.
+func (m *MockRepository) DeleteItem(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteItem", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteItem indicates an expected call of DeleteItem.
+func (mr *MockRepositoryMockRecorder) DeleteItem(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockRepository)(nil).DeleteItem), id)
+}
+
+// Get mocks base method.
+func (m *MockRepository) Get(id int) (models.Checklist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", id)
+	ret0, _ := ret[0].(models.Checklist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRepositoryMockRecorder) Get(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRepository)(nil).Get), id)
+}
+
+// GetItem mocks base method.
+func (m *MockRepository) GetItem(id int) (models.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItem", id)
+	ret0, _ := ret[0].(models.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItem indicates an expected call of GetItem.
+func (mr *MockRepositoryMockRecorder) GetItem(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockRepository)(nil).GetItem), id)
+}
+
+// ListByCard mocks base method.
+func (m *MockRepository) ListByCard(cardID int) ([]models.Checklist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCard", cardID)
+	ret0, _ := ret[0].([]models.Checklist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCard indicates an expected call of ListByCard.
+func (mr *MockRepositoryMockRecorder) ListByCard(cardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCard", reflect.TypeOf((*MockRepository)(nil).ListByCard), cardID)
+}
+
+// PartialUpdate mocks base method.
+func (m *MockRepository) PartialUpdate(params *checklists.PartialUpdateParams) (models.Checklist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PartialUpdate", params)
+	ret0, _ := ret[0].(models.Checklist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PartialUpdate indicates an expected call of PartialUpdate.
+func (mr *MockRepositoryMockRecorder) PartialUpdate(params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PartialUpdate", reflect.TypeOf((*MockRepository)(nil).PartialUpdate), params)
+}
+
+// PartialUpdateItem mocks base method.
+func (m *MockRepository) PartialUpdateItem(params *checklists.PartialUpdateItemParams) (models.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PartialUpdateItem", params)
+	ret0, _ := ret[0].(models.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PartialUpdateItem indicates an expected call of PartialUpdateItem.
+func (mr *MockRepositoryMockRecorder) PartialUpdateItem(params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PartialUpdateItem", reflect.TypeOf((*MockRepository)(nil).PartialUpdateItem), params)
+}
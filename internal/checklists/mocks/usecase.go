@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/checklists/usecase.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	checklists "github.com/SlavaShagalov/my-trello-backend/internal/checklists"
+	models "github.com/SlavaShagalov/my-trello-backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ConvertItem mocks base method.
+func (m *MockUsecase) ConvertItem(cardID, checklistID, id int) (models.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConvertItem", cardID, checklistID, id)
+	ret0, _ := ret[0].(models.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConvertItem indicates an expected call of ConvertItem.
+func (mr *MockUsecaseMockRecorder) ConvertItem(cardID, checklistID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertItem", reflect.TypeOf((*MockUsecase)(nil).ConvertItem), cardID, checklistID, id)
+}
+
+// Create mocks base method.
+func (m *MockUsecase) Create(params *checklists.CreateParams) (models.Checklist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", params)
+	ret0, _ := ret[0].(models.Checklist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUsecaseMockRecorder) Create(params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUsecase)(nil).Create), params)
+}
+
+// CreateItem mocks base method.
+func (m *MockUsecase) CreateItem(cardID int, params *checklists.CreateItemParams) (models.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateItem", cardID, params)
+	ret0, _ := ret[0].(models.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateItem indicates an expected call of CreateItem.
+func (mr *MockUsecaseMockRecorder) CreateItem(cardID, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItem", reflect.TypeOf((*MockUsecase)(nil).CreateItem), cardID, params)
+}
+
+// Delete mocks base method.
+func (m *MockUsecase) Delete(cardID, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", cardID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUsecaseMockRecorder) Delete(cardID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUsecase)(nil).Delete), cardID, id)
+}
+
+// DeleteItem mocks base method.
+func (m *MockUsecase) DeleteItem(cardID, checklistID, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteItem", cardID, checklistID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteItem indicates an expected call of DeleteItem.
+func (mr *MockUsecaseMockRecorder) DeleteItem(cardID, checklistID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockUsecase)(nil).DeleteItem), cardID, checklistID, id)
+}
+
+// ListByCard mocks base method.
+func (m *MockUsecase) ListByCard(cardID int) ([]models.Checklist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCard", cardID)
+	ret0, _ := ret[0].([]models.Checklist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCard indicates an expected call of ListByCard.
+func (mr *MockUsecaseMockRecorder) ListByCard(cardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCard", reflect.TypeOf((*MockUsecase)(nil).ListByCard), cardID)
+}
+
+// PartialUpdate mocks base method.
+func (m *MockUsecase) PartialUpdate(cardID int, params *checklists.PartialUpdateParams) (models.Checklist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PartialUpdate", cardID, params)
+	ret0, _ := ret[0].(models.Checklist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PartialUpdate indicates an expected call of PartialUpdate.
+func (mr *MockUsecaseMockRecorder) PartialUpdate(cardID, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PartialUpdate", reflect.TypeOf((*MockUsecase)(nil).PartialUpdate), cardID, params)
+}
+
+// PartialUpdateItem mocks base method.
+func (m *MockUsecase) PartialUpdateItem(cardID, checklistID int, params *checklists.PartialUpdateItemParams) (models.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PartialUpdateItem", cardID, checklistID, params)
+	ret0, _ := ret[0].(models.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PartialUpdateItem indicates an expected call of PartialUpdateItem.
+func (mr *MockUsecaseMockRecorder) PartialUpdateItem(cardID, checklistID, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PartialUpdateItem", reflect.TypeOf((*MockUsecase)(nil).PartialUpdateItem), cardID, checklistID, params)
+}
@@ -0,0 +1,48 @@
+package checklists
+
+import "github.com/SlavaShagalov/my-trello-backend/internal/models"
+
+type CreateParams struct {
+	CardID int
+	Title  string
+}
+
+type PartialUpdateParams struct {
+	ID          int
+	Title       string
+	UpdateTitle bool
+}
+
+type CreateItemParams struct {
+	ChecklistID int
+	Title       string
+}
+
+type PartialUpdateItemParams struct {
+	ID             int
+	Title          string
+	UpdateTitle    bool
+	Done           bool
+	UpdateDone     bool
+	Position       int
+	UpdatePosition bool
+}
+
+type Repository interface {
+	Create(params *CreateParams) (models.Checklist, error)
+	// ListByCard returns checklists of the card with their items.
+	ListByCard(cardID int) ([]models.Checklist, error)
+	Get(id int) (models.Checklist, error)
+	PartialUpdate(params *PartialUpdateParams) (models.Checklist, error)
+	Delete(id int) error
+
+	CreateItem(params *CreateItemParams) (models.ChecklistItem, error)
+	GetItem(id int) (models.ChecklistItem, error)
+	// PartialUpdateItem moves the item to the given position within its
+	// checklist, clamped to the number of items, shifting the others.
+	PartialUpdateItem(params *PartialUpdateItemParams) (models.ChecklistItem, error)
+	DeleteItem(id int) error
+	// ConvertItem deletes the item and creates a card with its title at the
+	// end of the list the checklist card is in, in one transaction.
+	ConvertItem(id int) (models.Card, error)
+}
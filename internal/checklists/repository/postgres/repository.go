@@ -0,0 +1,611 @@
+package postgres
+
+import (
+	"database/sql"
+	pkgChecklists "github.com/SlavaShagalov/my-trello-backend/internal/checklists"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/rank"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+type repository struct {
+	db  *sql.DB
+	log *zap.Logger
+}
+
+func New(db *sql.DB, log *zap.Logger) pkgChecklists.Repository {
+	return &repository{db: db, log: log}
+}
+
+const createCmd = `
+	INSERT INTO checklists (card_id, title, position)
+	VALUES ($1, $2, (SELECT COALESCE(MAX(position), 0) + 1
+						FROM checklists
+						WHERE card_id = $1))
+	RETURNING id, card_id, title, position, created_at, updated_at;`
+
+func (repo *repository) Create(params *pkgChecklists.CreateParams) (models.Checklist, error) {
+	row := repo.db.QueryRow(createCmd, params.CardID, params.Title)
+
+	var checklist models.Checklist
+	err := scanChecklist(row, &checklist)
+	if err != nil {
+		pgErr, ok := err.(*pq.Error)
+		if !ok {
+			repo.log.Error("Cannot convert err to pq.Error", zap.Error(err))
+			return models.Checklist{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+		if pgErr.Constraint == "checklists_card_id_fkey" {
+			return models.Checklist{}, errors.Wrap(pkgErrors.ErrCardNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", createCmd),
+			zap.Any("create_params", params))
+		return models.Checklist{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	checklist.Items = []models.ChecklistItem{}
+
+	repo.log.Debug("New checklist created", zap.Any("checklist", checklist))
+	return checklist, nil
+}
+
+const (
+	listByCardCmd = `
+	SELECT id, card_id, title, position, created_at, updated_at
+	FROM checklists
+	WHERE card_id = $1
+	ORDER BY position;`
+
+	listItemsByCardCmd = `
+	SELECT i.id, i.checklist_id, i.title, i.done, i.position, i.created_at, i.updated_at
+	FROM checklist_items i
+	JOIN checklists cl on cl.id = i.checklist_id
+	WHERE cl.card_id = $1
+	ORDER BY i.position;`
+)
+
+func (repo *repository) ListByCard(cardID int) ([]models.Checklist, error) {
+	rows, err := repo.db.Query(listByCardCmd, cardID)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listByCardCmd),
+			zap.Int("card_id", cardID))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	checklists := []models.Checklist{}
+	byID := make(map[int]int)
+	for rows.Next() {
+		var checklist models.Checklist
+		err = scanChecklist(rows, &checklist)
+		if err != nil {
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", listByCardCmd),
+				zap.Int("card_id", cardID))
+			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+
+		checklist.Items = []models.ChecklistItem{}
+		byID[checklist.ID] = len(checklists)
+		checklists = append(checklists, checklist)
+	}
+
+	if len(checklists) == 0 {
+		return checklists, nil
+	}
+
+	itemRows, err := repo.db.Query(listItemsByCardCmd, cardID)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listItemsByCardCmd),
+			zap.Int("card_id", cardID))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = itemRows.Close()
+	}()
+
+	for itemRows.Next() {
+		var item models.ChecklistItem
+		err = scanItem(itemRows, &item)
+		if err != nil {
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", listItemsByCardCmd),
+				zap.Int("card_id", cardID))
+			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+
+		if i, ok := byID[item.ChecklistID]; ok {
+			checklists[i].Items = append(checklists[i].Items, item)
+		}
+	}
+
+	return checklists, nil
+}
+
+const (
+	getCmd = `
+	SELECT id, card_id, title, position, created_at, updated_at
+	FROM checklists
+	WHERE id = $1;`
+
+	listItemsCmd = `
+	SELECT id, checklist_id, title, done, position, created_at, updated_at
+	FROM checklist_items
+	WHERE checklist_id = $1
+	ORDER BY position;`
+)
+
+func (repo *repository) Get(id int) (models.Checklist, error) {
+	row := repo.db.QueryRow(getCmd, id)
+
+	var checklist models.Checklist
+	err := scanChecklist(row, &checklist)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Checklist{}, errors.Wrap(pkgErrors.ErrChecklistNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", getCmd), zap.Int("id", id))
+		return models.Checklist{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	checklist.Items, err = repo.listItems(id)
+	if err != nil {
+		return models.Checklist{}, err
+	}
+
+	return checklist, nil
+}
+
+func (repo *repository) listItems(checklistID int) ([]models.ChecklistItem, error) {
+	rows, err := repo.db.Query(listItemsCmd, checklistID)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listItemsCmd),
+			zap.Int("checklist_id", checklistID))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	items := []models.ChecklistItem{}
+	for rows.Next() {
+		var item models.ChecklistItem
+		err = scanItem(rows, &item)
+		if err != nil {
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", listItemsCmd),
+				zap.Int("checklist_id", checklistID))
+			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+const partialUpdateCmd = `
+	UPDATE checklists
+	SET title      = CASE WHEN $1::boolean THEN $2 ELSE title END,
+		updated_at = now()
+	WHERE id = $3
+	RETURNING id, card_id, title, position, created_at, updated_at;`
+
+func (repo *repository) PartialUpdate(params *pkgChecklists.PartialUpdateParams) (models.Checklist, error) {
+	row := repo.db.QueryRow(partialUpdateCmd, params.UpdateTitle, params.Title, params.ID)
+
+	var checklist models.Checklist
+	err := scanChecklist(row, &checklist)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Checklist{}, errors.Wrap(pkgErrors.ErrChecklistNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", partialUpdateCmd),
+			zap.Any("params", params))
+		return models.Checklist{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	checklist.Items, err = repo.listItems(checklist.ID)
+	if err != nil {
+		return models.Checklist{}, err
+	}
+
+	repo.log.Debug("Checklist partial updated", zap.Any("checklist", checklist))
+	return checklist, nil
+}
+
+const deleteCmd = `
+	DELETE FROM checklists
+	WHERE id = $1;`
+
+func (repo *repository) Delete(id int) error {
+	result, err := repo.db.Exec(deleteCmd, id)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", deleteCmd), zap.Int("id", id))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", deleteCmd), zap.Int("id", id))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	if rowsAffected == 0 {
+		return pkgErrors.ErrChecklistNotFound
+	}
+
+	repo.log.Debug("Checklist deleted", zap.Int("id", id))
+	return nil
+}
+
+const createItemCmd = `
+	INSERT INTO checklist_items (checklist_id, title, position)
+	VALUES ($1, $2, (SELECT COALESCE(MAX(position), 0) + 1
+						FROM checklist_items
+						WHERE checklist_id = $1))
+	RETURNING id, checklist_id, title, done, position, created_at, updated_at;`
+
+func (repo *repository) CreateItem(params *pkgChecklists.CreateItemParams) (models.ChecklistItem, error) {
+	row := repo.db.QueryRow(createItemCmd, params.ChecklistID, params.Title)
+
+	var item models.ChecklistItem
+	err := scanItem(row, &item)
+	if err != nil {
+		pgErr, ok := err.(*pq.Error)
+		if !ok {
+			repo.log.Error("Cannot convert err to pq.Error", zap.Error(err))
+			return models.ChecklistItem{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+		if pgErr.Constraint == "checklist_items_checklist_id_fkey" {
+			return models.ChecklistItem{}, errors.Wrap(pkgErrors.ErrChecklistNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", createItemCmd),
+			zap.Any("create_params", params))
+		return models.ChecklistItem{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("New checklist item created", zap.Any("item", item))
+	return item, nil
+}
+
+const getItemCmd = `
+	SELECT id, checklist_id, title, done, position, created_at, updated_at
+	FROM checklist_items
+	WHERE id = $1;`
+
+func (repo *repository) GetItem(id int) (models.ChecklistItem, error) {
+	row := repo.db.QueryRow(getItemCmd, id)
+
+	var item models.ChecklistItem
+	err := scanItem(row, &item)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ChecklistItem{}, errors.Wrap(pkgErrors.ErrChecklistItemNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", getItemCmd), zap.Int("id", id))
+		return models.ChecklistItem{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	return item, nil
+}
+
+const (
+	// lockChecklistCmd serializes moves of items within the checklist.
+	lockChecklistCmd = `
+	SELECT cl.id
+	FROM checklists cl
+	JOIN checklist_items i on i.checklist_id = cl.id
+	WHERE i.id = $1
+	FOR UPDATE OF cl;`
+
+	itemPositionCmd = `
+	SELECT i.position, (SELECT count(*) FROM checklist_items WHERE checklist_id = i.checklist_id)
+	FROM checklist_items i
+	WHERE i.id = $1;`
+
+	// moveItemCmd puts the item at $3 and shifts the items between its old
+	// position $2 and the new one by one towards the gap.
+	moveItemCmd = `
+	UPDATE checklist_items
+	SET position = CASE
+					   WHEN id = $1 THEN $3
+					   WHEN $3 < $2 THEN position + 1
+					   ELSE position - 1
+		END
+	WHERE checklist_id = $4
+	  AND position BETWEEN LEAST($2::int, $3::int) AND GREATEST($2::int, $3::int);`
+
+	partialUpdateItemCmd = `
+	UPDATE checklist_items
+	SET title      = CASE WHEN $1::boolean THEN $2 ELSE title END,
+		done       = CASE WHEN $3::boolean THEN $4 ELSE done END,
+		updated_at = now()
+	WHERE id = $5
+	RETURNING id, checklist_id, title, done, position, created_at, updated_at;`
+)
+
+func (repo *repository) PartialUpdateItem(params *pkgChecklists.PartialUpdateItemParams) (models.ChecklistItem, error) {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Any("params", params))
+		return models.ChecklistItem{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if params.UpdatePosition {
+		var checklistID int
+		err = tx.QueryRow(lockChecklistCmd, params.ID).Scan(&checklistID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return models.ChecklistItem{}, errors.Wrap(pkgErrors.ErrChecklistItemNotFound, err.Error())
+			}
+
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", lockChecklistCmd),
+				zap.Any("params", params))
+			return models.ChecklistItem{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+
+		var position, count int
+		err = tx.QueryRow(itemPositionCmd, params.ID).Scan(&position, &count)
+		if err != nil {
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", itemPositionCmd),
+				zap.Any("params", params))
+			return models.ChecklistItem{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+
+		newPosition := params.Position
+		if newPosition < 1 {
+			newPosition = 1
+		}
+		if newPosition > count {
+			newPosition = count
+		}
+
+		_, err = tx.Exec(moveItemCmd, params.ID, position, newPosition, checklistID)
+		if err != nil {
+			repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", moveItemCmd),
+				zap.Any("params", params))
+			return models.ChecklistItem{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+	}
+
+	row := tx.QueryRow(partialUpdateItemCmd,
+		params.UpdateTitle,
+		params.Title,
+		params.UpdateDone,
+		params.Done,
+		params.ID,
+	)
+
+	var item models.ChecklistItem
+	err = scanItem(row, &item)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ChecklistItem{}, errors.Wrap(pkgErrors.ErrChecklistItemNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", partialUpdateItemCmd),
+			zap.Any("params", params))
+		return models.ChecklistItem{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Any("params", params))
+		return models.ChecklistItem{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("Checklist item partial updated", zap.Any("item", item))
+	return item, nil
+}
+
+const (
+	deleteItemCmd = `
+	DELETE FROM checklist_items
+	WHERE id = $1
+	RETURNING checklist_id, position;`
+
+	compactItemsCmd = `
+	UPDATE checklist_items
+	SET position = position - 1
+	WHERE checklist_id = $1
+	  AND position > $2;`
+)
+
+func (repo *repository) DeleteItem(id int) error {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Int("id", id))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	err = repo.deleteItem(tx, id)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Int("id", id))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("Checklist item deleted", zap.Int("id", id))
+	return nil
+}
+
+const (
+	itemCardCmd = `
+	SELECT i.title, c.list_id
+	FROM checklist_items i
+	JOIN checklists cl on cl.id = i.checklist_id
+	JOIN cards c on c.id = cl.card_id
+	WHERE i.id = $1;`
+
+	lastCardRankCmd = `
+	SELECT max(rank)
+	FROM cards
+	WHERE list_id = $1;`
+
+	createCardCmd = `
+	INSERT INTO cards AS c (list_id, title, content, rank)
+	VALUES ($1, $2, '', $3)
+	RETURNING id, list_id, title, content,
+			  (SELECT count(*) + 1
+			   FROM cards p
+			   WHERE p.list_id = c.list_id AND p.archived_at IS NULL AND p.rank < c.rank),
+			  created_at, updated_at;`
+)
+
+// maxRankAttempts bounds retries of conversions whose card rank was taken by
+// another card meanwhile.
+const maxRankAttempts = 10
+
+var errRankTaken = errors.New("card rank taken")
+
+func (repo *repository) ConvertItem(id int) (models.Card, error) {
+	for attempt := 1; ; attempt++ {
+		card, err := repo.convertItem(id)
+		if errors.Is(err, errRankTaken) && attempt < maxRankAttempts {
+			continue
+		}
+		if errors.Is(err, errRankTaken) {
+			repo.log.Error(constants.DBError, zap.Error(err), zap.Int("id", id))
+			return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+		return card, err
+	}
+}
+
+// convertItem puts the card after all cards of the list, the archived ones too.
+func (repo *repository) convertItem(id int) (models.Card, error) {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Int("id", id))
+		return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var title string
+	var listID int
+	err = tx.QueryRow(itemCardCmd, id).Scan(&title, &listID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Card{}, errors.Wrap(pkgErrors.ErrChecklistItemNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", itemCardCmd),
+			zap.Int("id", id))
+		return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	err = repo.deleteItem(tx, id)
+	if err != nil {
+		return models.Card{}, err
+	}
+
+	var last sql.NullString
+	err = tx.QueryRow(lastCardRankCmd, listID).Scan(&last)
+	if err != nil {
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", lastCardRankCmd),
+			zap.Int("id", id))
+		return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	card := models.Card{Labels: []models.Label{}}
+	var content sql.NullString
+	err = tx.QueryRow(createCardCmd, listID, title, rank.After(last.String)).Scan(
+		&card.ID,
+		&card.ListID,
+		&card.Title,
+		&content,
+		&card.Position,
+		&card.CreatedAt,
+		&card.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pq.Error
+		if errors.As(err, &pgErr) && pgErr.Constraint == "cards_rank_key" {
+			return models.Card{}, errRankTaken
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", createCardCmd),
+			zap.Int("id", id))
+		return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	card.Content = content.String
+
+	err = tx.Commit()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Int("id", id))
+		return models.Card{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("Checklist item converted", zap.Int("id", id), zap.Any("card", card))
+	return card, nil
+}
+
+// deleteItem deletes the item and closes the gap it leaves in its checklist.
+func (repo *repository) deleteItem(tx *sql.Tx, id int) error {
+	var checklistID, position int
+	err := tx.QueryRow(deleteItemCmd, id).Scan(&checklistID, &position)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.Wrap(pkgErrors.ErrChecklistItemNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", deleteItemCmd),
+			zap.Int("id", id))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	_, err = tx.Exec(compactItemsCmd, checklistID, position)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", compactItemsCmd),
+			zap.Int("id", id))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanChecklist(row scanner, checklist *models.Checklist) error {
+	return row.Scan(
+		&checklist.ID,
+		&checklist.CardID,
+		&checklist.Title,
+		&checklist.Position,
+		&checklist.CreatedAt,
+		&checklist.UpdatedAt,
+	)
+}
+
+func scanItem(row scanner, item *models.ChecklistItem) error {
+	return row.Scan(
+		&item.ID,
+		&item.ChecklistID,
+		&item.Title,
+		&item.Done,
+		&item.Position,
+		&item.CreatedAt,
+		&item.UpdatedAt,
+	)
+}
@@ -0,0 +1,17 @@
+package checklists
+
+import "github.com/SlavaShagalov/my-trello-backend/internal/models"
+
+// All methods taking cardID fail with ErrChecklistNotFound or
+// ErrChecklistItemNotFound unless the checklist belongs to the card.
+type Usecase interface {
+	Create(params *CreateParams) (models.Checklist, error)
+	ListByCard(cardID int) ([]models.Checklist, error)
+	PartialUpdate(cardID int, params *PartialUpdateParams) (models.Checklist, error)
+	Delete(cardID, id int) error
+
+	CreateItem(cardID int, params *CreateItemParams) (models.ChecklistItem, error)
+	PartialUpdateItem(cardID, checklistID int, params *PartialUpdateItemParams) (models.ChecklistItem, error)
+	DeleteItem(cardID, checklistID, id int) error
+	ConvertItem(cardID, checklistID, id int) (models.Card, error)
+}
@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/checklists"
+	"github.com/SlavaShagalov/my-trello-backend/internal/events"
+	"github.com/SlavaShagalov/my-trello-backend/internal/lists"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"strings"
+	"unicode/utf8"
+)
+
+type usecase struct {
+	repo      checklists.Repository
+	listsRepo lists.Repository
+	bus       events.Bus
+}
+
+func New(repo checklists.Repository, listsRepo lists.Repository, bus events.Bus) checklists.Usecase {
+	return &usecase{
+		repo:      repo,
+		listsRepo: listsRepo,
+		bus:       bus,
+	}
+}
+
+func (uc *usecase) Create(params *checklists.CreateParams) (models.Checklist, error) {
+	title, err := validateTitle(params.Title)
+	if err != nil {
+		return models.Checklist{}, err
+	}
+
+	return uc.repo.Create(&checklists.CreateParams{
+		CardID: params.CardID,
+		Title:  title,
+	})
+}
+
+func (uc *usecase) ListByCard(cardID int) ([]models.Checklist, error) {
+	return uc.repo.ListByCard(cardID)
+}
+
+func (uc *usecase) PartialUpdate(cardID int, params *checklists.PartialUpdateParams) (models.Checklist, error) {
+	validated := *params
+
+	var err error
+	if params.UpdateTitle {
+		validated.Title, err = validateTitle(params.Title)
+		if err != nil {
+			return models.Checklist{}, err
+		}
+	}
+
+	err = uc.checkCard(cardID, params.ID)
+	if err != nil {
+		return models.Checklist{}, err
+	}
+
+	return uc.repo.PartialUpdate(&validated)
+}
+
+func (uc *usecase) Delete(cardID, id int) error {
+	err := uc.checkCard(cardID, id)
+	if err != nil {
+		return err
+	}
+
+	return uc.repo.Delete(id)
+}
+
+func (uc *usecase) CreateItem(cardID int, params *checklists.CreateItemParams) (models.ChecklistItem, error) {
+	title, err := validateTitle(params.Title)
+	if err != nil {
+		return models.ChecklistItem{}, err
+	}
+
+	err = uc.checkCard(cardID, params.ChecklistID)
+	if err != nil {
+		return models.ChecklistItem{}, err
+	}
+
+	return uc.repo.CreateItem(&checklists.CreateItemParams{
+		ChecklistID: params.ChecklistID,
+		Title:       title,
+	})
+}
+
+func (uc *usecase) PartialUpdateItem(cardID, checklistID int,
+	params *checklists.PartialUpdateItemParams) (models.ChecklistItem, error) {
+	validated := *params
+
+	var err error
+	if params.UpdateTitle {
+		validated.Title, err = validateTitle(params.Title)
+		if err != nil {
+			return models.ChecklistItem{}, err
+		}
+	}
+
+	err = uc.checkItem(cardID, checklistID, params.ID)
+	if err != nil {
+		return models.ChecklistItem{}, err
+	}
+
+	return uc.repo.PartialUpdateItem(&validated)
+}
+
+func (uc *usecase) DeleteItem(cardID, checklistID, id int) error {
+	err := uc.checkItem(cardID, checklistID, id)
+	if err != nil {
+		return err
+	}
+
+	return uc.repo.DeleteItem(id)
+}
+
+func (uc *usecase) ConvertItem(cardID, checklistID, id int) (models.Card, error) {
+	err := uc.checkItem(cardID, checklistID, id)
+	if err != nil {
+		return models.Card{}, err
+	}
+
+	card, err := uc.repo.ConvertItem(id)
+	if err != nil {
+		return models.Card{}, err
+	}
+
+	// The card is already stored at this point, so a failed lookup only
+	// drops the event.
+	list, err := uc.listsRepo.Get(card.ListID)
+	if err == nil {
+		uc.bus.Publish(events.New(models.EventCardCreated, list.BoardID, card))
+	}
+	return card, nil
+}
+
+func (uc *usecase) checkCard(cardID, id int) error {
+	checklist, err := uc.repo.Get(id)
+	if err != nil {
+		return err
+	}
+	if checklist.CardID != cardID {
+		return pkgErrors.ErrChecklistNotFound
+	}
+	return nil
+}
+
+func (uc *usecase) checkItem(cardID, checklistID, id int) error {
+	err := uc.checkCard(cardID, checklistID)
+	if err != nil {
+		return err
+	}
+
+	item, err := uc.repo.GetItem(id)
+	if err != nil {
+		return err
+	}
+	if item.ChecklistID != checklistID {
+		return pkgErrors.ErrChecklistItemNotFound
+	}
+	return nil
+}
+
+func validateTitle(title string) (string, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return "", pkgErrors.ErrEmptyChecklistTitle
+	}
+	if utf8.RuneCountInString(title) > constants.MaxChecklistTitleLen {
+		return "", pkgErrors.ErrTooLongChecklistTitle
+	}
+	return title, nil
+}
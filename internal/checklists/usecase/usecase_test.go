@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	pkgChecklists "github.com/SlavaShagalov/my-trello-backend/internal/checklists"
+	"github.com/SlavaShagalov/my-trello-backend/internal/checklists/mocks"
+	eventsMocks "github.com/SlavaShagalov/my-trello-backend/internal/events/mocks"
+	listsMocks "github.com/SlavaShagalov/my-trello-backend/internal/lists/mocks"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestUsecase_Create(t *testing.T) {
+	type fields struct {
+		repo      *mocks.MockRepository
+		checklist *models.Checklist
+	}
+
+	type testCase struct {
+		prepare   func(f *fields)
+		params    *pkgChecklists.CreateParams
+		checklist models.Checklist
+		err       error
+	}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Create(&pkgChecklists.CreateParams{CardID: 21, Title: "Todo"}).
+					Return(*f.checklist, nil)
+			},
+			params:    &pkgChecklists.CreateParams{CardID: 21, Title: " Todo "},
+			checklist: models.Checklist{ID: 1, CardID: 21, Title: "Todo", Position: 1, Items: []models.ChecklistItem{}},
+			err:       nil,
+		},
+		"empty title": {
+			params:    &pkgChecklists.CreateParams{CardID: 21, Title: "  "},
+			checklist: models.Checklist{},
+			err:       pkgErrors.ErrEmptyChecklistTitle,
+		},
+		"too long title": {
+			params:    &pkgChecklists.CreateParams{CardID: 21, Title: strings.Repeat("ы", 101)},
+			checklist: models.Checklist{},
+			err:       pkgErrors.ErrTooLongChecklistTitle,
+		},
+		"card not found": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Create(gomock.Any()).Return(models.Checklist{}, pkgErrors.ErrCardNotFound)
+			},
+			params:    &pkgChecklists.CreateParams{CardID: 999, Title: "Todo"},
+			checklist: models.Checklist{},
+			err:       pkgErrors.ErrCardNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), checklist: &test.checklist}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo, nil, nil)
+			checklist, err := uc.Create(test.params)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			assert.Equal(t, test.checklist, checklist)
+		})
+	}
+}
+
+func TestUsecase_PartialUpdateItem(t *testing.T) {
+	type fields struct {
+		repo *mocks.MockRepository
+		item *models.ChecklistItem
+	}
+
+	type testCase struct {
+		prepare     func(f *fields)
+		cardID      int
+		checklistID int
+		params      *pkgChecklists.PartialUpdateItemParams
+		item        models.ChecklistItem
+		err         error
+	}
+
+	tests := map[string]testCase{
+		"check and move": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(3).Return(models.Checklist{ID: 3, CardID: 21}, nil)
+				f.repo.EXPECT().GetItem(7).Return(models.ChecklistItem{ID: 7, ChecklistID: 3}, nil)
+				f.repo.EXPECT().PartialUpdateItem(&pkgChecklists.PartialUpdateItemParams{ID: 7, Done: true,
+					UpdateDone: true, Position: 1, UpdatePosition: true}).Return(*f.item, nil)
+			},
+			cardID:      21,
+			checklistID: 3,
+			params: &pkgChecklists.PartialUpdateItemParams{ID: 7, Done: true, UpdateDone: true, Position: 1,
+				UpdatePosition: true},
+			item: models.ChecklistItem{ID: 7, ChecklistID: 3, Title: "Write tests", Done: true, Position: 1},
+			err:  nil,
+		},
+		"rename": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(3).Return(models.Checklist{ID: 3, CardID: 21}, nil)
+				f.repo.EXPECT().GetItem(7).Return(models.ChecklistItem{ID: 7, ChecklistID: 3}, nil)
+				f.repo.EXPECT().PartialUpdateItem(&pkgChecklists.PartialUpdateItemParams{ID: 7, Title: "Run tests",
+					UpdateTitle: true}).Return(*f.item, nil)
+			},
+			cardID:      21,
+			checklistID: 3,
+			params:      &pkgChecklists.PartialUpdateItemParams{ID: 7, Title: "Run tests ", UpdateTitle: true},
+			item:        models.ChecklistItem{ID: 7, ChecklistID: 3, Title: "Run tests", Position: 2},
+			err:         nil,
+		},
+		"empty title": {
+			cardID:      21,
+			checklistID: 3,
+			params:      &pkgChecklists.PartialUpdateItemParams{ID: 7, UpdateTitle: true},
+			item:        models.ChecklistItem{},
+			err:         pkgErrors.ErrEmptyChecklistTitle,
+		},
+		"checklist of another card": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(3).Return(models.Checklist{ID: 3, CardID: 22}, nil)
+			},
+			cardID:      21,
+			checklistID: 3,
+			params:      &pkgChecklists.PartialUpdateItemParams{ID: 7, Done: true, UpdateDone: true},
+			item:        models.ChecklistItem{},
+			err:         pkgErrors.ErrChecklistNotFound,
+		},
+		"item of another checklist": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(3).Return(models.Checklist{ID: 3, CardID: 21}, nil)
+				f.repo.EXPECT().GetItem(7).Return(models.ChecklistItem{ID: 7, ChecklistID: 4}, nil)
+			},
+			cardID:      21,
+			checklistID: 3,
+			params:      &pkgChecklists.PartialUpdateItemParams{ID: 7, Done: true, UpdateDone: true},
+			item:        models.ChecklistItem{},
+			err:         pkgErrors.ErrChecklistItemNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), item: &test.item}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo, nil, nil)
+			item, err := uc.PartialUpdateItem(test.cardID, test.checklistID, test.params)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			assert.Equal(t, test.item, item)
+		})
+	}
+}
+
+func TestUsecase_ConvertItem(t *testing.T) {
+	type fields struct {
+		repo      *mocks.MockRepository
+		listsRepo *listsMocks.MockRepository
+		bus       *eventsMocks.MockBus
+		card      *models.Card
+	}
+
+	type testCase struct {
+		prepare func(f *fields)
+		card    models.Card
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(3).Return(models.Checklist{ID: 3, CardID: 21}, nil)
+				f.repo.EXPECT().GetItem(7).Return(models.ChecklistItem{ID: 7, ChecklistID: 3}, nil)
+				f.repo.EXPECT().ConvertItem(7).Return(*f.card, nil)
+				f.listsRepo.EXPECT().Get(27).Return(models.List{ID: 27, BoardID: 9}, nil)
+				f.bus.EXPECT().Publish(gomock.Any())
+			},
+			card: models.Card{ID: 40, ListID: 27, Title: "Write tests", Position: 5, Labels: []models.Label{}},
+			err:  nil,
+		},
+		"item not found": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(3).Return(models.Checklist{ID: 3, CardID: 21}, nil)
+				f.repo.EXPECT().GetItem(7).Return(models.ChecklistItem{}, pkgErrors.ErrChecklistItemNotFound)
+			},
+			card: models.Card{},
+			err:  pkgErrors.ErrChecklistItemNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repo:      mocks.NewMockRepository(ctrl),
+				listsRepo: listsMocks.NewMockRepository(ctrl),
+				bus:       eventsMocks.NewMockBus(ctrl),
+				card:      &test.card,
+			}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo, f.listsRepo, f.bus)
+			card, err := uc.ConvertItem(21, 3, 7)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			assert.Equal(t, test.card, card)
+		})
+	}
+}
@@ -0,0 +1,353 @@
+package http
+
+import (
+	pAccess "github.com/SlavaShagalov/my-trello-backend/internal/access"
+	pComments "github.com/SlavaShagalov/my-trello-backend/internal/comments"
+	mw "github.com/SlavaShagalov/my-trello-backend/internal/middleware"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pHTTP "github.com/SlavaShagalov/my-trello-backend/internal/pkg/http"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+type delivery struct {
+	uc       pComments.Usecase
+	accessUC pAccess.Usecase
+	log      *zap.Logger
+}
+
+func RegisterHandlers(mux *mux.Router, uc pComments.Usecase, accessUC pAccess.Usecase, log *zap.Logger,
+	checkAuth mw.Middleware, metrics mw.Middleware) {
+	del := delivery{
+		uc:       uc,
+		accessUC: accessUC,
+		log:      log,
+	}
+
+	const (
+		cardCommentsPrefix  = "/cards/{id}/comments"
+		cardCommentsPath    = constants.ApiPrefix + cardCommentsPrefix
+		commentPath         = cardCommentsPath + "/{comment_id}"
+		commentVersionsPath = commentPath + "/versions"
+	)
+
+	mux.HandleFunc(cardCommentsPath, metrics(checkAuth(del.create))).Methods(http.MethodPost)
+	mux.HandleFunc(cardCommentsPath, metrics(checkAuth(del.listByCard))).Methods(http.MethodGet)
+	mux.HandleFunc(commentPath, metrics(checkAuth(del.update))).Methods(http.MethodPatch)
+	mux.HandleFunc(commentPath, metrics(checkAuth(del.delete))).Methods(http.MethodDelete)
+	mux.HandleFunc(commentVersionsPath, metrics(checkAuth(del.listVersions))).Methods(http.MethodGet)
+}
+
+// create godoc
+//
+//	@Summary		Create a new comment
+//	@Description	Create a new comment on the card
+//	@Tags			cards
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		int				true	"Card ID"
+//	@Param			CommentData	body		createRequest	true	"Comment data"
+//	@Success		200			{object}	models.Comment	"Created comment data."
+//	@Failure		400			{object}	http.JSONError
+//	@Failure		401			{object}	http.JSONError
+//	@Failure		403			{object}	http.JSONError
+//	@Failure		404			{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/comments [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) create(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	body, err := pHTTP.ReadBody(r, del.log)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	var request createRequest
+	err = request.UnmarshalJSON(body)
+	if err != nil {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	params := pComments.CreateParams{
+		CardID:   cardID,
+		AuthorID: userID,
+		Content:  request.Content,
+	}
+
+	comment, err := del.uc.Create(&params)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	pHTTP.SendJSON(w, r, http.StatusOK, &comment)
+}
+
+// listByCard godoc
+//
+//	@Summary		Returns comments of card
+//	@Description	Returns a page of card comments, newest first
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id		path		int				true	"Card ID"
+//	@Param			before	query		int				false	"next_before of the previous page"
+//	@Param			limit	query		int				false	"Page size, 20 by default and 100 at most"
+//	@Success		200		{object}	listResponse	"Comments data"
+//	@Failure		400		{object}	http.JSONError
+//	@Failure		401		{object}	http.JSONError
+//	@Failure		403		{object}	http.JSONError
+//	@Failure		404		{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/comments [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) listByCard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	params := pComments.ListParams{}
+	query := r.URL.Query()
+	if value := query.Get("before"); value != "" {
+		params.Before, err = strconv.Atoi(value)
+		if err != nil {
+			pHTTP.HandleError(w, r, errors.Wrap(pErrors.ErrBadPagination, err.Error()))
+			return
+		}
+	}
+	if value := query.Get("limit"); value != "" {
+		params.Limit, err = strconv.Atoi(value)
+		if err != nil {
+			pHTTP.HandleError(w, r, errors.Wrap(pErrors.ErrBadPagination, err.Error()))
+			return
+		}
+	}
+
+	page, err := del.uc.ListByCard(cardID, &params)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newListResponse(&page)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// update godoc
+//
+//	@Summary		Edit comment
+//	@Description	Edit own comment. The previous content is kept in the comment versions.
+//	@Tags			cards
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		int				true	"Card ID"
+//	@Param			comment_id	path		int				true	"Comment ID"
+//	@Param			CommentData	body		updateRequest	true	"Comment data"
+//	@Success		200			{object}	models.Comment	"Updated comment data."
+//	@Failure		400			{object}	http.JSONError
+//	@Failure		401			{object}	http.JSONError
+//	@Failure		403			{object}	http.JSONError
+//	@Failure		404			{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/comments/{comment_id} [patch]
+//
+//	@Security		cookieAuth
+func (del *delivery) update(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	commentID, err := strconv.Atoi(vars["comment_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	body, err := pHTTP.ReadBody(r, del.log)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	var request updateRequest
+	err = request.UnmarshalJSON(body)
+	if err != nil {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	params := pComments.UpdateParams{
+		ID:      commentID,
+		Content: request.Content,
+	}
+
+	comment, err := del.uc.Update(userID, cardID, &params)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	pHTTP.SendJSON(w, r, http.StatusOK, &comment)
+}
+
+// delete godoc
+//
+//	@Summary		Delete comment
+//	@Description	Delete own comment with its versions
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id			path	int	true	"Card ID"
+//	@Param			comment_id	path	int	true	"Comment ID"
+//	@Success		204			"Comment deleted successfully"
+//	@Failure		400			{object}	http.JSONError
+//	@Failure		401			{object}	http.JSONError
+//	@Failure		403			{object}	http.JSONError
+//	@Failure		404			{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/comments/{comment_id} [delete]
+//
+//	@Security		cookieAuth
+func (del *delivery) delete(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	commentID, err := strconv.Atoi(vars["comment_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	err = del.uc.Delete(userID, cardID, commentID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listVersions godoc
+//
+//	@Summary		Returns comment edit history
+//	@Description	Returns previous contents of the comment, latest first
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id			path		int					true	"Card ID"
+//	@Param			comment_id	path		int					true	"Comment ID"
+//	@Success		200			{object}	versionsResponse	"Versions data"
+//	@Failure		400			{object}	http.JSONError
+//	@Failure		401			{object}	http.JSONError
+//	@Failure		403			{object}	http.JSONError
+//	@Failure		404			{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/comments/{comment_id}/versions [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) listVersions(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	commentID, err := strconv.Atoi(vars["comment_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	versions, err := del.uc.ListVersions(cardID, commentID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newVersionsResponse(versions)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
@@ -0,0 +1,40 @@
+package http
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/comments"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+)
+
+//go:generate easyjson -all -snake_case models.go
+
+// API requests
+type createRequest struct {
+	Content string `json:"content"`
+}
+
+type updateRequest struct {
+	Content string `json:"content"`
+}
+
+// API responses
+type listResponse struct {
+	Comments   []models.Comment `json:"comments"`
+	NextBefore *int             `json:"next_before"`
+}
+
+func newListResponse(page *comments.Page) *listResponse {
+	return &listResponse{
+		Comments:   page.Comments,
+		NextBefore: page.NextBefore,
+	}
+}
+
+type versionsResponse struct {
+	Versions []models.CommentVersion `json:"versions"`
+}
+
+func newVersionsResponse(versions []models.CommentVersion) *versionsResponse {
+	return &versionsResponse{
+		Versions: versions,
+	}
+}
@@ -0,0 +1,32 @@
+package comments
+
+import "github.com/SlavaShagalov/my-trello-backend/internal/models"
+
+type CreateParams struct {
+	CardID   int
+	AuthorID int
+	Content  string
+}
+
+type UpdateParams struct {
+	ID      int
+	Content string
+}
+
+// ListParams selects a page of comments, newest first. Before is the ID of
+// the last comment of the previous page, zero for the first page.
+type ListParams struct {
+	Before int
+	Limit  int
+}
+
+type Repository interface {
+	Create(params *CreateParams) (models.Comment, error)
+	ListByCard(cardID int, params *ListParams) ([]models.Comment, error)
+	Get(id int) (models.Comment, error)
+	// Update saves the current content as a version and replaces it in one
+	// transaction.
+	Update(params *UpdateParams) (models.Comment, error)
+	Delete(id int) error
+	ListVersions(commentID int) ([]models.CommentVersion, error)
+}
@@ -0,0 +1,273 @@
+package postgres
+
+import (
+	"database/sql"
+	pkgComments "github.com/SlavaShagalov/my-trello-backend/internal/comments"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+type repository struct {
+	db  *sql.DB
+	log *zap.Logger
+}
+
+func New(db *sql.DB, log *zap.Logger) pkgComments.Repository {
+	return &repository{db: db, log: log}
+}
+
+const createCmd = `
+	WITH cm AS (
+		INSERT INTO comments (card_id, author_id, content)
+		VALUES ($1, $2, $3)
+		RETURNING id, card_id, author_id, content, created_at, updated_at, edited_at
+	)
+	SELECT cm.id, cm.card_id, cm.author_id, u.username, cm.content, cm.created_at, cm.updated_at, cm.edited_at
+	FROM cm
+	JOIN users u on u.id = cm.author_id;`
+
+func (repo *repository) Create(params *pkgComments.CreateParams) (models.Comment, error) {
+	row := repo.db.QueryRow(createCmd, params.CardID, params.AuthorID, params.Content)
+
+	var comment models.Comment
+	err := scanComment(row, &comment)
+	if err != nil {
+		pgErr, ok := err.(*pq.Error)
+		if !ok {
+			repo.log.Error("Cannot convert err to pq.Error", zap.Error(err))
+			return models.Comment{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+		if pgErr.Constraint == "comments_card_id_fkey" {
+			return models.Comment{}, errors.Wrap(pkgErrors.ErrCardNotFound, err.Error())
+		}
+		if pgErr.Constraint == "comments_author_id_fkey" {
+			return models.Comment{}, errors.Wrap(pkgErrors.ErrUserNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", createCmd),
+			zap.Any("create_params", params))
+		return models.Comment{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("New comment created", zap.Any("comment", comment))
+	return comment, nil
+}
+
+const listByCardCmd = `
+	SELECT cm.id, cm.card_id, cm.author_id, u.username, cm.content, cm.created_at, cm.updated_at, cm.edited_at
+	FROM comments cm
+	JOIN users u on u.id = cm.author_id
+	WHERE cm.card_id = $1 AND ($2 = 0 OR cm.id < $2)
+	ORDER BY cm.id DESC
+	LIMIT $3;`
+
+func (repo *repository) ListByCard(cardID int, params *pkgComments.ListParams) ([]models.Comment, error) {
+	rows, err := repo.db.Query(listByCardCmd, cardID, params.Before, params.Limit)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listByCardCmd),
+			zap.Int("card_id", cardID), zap.Any("params", params))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	comments := []models.Comment{}
+	for rows.Next() {
+		var comment models.Comment
+		err = scanComment(rows, &comment)
+		if err != nil {
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", listByCardCmd),
+				zap.Int("card_id", cardID), zap.Any("params", params))
+			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+
+		comments = append(comments, comment)
+	}
+
+	return comments, nil
+}
+
+const getCmd = `
+	SELECT cm.id, cm.card_id, cm.author_id, u.username, cm.content, cm.created_at, cm.updated_at, cm.edited_at
+	FROM comments cm
+	JOIN users u on u.id = cm.author_id
+	WHERE cm.id = $1;`
+
+func (repo *repository) Get(id int) (models.Comment, error) {
+	row := repo.db.QueryRow(getCmd, id)
+
+	var comment models.Comment
+	err := scanComment(row, &comment)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Comment{}, errors.Wrap(pkgErrors.ErrCommentNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", getCmd), zap.Int("id", id))
+		return models.Comment{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	return comment, nil
+}
+
+const (
+	// saveVersionCmd dates the version with the time its content was written.
+	saveVersionCmd = `
+	INSERT INTO comment_versions (comment_id, content, created_at)
+	SELECT id, content, COALESCE(edited_at, created_at)
+	FROM comments
+	WHERE id = $1;`
+
+	updateCmd = `
+	WITH cm AS (
+		UPDATE comments
+		SET content    = $1,
+			updated_at = now(),
+			edited_at  = now()
+		WHERE id = $2
+		RETURNING id, card_id, author_id, content, created_at, updated_at, edited_at
+	)
+	SELECT cm.id, cm.card_id, cm.author_id, u.username, cm.content, cm.created_at, cm.updated_at, cm.edited_at
+	FROM cm
+	JOIN users u on u.id = cm.author_id;`
+)
+
+func (repo *repository) Update(params *pkgComments.UpdateParams) (models.Comment, error) {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Any("params", params))
+		return models.Comment{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	result, err := tx.Exec(saveVersionCmd, params.ID)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", saveVersionCmd),
+			zap.Any("params", params))
+		return models.Comment{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", saveVersionCmd),
+			zap.Any("params", params))
+		return models.Comment{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	if rowsAffected == 0 {
+		return models.Comment{}, pkgErrors.ErrCommentNotFound
+	}
+
+	row := tx.QueryRow(updateCmd, params.Content, params.ID)
+
+	var comment models.Comment
+	err = scanComment(row, &comment)
+	if err != nil {
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", updateCmd),
+			zap.Any("params", params))
+		return models.Comment{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Any("params", params))
+		return models.Comment{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("Comment updated", zap.Any("comment", comment))
+	return comment, nil
+}
+
+const deleteCmd = `
+	DELETE FROM comments
+	WHERE id = $1;`
+
+func (repo *repository) Delete(id int) error {
+	result, err := repo.db.Exec(deleteCmd, id)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", deleteCmd), zap.Int("id", id))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", deleteCmd), zap.Int("id", id))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	if rowsAffected == 0 {
+		return pkgErrors.ErrCommentNotFound
+	}
+
+	repo.log.Debug("Comment deleted", zap.Int("id", id))
+	return nil
+}
+
+const listVersionsCmd = `
+	SELECT id, comment_id, content, created_at
+	FROM comment_versions
+	WHERE comment_id = $1
+	ORDER BY id DESC;`
+
+func (repo *repository) ListVersions(commentID int) ([]models.CommentVersion, error) {
+	rows, err := repo.db.Query(listVersionsCmd, commentID)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listVersionsCmd),
+			zap.Int("comment_id", commentID))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	versions := []models.CommentVersion{}
+	for rows.Next() {
+		var version models.CommentVersion
+		err = rows.Scan(
+			&version.ID,
+			&version.CommentID,
+			&version.Content,
+			&version.CreatedAt,
+		)
+		if err != nil {
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", listVersionsCmd),
+				zap.Int("comment_id", commentID))
+			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanComment(row scanner, comment *models.Comment) error {
+	var editedAt sql.NullTime
+	err := row.Scan(
+		&comment.ID,
+		&comment.CardID,
+		&comment.AuthorID,
+		&comment.AuthorUsername,
+		&comment.Content,
+		&comment.CreatedAt,
+		&comment.UpdatedAt,
+		&editedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if editedAt.Valid {
+		comment.EditedAt = &editedAt.Time
+	}
+	return nil
+}
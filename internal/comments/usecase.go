@@ -0,0 +1,20 @@
+package comments
+
+import "github.com/SlavaShagalov/my-trello-backend/internal/models"
+
+type Page struct {
+	Comments []models.Comment
+	// NextBefore is the cursor of the next page, nil on the last page.
+	NextBefore *int
+}
+
+// Methods taking cardID fail with ErrCommentNotFound unless the comment
+// belongs to the card. Update and Delete fail with ErrNotCommentAuthor
+// unless userID wrote the comment.
+type Usecase interface {
+	Create(params *CreateParams) (models.Comment, error)
+	ListByCard(cardID int, params *ListParams) (Page, error)
+	Update(userID, cardID int, params *UpdateParams) (models.Comment, error)
+	Delete(userID, cardID, id int) error
+	ListVersions(cardID, id int) ([]models.CommentVersion, error)
+}
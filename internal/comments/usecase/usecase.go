@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/comments"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"strings"
+	"unicode/utf8"
+)
+
+type usecase struct {
+	repo comments.Repository
+}
+
+func New(repo comments.Repository) comments.Usecase {
+	return &usecase{repo: repo}
+}
+
+func (uc *usecase) Create(params *comments.CreateParams) (models.Comment, error) {
+	content, err := validateContent(params.Content)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	return uc.repo.Create(&comments.CreateParams{
+		CardID:   params.CardID,
+		AuthorID: params.AuthorID,
+		Content:  content,
+	})
+}
+
+func (uc *usecase) ListByCard(cardID int, params *comments.ListParams) (comments.Page, error) {
+	limit := params.Limit
+	if limit == 0 {
+		limit = constants.CommentsPageSize
+	}
+	if limit < 0 || params.Before < 0 {
+		return comments.Page{}, pkgErrors.ErrBadPagination
+	}
+	if limit > constants.MaxCommentsPageSize {
+		limit = constants.MaxCommentsPageSize
+	}
+
+	// One extra comment tells whether there is a next page.
+	list, err := uc.repo.ListByCard(cardID, &comments.ListParams{Before: params.Before, Limit: limit + 1})
+	if err != nil {
+		return comments.Page{}, err
+	}
+
+	page := comments.Page{Comments: list}
+	if len(list) > limit {
+		page.Comments = list[:limit]
+		page.NextBefore = &list[limit-1].ID
+	}
+	return page, nil
+}
+
+func (uc *usecase) Update(userID, cardID int, params *comments.UpdateParams) (models.Comment, error) {
+	content, err := validateContent(params.Content)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	err = uc.checkAuthor(userID, cardID, params.ID)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	return uc.repo.Update(&comments.UpdateParams{
+		ID:      params.ID,
+		Content: content,
+	})
+}
+
+func (uc *usecase) Delete(userID, cardID, id int) error {
+	err := uc.checkAuthor(userID, cardID, id)
+	if err != nil {
+		return err
+	}
+
+	return uc.repo.Delete(id)
+}
+
+func (uc *usecase) ListVersions(cardID, id int) ([]models.CommentVersion, error) {
+	_, err := uc.checkCard(cardID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.ListVersions(id)
+}
+
+func (uc *usecase) checkCard(cardID, id int) (models.Comment, error) {
+	comment, err := uc.repo.Get(id)
+	if err != nil {
+		return models.Comment{}, err
+	}
+	if comment.CardID != cardID {
+		return models.Comment{}, pkgErrors.ErrCommentNotFound
+	}
+	return comment, nil
+}
+
+func (uc *usecase) checkAuthor(userID, cardID, id int) error {
+	comment, err := uc.checkCard(cardID, id)
+	if err != nil {
+		return err
+	}
+	if comment.AuthorID != userID {
+		return pkgErrors.ErrNotCommentAuthor
+	}
+	return nil
+}
+
+func validateContent(content string) (string, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return "", pkgErrors.ErrEmptyComment
+	}
+	if utf8.RuneCountInString(content) > constants.MaxCommentLen {
+		return "", pkgErrors.ErrTooLongComment
+	}
+	return content, nil
+}
@@ -0,0 +1,278 @@
+package usecase
+
+import (
+	pkgComments "github.com/SlavaShagalov/my-trello-backend/internal/comments"
+	"github.com/SlavaShagalov/my-trello-backend/internal/comments/mocks"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestUsecase_Create(t *testing.T) {
+	type fields struct {
+		repo    *mocks.MockRepository
+		comment *models.Comment
+	}
+
+	type testCase struct {
+		prepare func(f *fields)
+		params  *pkgComments.CreateParams
+		comment models.Comment
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Create(&pkgComments.CreateParams{CardID: 21, AuthorID: 1, Content: "Looks good"}).
+					Return(*f.comment, nil)
+			},
+			params:  &pkgComments.CreateParams{CardID: 21, AuthorID: 1, Content: "Looks good\n"},
+			comment: models.Comment{ID: 1, CardID: 21, AuthorID: 1, AuthorUsername: "slava", Content: "Looks good"},
+			err:     nil,
+		},
+		"empty": {
+			params:  &pkgComments.CreateParams{CardID: 21, AuthorID: 1, Content: " \n "},
+			comment: models.Comment{},
+			err:     pkgErrors.ErrEmptyComment,
+		},
+		"too long": {
+			params:  &pkgComments.CreateParams{CardID: 21, AuthorID: 1, Content: strings.Repeat("ы", 5001)},
+			comment: models.Comment{},
+			err:     pkgErrors.ErrTooLongComment,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), comment: &test.comment}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo)
+			comment, err := uc.Create(test.params)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			assert.Equal(t, test.comment, comment)
+		})
+	}
+}
+
+func TestUsecase_ListByCard(t *testing.T) {
+	type fields struct {
+		repo *mocks.MockRepository
+	}
+
+	comments := []models.Comment{{ID: 9}, {ID: 7}, {ID: 4}}
+	next := 7
+
+	type testCase struct {
+		prepare func(f *fields)
+		params  *pkgComments.ListParams
+		page    pkgComments.Page
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"default limit": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().ListByCard(21, &pkgComments.ListParams{Limit: 21}).Return(comments, nil)
+			},
+			params: &pkgComments.ListParams{},
+			page:   pkgComments.Page{Comments: comments},
+			err:    nil,
+		},
+		"next page": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().ListByCard(21, &pkgComments.ListParams{Before: 10, Limit: 3}).Return(comments, nil)
+			},
+			params: &pkgComments.ListParams{Before: 10, Limit: 2},
+			page:   pkgComments.Page{Comments: comments[:2], NextBefore: &next},
+			err:    nil,
+		},
+		"limit capped": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().ListByCard(21, &pkgComments.ListParams{Limit: 101}).Return(comments, nil)
+			},
+			params: &pkgComments.ListParams{Limit: 1000},
+			page:   pkgComments.Page{Comments: comments},
+			err:    nil,
+		},
+		"negative limit": {
+			params: &pkgComments.ListParams{Limit: -1},
+			page:   pkgComments.Page{},
+			err:    pkgErrors.ErrBadPagination,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl)}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo)
+			page, err := uc.ListByCard(21, test.params)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			assert.Equal(t, test.page, page)
+		})
+	}
+}
+
+func TestUsecase_Update(t *testing.T) {
+	type fields struct {
+		repo    *mocks.MockRepository
+		comment *models.Comment
+	}
+
+	type testCase struct {
+		prepare func(f *fields)
+		userID  int
+		cardID  int
+		params  *pkgComments.UpdateParams
+		comment models.Comment
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"author": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(5).Return(models.Comment{ID: 5, CardID: 21, AuthorID: 1}, nil)
+				f.repo.EXPECT().Update(&pkgComments.UpdateParams{ID: 5, Content: "Fixed"}).Return(*f.comment, nil)
+			},
+			userID:  1,
+			cardID:  21,
+			params:  &pkgComments.UpdateParams{ID: 5, Content: "Fixed"},
+			comment: models.Comment{ID: 5, CardID: 21, AuthorID: 1, Content: "Fixed"},
+			err:     nil,
+		},
+		"another user": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(5).Return(models.Comment{ID: 5, CardID: 21, AuthorID: 1}, nil)
+			},
+			userID:  2,
+			cardID:  21,
+			params:  &pkgComments.UpdateParams{ID: 5, Content: "Fixed"},
+			comment: models.Comment{},
+			err:     pkgErrors.ErrNotCommentAuthor,
+		},
+		"another card": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(5).Return(models.Comment{ID: 5, CardID: 22, AuthorID: 1}, nil)
+			},
+			userID:  1,
+			cardID:  21,
+			params:  &pkgComments.UpdateParams{ID: 5, Content: "Fixed"},
+			comment: models.Comment{},
+			err:     pkgErrors.ErrCommentNotFound,
+		},
+		"empty": {
+			userID:  1,
+			cardID:  21,
+			params:  &pkgComments.UpdateParams{ID: 5},
+			comment: models.Comment{},
+			err:     pkgErrors.ErrEmptyComment,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), comment: &test.comment}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo)
+			comment, err := uc.Update(test.userID, test.cardID, test.params)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			assert.Equal(t, test.comment, comment)
+		})
+	}
+}
+
+func TestUsecase_Delete(t *testing.T) {
+	type fields struct {
+		repo *mocks.MockRepository
+	}
+
+	type testCase struct {
+		prepare func(f *fields)
+		userID  int
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"author": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(5).Return(models.Comment{ID: 5, CardID: 21, AuthorID: 1}, nil)
+				f.repo.EXPECT().Delete(5).Return(nil)
+			},
+			userID: 1,
+			err:    nil,
+		},
+		"another user": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(5).Return(models.Comment{ID: 5, CardID: 21, AuthorID: 1}, nil)
+			},
+			userID: 2,
+			err:    pkgErrors.ErrNotCommentAuthor,
+		},
+		"comment not found": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(5).Return(models.Comment{}, pkgErrors.ErrCommentNotFound)
+			},
+			userID: 1,
+			err:    pkgErrors.ErrCommentNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl)}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo)
+			err := uc.Delete(test.userID, 21, 5)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+		})
+	}
+}
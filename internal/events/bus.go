@@ -0,0 +1,31 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"time"
+)
+
+type Bus interface {
+	// Publish delivers event to subscribers of its board on every API replica.
+	// Delivery is best effort: implementations log failures instead of
+	// returning them, so a broken bus never fails the change behind the event.
+	Publish(event *models.Event)
+	// Subscribe streams events of the board until ctx is done, then closes
+	// the channel. A non-empty lastEventID first replays buffered events
+	// published after it; if that event is no longer buffered, the stream
+	// starts with EventBoardResync.
+	Subscribe(ctx context.Context, boardID int, lastEventID string) (<-chan models.Event, error)
+}
+
+// New builds an event carrying data, which is any JSON-serializable model.
+func New(eventType string, boardID int, data interface{}) *models.Event {
+	payload, _ := json.Marshal(data)
+	return &models.Event{
+		Type:      eventType,
+		BoardID:   boardID,
+		Data:      payload,
+		CreatedAt: time.Now().UTC(),
+	}
+}
@@ -0,0 +1,241 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	pkgEvents "github.com/SlavaShagalov/my-trello-backend/internal/events"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// replaySize bounds the number of events kept per board for resumption.
+const replaySize = 100
+
+const eventField = "event"
+
+type bus struct {
+	rdb *redis.Client
+	ctx context.Context
+	log *zap.Logger
+}
+
+func New(rdb *redis.Client, ctx context.Context, log *zap.Logger) pkgEvents.Bus {
+	return &bus{
+		rdb: rdb,
+		ctx: ctx,
+		log: log,
+	}
+}
+
+// Publish appends the event to the replay stream of the board, which assigns
+// its ID, and then broadcasts it to live subscribers.
+func (b *bus) Publish(event *models.Event) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		b.log.Error("Failed to marshal event", zap.Error(err), zap.String("type", event.Type),
+			zap.Int("board_id", event.BoardID))
+		return
+	}
+
+	id, err := b.rdb.XAdd(b.ctx, &redis.XAddArgs{
+		Stream: stream(event.BoardID),
+		MaxLen: replaySize,
+		Approx: true,
+		Values: map[string]interface{}{eventField: payload},
+	}).Result()
+	if err != nil {
+		b.log.Error("Failed to buffer event", zap.Error(err), zap.String("type", event.Type),
+			zap.Int("board_id", event.BoardID))
+		return
+	}
+	event.ID = id
+
+	payload, err = json.Marshal(event)
+	if err != nil {
+		b.log.Error("Failed to marshal event", zap.Error(err), zap.String("type", event.Type),
+			zap.Int("board_id", event.BoardID))
+		return
+	}
+
+	err = b.rdb.Publish(b.ctx, channel(event.BoardID), payload).Err()
+	if err != nil {
+		b.log.Error("Failed to publish event", zap.Error(err), zap.String("type", event.Type),
+			zap.Int("board_id", event.BoardID))
+		return
+	}
+
+	b.log.Debug("Event published", zap.String("id", event.ID), zap.String("type", event.Type),
+		zap.Int("board_id", event.BoardID))
+}
+
+func (b *bus) Subscribe(ctx context.Context, boardID int, lastEventID string) (<-chan models.Event, error) {
+	pubsub := b.rdb.Subscribe(ctx, channel(boardID))
+
+	// Wait for the subscription to be confirmed, so that no event published
+	// after Subscribe returns is lost.
+	_, err := pubsub.Receive(ctx)
+	if err != nil {
+		_ = pubsub.Close()
+		b.log.Error("Failed to subscribe to board events", zap.Error(err), zap.Int("board_id", boardID))
+		return nil, errors.Wrap(pkgErrors.ErrEventBus, err.Error())
+	}
+
+	// The replay is read after subscribing, so events published in between
+	// may arrive twice; live events not newer than the replay are dropped.
+	var replay []models.Event
+	if lastEventID != "" {
+		replay, err = b.replay(ctx, boardID, lastEventID)
+		if err != nil {
+			_ = pubsub.Close()
+			b.log.Error("Failed to replay board events", zap.Error(err), zap.Int("board_id", boardID),
+				zap.String("last_event_id", lastEventID))
+			return nil, errors.Wrap(pkgErrors.ErrEventBus, err.Error())
+		}
+	}
+
+	events := make(chan models.Event)
+	go func() {
+		defer close(events)
+		defer func() {
+			_ = pubsub.Close()
+		}()
+
+		lastID := lastEventID
+		for _, event := range replay {
+			select {
+			case events <- event:
+				if event.ID != "" {
+					lastID = event.ID
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				var event models.Event
+				err := json.Unmarshal([]byte(msg.Payload), &event)
+				if err != nil {
+					b.log.Error("Failed to unmarshal event", zap.Error(err), zap.String("payload", msg.Payload))
+					continue
+				}
+
+				if lastID != "" && !newer(event.ID, lastID) {
+					continue
+				}
+
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// replay returns the buffered events published after lastEventID. If that
+// event has already been trimmed from the buffer, the subscriber has missed
+// events and gets EventBoardResync instead.
+func (b *bus) replay(ctx context.Context, boardID int, lastEventID string) ([]models.Event, error) {
+	resync := []models.Event{{
+		Type:      models.EventBoardResync,
+		BoardID:   boardID,
+		CreatedAt: time.Now().UTC(),
+	}}
+
+	if _, _, ok := parseID(lastEventID); !ok {
+		return resync, nil
+	}
+
+	found, err := b.rdb.XRange(ctx, stream(boardID), lastEventID, lastEventID).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(found) == 0 {
+		return resync, nil
+	}
+
+	messages, err := b.rdb.XRange(ctx, stream(boardID), "("+lastEventID, "+").Result()
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]models.Event, 0, len(messages))
+	for _, msg := range messages {
+		payload, _ := msg.Values[eventField].(string)
+
+		var event models.Event
+		err = json.Unmarshal([]byte(payload), &event)
+		if err != nil {
+			b.log.Error("Failed to unmarshal event", zap.Error(err), zap.String("payload", payload))
+			continue
+		}
+		event.ID = msg.ID
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func channel(boardID int) string {
+	return "boards:" + strconv.Itoa(boardID) + ":events"
+}
+
+func stream(boardID int) string {
+	return "boards:" + strconv.Itoa(boardID) + ":stream"
+}
+
+// newer reports whether stream ID a comes after b.
+func newer(a, b string) bool {
+	aMs, aSeq, ok := parseID(a)
+	if !ok {
+		return false
+	}
+	bMs, bSeq, ok := parseID(b)
+	if !ok {
+		return true
+	}
+
+	if aMs != bMs {
+		return aMs > bMs
+	}
+	return aSeq > bSeq
+}
+
+// parseID splits a Redis stream ID of the form "<ms>-<seq>".
+func parseID(id string) (uint64, uint64, bool) {
+	msPart, seqPart, found := strings.Cut(id, "-")
+	if !found {
+		return 0, 0, false
+	}
+
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	seq, err := strconv.ParseUint(seqPart, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return ms, seq, true
+}
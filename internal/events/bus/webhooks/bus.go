@@ -0,0 +1,33 @@
+package webhooks
+
+import (
+	"context"
+	pkgEvents "github.com/SlavaShagalov/my-trello-backend/internal/events"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	pkgWebhooks "github.com/SlavaShagalov/my-trello-backend/internal/webhooks"
+)
+
+// bus queues a webhook delivery for every event published to next.
+type bus struct {
+	next pkgEvents.Bus
+	repo pkgWebhooks.Repository
+}
+
+func New(next pkgEvents.Bus, repo pkgWebhooks.Repository) pkgEvents.Bus {
+	return &bus{
+		next: next,
+		repo: repo,
+	}
+}
+
+func (b *bus) Publish(event *models.Event) {
+	// next assigns the event ID, which receivers can use for deduplication.
+	b.next.Publish(event)
+
+	// Enqueue logs its failures.
+	_ = b.repo.Enqueue(event)
+}
+
+func (b *bus) Subscribe(ctx context.Context, boardID int, lastEventID string) (<-chan models.Event, error) {
+	return b.next.Subscribe(ctx, boardID, lastEventID)
+}
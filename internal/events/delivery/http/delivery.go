@@ -0,0 +1,322 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	pAccess "github.com/SlavaShagalov/my-trello-backend/internal/access"
+	pEvents "github.com/SlavaShagalov/my-trello-backend/internal/events"
+	mw "github.com/SlavaShagalov/my-trello-backend/internal/middleware"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pHTTP "github.com/SlavaShagalov/my-trello-backend/internal/pkg/http"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	writeWait  = 10 * time.Second
+	pongWait   = 60 * time.Second
+	pingPeriod = pongWait * 9 / 10
+
+	heartbeatPeriod = 15 * time.Second
+	// accessCheckPeriod bounds how long a user who has lost access to the
+	// board, by leaving its workspace or otherwise, keeps receiving its events.
+	accessCheckPeriod = 15 * time.Second
+)
+
+type delivery struct {
+	bus               pEvents.Bus
+	accessUC          pAccess.Usecase
+	upgrader          websocket.Upgrader
+	accessCheckPeriod time.Duration
+	log               *zap.Logger
+}
+
+func RegisterHandlers(mux *mux.Router, bus pEvents.Bus, accessUC pAccess.Usecase, log *zap.Logger,
+	checkAuth mw.Middleware) {
+	del := delivery{
+		bus:      bus,
+		accessUC: accessUC,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			CheckOrigin:     checkOrigin,
+		},
+		accessCheckPeriod: accessCheckPeriod,
+		log:               log,
+	}
+
+	const (
+		boardEventsPrefix = "/boards/{id}"
+		boardEventsPath   = constants.ApiPrefix + boardEventsPrefix
+		boardWSPath       = boardEventsPath + "/ws"
+		boardSSEPath      = boardEventsPath + "/events"
+	)
+
+	// Streams are not wrapped in metrics: their duration is the lifetime of
+	// the connection and would skew request timings.
+	mux.HandleFunc(boardWSPath, checkAuth(del.ws)).Methods(http.MethodGet)
+	mux.HandleFunc(boardSSEPath, checkAuth(del.sse)).Methods(http.MethodGet)
+}
+
+// ws godoc
+//
+//	@Summary		Board events stream
+//	@Description	Upgrades to WebSocket and streams JSON events on cards and lists of the board. The access to the
+//	@Description	board is checked again periodically, the stream is closed with 1008 once it is lost.
+//	@Tags			boards
+//	@Param			id	path	int	true	"Board ID"
+//	@Success		101	"Switching protocols"
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/boards/{id}/ws [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) ws(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, boardID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := del.bus.Subscribe(ctx, boardID, "")
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	conn, err := del.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade has already replied to the client.
+		del.log.Debug("Failed to upgrade connection", zap.Error(err), zap.Int("board_id", boardID))
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	go del.readPump(conn, cancel)
+	del.writePump(ctx, conn, events, func() error {
+		return del.accessUC.CheckBoard(userID, boardID, pAccess.Read)
+	})
+}
+
+// sse godoc
+//
+//	@Summary		Board events stream (SSE)
+//	@Description	Streams the same events as /boards/{id}/ws as Server-Sent Events. Resumes after Last-Event-ID
+//	@Description	from a bounded per-board buffer; sends board.resync if the event is no longer buffered. The access
+//	@Description	to the board is checked again periodically, the stream ends once it is lost.
+//	@Tags			boards
+//	@Produce		text/event-stream
+//	@Param			id				path	int		true	"Board ID"
+//	@Param			Last-Event-ID	header	string	false	"ID of the last received event"
+//	@Param			last_event_id	query	string	false	"Same as Last-Event-ID, for clients that cannot set headers"
+//	@Success		200
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/boards/{id}/events [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) sse(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, boardID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrEventBus)
+		return
+	}
+
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("last_event_id")
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	events, err := del.bus.Subscribe(ctx, boardID, lastEventID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ticker := time.NewTicker(heartbeatPeriod)
+	defer ticker.Stop()
+	accessTicker := time.NewTicker(del.accessCheckPeriod)
+	defer accessTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			if err := writeSSE(w, &event); err != nil {
+				del.log.Debug("Failed to write event", zap.Error(err), zap.Int("board_id", event.BoardID))
+				return
+			}
+			flusher.Flush()
+		case <-ticker.C:
+			if _, err := io.WriteString(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-accessTicker.C:
+			if err := del.accessUC.CheckBoard(userID, boardID, pAccess.Read); err != nil {
+				del.log.Debug("Board access lost", zap.Error(err), zap.Int("user_id", userID),
+					zap.Int("board_id", boardID))
+				return
+			}
+		}
+	}
+}
+
+// writeSSE writes the event as a single Server-Sent Events frame. Events
+// without ID (board.resync) do not move the client's Last-Event-ID.
+func writeSSE(w io.Writer, event *models.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	var frame bytes.Buffer
+	if event.ID != "" {
+		frame.WriteString("id: " + event.ID + "\n")
+	}
+	frame.WriteString("event: " + event.Type + "\n")
+	frame.WriteString("data: ")
+	frame.Write(data)
+	frame.WriteString("\n\n")
+
+	_, err = w.Write(frame.Bytes())
+	return err
+}
+
+// readPump consumes control frames and cancels ctx once the client is gone.
+// Clients are not expected to send data.
+func (del *delivery) readPump(conn *websocket.Conn, cancel context.CancelFunc) {
+	defer cancel()
+
+	_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+// writePump writes the events until ctx is done. The stream is closed once
+// checkAccess fails.
+func (del *delivery) writePump(ctx context.Context, conn *websocket.Conn, events <-chan models.Event,
+	checkAccess func() error) {
+	ticker := time.NewTicker(pingPeriod)
+	defer ticker.Stop()
+	accessTicker := time.NewTicker(del.accessCheckPeriod)
+	defer accessTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			_ = conn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(writeWait))
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			_ = conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := conn.WriteJSON(&event); err != nil {
+				del.log.Debug("Failed to write event", zap.Error(err), zap.Int("board_id", event.BoardID))
+				return
+			}
+		case <-ticker.C:
+			err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait))
+			if err != nil {
+				return
+			}
+		case <-accessTicker.C:
+			if err := checkAccess(); err != nil {
+				del.log.Debug("Board access lost", zap.Error(err))
+				_ = conn.WriteControl(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "board access lost"),
+					time.Now().Add(writeWait))
+				return
+			}
+		}
+	}
+}
+
+// checkOrigin accepts the same origins as the CORS middleware. Requests
+// without Origin do not come from a browser and are let through.
+func checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	_, allowed := mw.AllowedOrigins[origin]
+	return allowed
+}
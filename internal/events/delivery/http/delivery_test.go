@@ -0,0 +1,87 @@
+package http
+
+import (
+	"context"
+	pAccess "github.com/SlavaShagalov/my-trello-backend/internal/access"
+	accessMocks "github.com/SlavaShagalov/my-trello-backend/internal/access/mocks"
+	eventsMocks "github.com/SlavaShagalov/my-trello-backend/internal/events/mocks"
+	mw "github.com/SlavaShagalov/my-trello-backend/internal/middleware"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	pErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newStreamServer serves handler as user 2 on board 7. The user loses access
+// to the board after the first check.
+func newStreamServer(t *testing.T, handler func(del *delivery) http.HandlerFunc) *httptest.Server {
+	ctrl := gomock.NewController(t)
+
+	accessUC := accessMocks.NewMockUsecase(ctrl)
+	gomock.InOrder(
+		accessUC.EXPECT().CheckBoard(2, 7, pAccess.Read).Return(nil),
+		accessUC.EXPECT().CheckBoard(2, 7, pAccess.Read).Return(pErrors.ErrAccessDenied),
+	)
+	bus := eventsMocks.NewMockBus(ctrl)
+	bus.EXPECT().Subscribe(gomock.Any(), 7, "").Return(make(chan models.Event), nil)
+
+	del := &delivery{
+		bus:               bus,
+		accessUC:          accessUC,
+		accessCheckPeriod: 10 * time.Millisecond,
+		log:               zap.NewNop(),
+	}
+	serve := handler(del)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r = mux.SetURLVars(r, map[string]string{"id": "7"})
+		serve(w, r.WithContext(context.WithValue(r.Context(), mw.ContextUserID, 2)))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestDelivery_SSEAccessLost(t *testing.T) {
+	server := newStreamServer(t, func(del *delivery) http.HandlerFunc { return del.sse })
+
+	client := http.Client{Timeout: time.Second}
+	resp, err := client.Get(server.URL)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// The body ends once the stream is closed, before the client times out.
+	buf := make([]byte, 64)
+	for err == nil {
+		_, err = resp.Body.Read(buf)
+	}
+	assert.False(t, strings.Contains(err.Error(), "Client.Timeout"), "stream not closed: %s", err)
+}
+
+func TestDelivery_WSAccessLost(t *testing.T) {
+	server := newStreamServer(t, func(del *delivery) http.HandlerFunc { return del.ws })
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	_, _, err = conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.ClosePolicyViolation), "unexpected error: %v", err)
+}
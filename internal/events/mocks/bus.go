@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/events/bus.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/SlavaShagalov/my-trello-backend/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBus is a mock of Bus interface.
+type MockBus struct {
+	ctrl     *gomock.Controller
+	recorder *MockBusMockRecorder
+}
+
+// MockBusMockRecorder is the mock recorder for MockBus.
+type MockBusMockRecorder struct {
+	mock *MockBus
+}
+
+// NewMockBus creates a new mock instance.
+func NewMockBus(ctrl *gomock.Controller) *MockBus {
+	mock := &MockBus{ctrl: ctrl}
+	mock.recorder = &MockBusMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBus) EXPECT() *MockBusMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockBus) Publish(event *models.Event) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Publish", event)
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockBusMockRecorder) Publish(event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockBus)(nil).Publish), event)
+}
+
+// Subscribe mocks base method.
+func (m *MockBus) Subscribe(ctx context.Context, boardID int, lastEventID string) (<-chan models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, boardID, lastEventID)
+	ret0, _ := ret[0].(<-chan models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockBusMockRecorder) Subscribe(ctx, boardID, lastEventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockBus)(nil).Subscribe), ctx, boardID, lastEventID)
+}
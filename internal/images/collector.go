@@ -0,0 +1,29 @@
+package images
+
+import "context"
+
+// Report sums up a collection of orphaned images.
+type Report struct {
+	// Scanned is the number of stored avatars, backgrounds, their thumbnails and
+	// attachments.
+	Scanned    int
+	Referenced int
+	// Recent is the number of unreferenced images kept because they are within
+	// the grace period.
+	Recent int
+	// Orphaned are unreferenced images past the grace period. They are deleted
+	// unless it is a dry run.
+	Orphaned   []Object
+	Deleted    int
+	Failed     int
+	FreedBytes int64
+}
+
+type Collector interface {
+	// Run collects orphaned images periodically until ctx is done.
+	Run(ctx context.Context)
+	// Collect deletes avatars and backgrounds, with their thumbnails, that no user
+	// or board refers to anymore, and attachments whose card is deleted. A dry
+	// run only reports them.
+	Collect(ctx context.Context, dryRun bool) (Report, error)
+}
@@ -0,0 +1,123 @@
+package collector
+
+import (
+	"context"
+	"github.com/SlavaShagalov/my-trello-backend/internal/images"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+)
+
+// folders are where avatars, backgrounds and attachments are stored, with the
+// thumbnails each of them gets.
+var folders = []struct {
+	prefix     string
+	thumbnails []images.Thumbnail
+}{
+	{prefix: "avatars/", thumbnails: images.AvatarThumbnails},
+	{prefix: "backgrounds/", thumbnails: images.BackgroundThumbnails},
+	{prefix: "attachments/"},
+}
+
+type collector struct {
+	repo images.Repository
+	refs images.ReferencesRepository
+	log  *zap.Logger
+}
+
+func New(repo images.Repository, refs images.ReferencesRepository, log *zap.Logger) images.Collector {
+	return &collector{
+		repo: repo,
+		refs: refs,
+		log:  log,
+	}
+}
+
+func (c *collector) Run(ctx context.Context) {
+	interval := viper.GetDuration(config.ImagesGCInterval)
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		// Failures are logged, the next run tries again.
+		_, _ = c.Collect(ctx, false)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *collector) Collect(ctx context.Context, dryRun bool) (images.Report, error) {
+	var report images.Report
+
+	// Objects are listed before the references are read, so an image stored in
+	// between is not seen at all. Images are stored before the rows referring to
+	// them are updated, the grace period covers that gap.
+	var objects []images.Object
+	for _, folder := range folders {
+		listed, err := c.repo.List(folder.prefix)
+		if err != nil {
+			return report, err
+		}
+		objects = append(objects, listed...)
+	}
+
+	keys, err := c.refs.ListKeys()
+	if err != nil {
+		return report, err
+	}
+	referenced := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		referenced[key] = struct{}{}
+		for _, folder := range folders {
+			if !strings.HasPrefix(key, folder.prefix) {
+				continue
+			}
+			for _, thumbnail := range folder.thumbnails {
+				referenced[images.ThumbnailKey(key, thumbnail.Name)] = struct{}{}
+			}
+		}
+	}
+
+	deadline := time.Now().Add(-viper.GetDuration(config.ImagesGCGracePeriod))
+	report.Scanned = len(objects)
+	for _, object := range objects {
+		if _, ok := referenced[object.Key]; ok {
+			report.Referenced++
+		} else if object.ModifiedAt.After(deadline) {
+			report.Recent++
+		} else {
+			report.Orphaned = append(report.Orphaned, object)
+		}
+	}
+
+	if !dryRun {
+		for _, object := range report.Orphaned {
+			if ctx.Err() != nil {
+				// The rest is collected next time.
+				break
+			}
+			err = c.repo.Delete(object.Key)
+			if err != nil {
+				report.Failed++
+				continue
+			}
+			report.Deleted++
+			report.FreedBytes += object.Size
+		}
+	}
+
+	c.log.Info("Orphaned images collected", zap.Bool("dry_run", dryRun), zap.Int("scanned", report.Scanned),
+		zap.Int("referenced", report.Referenced), zap.Int("recent", report.Recent),
+		zap.Int("orphaned", len(report.Orphaned)), zap.Int("deleted", report.Deleted),
+		zap.Int("failed", report.Failed), zap.Int64("freed_bytes", report.FreedBytes))
+	return report, ctx.Err()
+}
@@ -0,0 +1,102 @@
+package collector
+
+import (
+	"context"
+	pkgImages "github.com/SlavaShagalov/my-trello-backend/internal/images"
+	"github.com/SlavaShagalov/my-trello-backend/internal/images/mocks"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+func TestCollector_Collect(t *testing.T) {
+	viper.Set(config.ImagesGCGracePeriod, time.Hour)
+	old := time.Now().Add(-2 * time.Hour)
+	recent := time.Now().Add(-time.Minute)
+
+	avatars := []pkgImages.Object{
+		{Key: "avatars/used.jpg", Size: 100, ModifiedAt: old},
+		{Key: "avatars/used_small.jpg", Size: 10, ModifiedAt: old},
+		{Key: "avatars/used_medium.jpg", Size: 20, ModifiedAt: old},
+		{Key: "avatars/replaced.png", Size: 200, ModifiedAt: old},
+		{Key: "avatars/replaced_small.png", Size: 30, ModifiedAt: old},
+		{Key: "avatars/uploading.png", Size: 300, ModifiedAt: recent},
+	}
+	backgrounds := []pkgImages.Object{
+		{Key: "backgrounds/used.gif", Size: 400, ModifiedAt: old},
+		{Key: "backgrounds/used_small.png", Size: 40, ModifiedAt: old},
+		{Key: "backgrounds/deleted.png", Size: 500, ModifiedAt: old},
+	}
+	attachments := []pkgImages.Object{
+		{Key: "attachments/1/used", Size: 600, ModifiedAt: old},
+		{Key: "attachments/2/card_deleted", Size: 700, ModifiedAt: old},
+	}
+	keys := []string{"avatars/used.jpg", "backgrounds/used.gif", "attachments/1/used"}
+	orphaned := []pkgImages.Object{avatars[3], avatars[4], backgrounds[2], attachments[1]}
+
+	t.Run("deletes orphaned", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		refs := mocks.NewMockReferencesRepository(ctrl)
+		repo.EXPECT().List("avatars/").Return(avatars, nil)
+		repo.EXPECT().List("backgrounds/").Return(backgrounds, nil)
+		repo.EXPECT().List("attachments/").Return(attachments, nil)
+		refs.EXPECT().ListKeys().Return(keys, nil)
+		repo.EXPECT().Delete("avatars/replaced.png").Return(nil)
+		repo.EXPECT().Delete("avatars/replaced_small.png").Return(pkgErrors.ErrDb)
+		repo.EXPECT().Delete("backgrounds/deleted.png").Return(nil)
+		repo.EXPECT().Delete("attachments/2/card_deleted").Return(nil)
+
+		report, err := New(repo, refs, zap.NewNop()).Collect(context.Background(), false)
+		require.NoError(t, err)
+		assert.Equal(t, pkgImages.Report{
+			Scanned:    11,
+			Referenced: 6,
+			Recent:     1,
+			Orphaned:   orphaned,
+			Deleted:    3,
+			Failed:     1,
+			FreedBytes: 1400,
+		}, report)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		refs := mocks.NewMockReferencesRepository(ctrl)
+		repo.EXPECT().List("avatars/").Return(avatars, nil)
+		repo.EXPECT().List("backgrounds/").Return(backgrounds, nil)
+		repo.EXPECT().List("attachments/").Return(attachments, nil)
+		refs.EXPECT().ListKeys().Return(keys, nil)
+
+		report, err := New(repo, refs, zap.NewNop()).Collect(context.Background(), true)
+		require.NoError(t, err)
+		assert.Equal(t, orphaned, report.Orphaned)
+		assert.Zero(t, report.Deleted)
+	})
+
+	t.Run("references unavailable", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		refs := mocks.NewMockReferencesRepository(ctrl)
+		repo.EXPECT().List("avatars/").Return(avatars, nil)
+		repo.EXPECT().List("backgrounds/").Return(backgrounds, nil)
+		repo.EXPECT().List("attachments/").Return(attachments, nil)
+		refs.EXPECT().ListKeys().Return(nil, pkgErrors.ErrDb)
+
+		_, err := New(repo, refs, zap.NewNop()).Collect(context.Background(), false)
+		assert.ErrorIs(t, err, pkgErrors.ErrDb)
+	})
+}
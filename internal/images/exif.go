@@ -0,0 +1,122 @@
+package images
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+)
+
+const (
+	jpegApp1 = 0xE1
+	jpegSOS  = 0xDA
+	jpegEOI  = 0xD9
+
+	orientationTag = 0x0112
+)
+
+// jpegOrientation returns the EXIF orientation of a JPEG image, from 1 to 8.
+// Images without it are taken as 1, which needs no transformation.
+func jpegOrientation(data []byte) int {
+	if len(data) < 2 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	// Segments up to the image data are 0xFF, the marker and the big-endian length
+	// that includes the length itself.
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		if marker == jpegSOS || marker == jpegEOI {
+			return 1
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		end := i + 2 + length
+		if length < 2 || end > len(data) {
+			return 1
+		}
+		segment := data[i+4 : end]
+		if marker == jpegApp1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return tiffOrientation(segment[6:])
+		}
+		i = end
+	}
+	return 1
+}
+
+// tiffOrientation looks for the orientation in the first IFD of the EXIF TIFF structure.
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+	count := int(order.Uint16(tiff[ifd:]))
+	for i := 0; i < count; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) != orientationTag {
+			continue
+		}
+		value := int(order.Uint16(tiff[entry+8:]))
+		if value < 1 || value > 8 {
+			return 1
+		}
+		return value
+	}
+	return 1
+}
+
+// orient transforms img so that it looks as its EXIF orientation says.
+func orient(img image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	rect := image.Rect(0, 0, width, height)
+	if orientation >= 5 {
+		rect = image.Rect(0, 0, height, width)
+	}
+
+	oriented := image.NewRGBA(rect)
+	for y := 0; y < rect.Dy(); y++ {
+		for x := 0; x < rect.Dx(); x++ {
+			var srcX, srcY int
+			switch orientation {
+			case 2: // mirrored
+				srcX, srcY = width-1-x, y
+			case 3: // rotated by 180°
+				srcX, srcY = width-1-x, height-1-y
+			case 4: // mirrored vertically
+				srcX, srcY = x, height-1-y
+			case 5: // mirrored and rotated by 270°
+				srcX, srcY = y, x
+			case 6: // rotated by 90° clockwise
+				srcX, srcY = y, height-1-x
+			case 7: // mirrored and rotated by 90°
+				srcX, srcY = width-1-y, height-1-x
+			case 8: // rotated by 90° counterclockwise
+				srcX, srcY = width-1-y, x
+			}
+			oriented.Set(x, y, img.At(bounds.Min.X+srcX, bounds.Min.Y+srcY))
+		}
+	}
+	return oriented
+}
@@ -0,0 +1,107 @@
+package images
+
+import (
+	"bytes"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	_ "golang.org/x/image/webp"
+)
+
+const jpegQuality = 90
+
+// Image is an uploaded avatar or background ready to be stored.
+type Image struct {
+	Data        []byte
+	ContentType string
+	// Ext is the file extension for ContentType.
+	Ext string
+	// Thumbnails are encoded thumbnails by their names.
+	Thumbnails map[string][]byte
+}
+
+// Prepare checks that data is a PNG, JPEG, GIF or WebP image within the configured
+// limits and makes thumbnails of it. The image is re-encoded, which drops EXIF and
+// any other metadata. WebP images are stored as PNG, there is no WebP encoder.
+func Prepare(data []byte, thumbnails []Thumbnail) (Image, error) {
+	if len(data) == 0 {
+		return Image{}, pkgErrors.ErrBadImage
+	}
+	if int64(len(data)) > viper.GetInt64(config.MaxImageSize) {
+		return Image{}, pkgErrors.ErrTooLargeImage
+	}
+
+	contentType := http.DetectContentType(data)
+	switch contentType {
+	case "image/png", "image/jpeg", "image/gif", "image/webp":
+	default:
+		return Image{}, pkgErrors.ErrBadImage
+	}
+
+	// The header is enough to refuse images that would take too much memory to decode.
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return Image{}, errors.Wrap(pkgErrors.ErrBadImage, err.Error())
+	}
+	maxDimension := viper.GetInt(config.MaxImageDimension)
+	if cfg.Width > maxDimension || cfg.Height > maxDimension {
+		return Image{}, pkgErrors.ErrTooLargeImageDimensions
+	}
+
+	var img Image
+	var decoded image.Image
+	var buf bytes.Buffer
+	switch contentType {
+	case "image/jpeg":
+		img.ContentType, img.Ext = "image/jpeg", ".jpg"
+		decoded, err = jpeg.Decode(bytes.NewReader(data))
+		if err == nil {
+			// The orientation is lost with the metadata, so it is applied to the pixels.
+			decoded = orient(decoded, jpegOrientation(data))
+			err = jpeg.Encode(&buf, decoded, &jpeg.Options{Quality: jpegQuality})
+		}
+	case "image/gif":
+		// Animation is kept, thumbnails show the first frame.
+		img.ContentType, img.Ext = "image/gif", ".gif"
+		var animation *gif.GIF
+		animation, err = gif.DecodeAll(bytes.NewReader(data))
+		if err == nil {
+			decoded = animation.Image[0]
+			err = gif.EncodeAll(&buf, animation)
+		}
+	default:
+		img.ContentType, img.Ext = "image/png", ".png"
+		decoded, _, err = image.Decode(bytes.NewReader(data))
+		if err == nil {
+			err = png.Encode(&buf, decoded)
+		}
+	}
+	if err != nil {
+		return Image{}, errors.Wrap(pkgErrors.ErrBadImage, err.Error())
+	}
+	img.Data = buf.Bytes()
+
+	img.Thumbnails = make(map[string][]byte, len(thumbnails))
+	for _, thumbnail := range thumbnails {
+		buf = bytes.Buffer{}
+		resized := resize(decoded, thumbnail.Width, thumbnail.Height)
+		if img.ContentType == "image/jpeg" {
+			err = jpeg.Encode(&buf, resized, &jpeg.Options{Quality: jpegQuality})
+		} else {
+			err = png.Encode(&buf, resized)
+		}
+		if err != nil {
+			return Image{}, errors.Wrap(pkgErrors.ErrBadImage, err.Error())
+		}
+		img.Thumbnails[thumbnail.Name] = buf.Bytes()
+	}
+
+	return img, nil
+}
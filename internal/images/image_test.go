@@ -0,0 +1,192 @@
+package images
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+// webp1x1 is a lossless 1x1 WebP image.
+const webp1x1 = "UklGRhoAAABXRUJQVlA4TA0AAAAvAAAAEAcQERGIiP4HAA=="
+
+func testImage(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 100, A: 255})
+		}
+	}
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+// encodeJPEG encodes img with an EXIF segment holding orientation.
+func encodeJPEG(t *testing.T, img image.Image, orientation uint16) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+	data := buf.Bytes()
+
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08\x00\x01")
+	tiff = binary.BigEndian.AppendUint16(tiff, orientationTag)
+	tiff = append(tiff, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00)
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+
+	exif := []byte{0xFF, jpegApp1}
+	exif = binary.BigEndian.AppendUint16(exif, uint16(len(segment)+2))
+	exif = append(exif, segment...)
+
+	return append(append([]byte{0xFF, 0xD8}, exif...), data[2:]...)
+}
+
+func decodeConfig(t *testing.T, data []byte) image.Config {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	require.NoError(t, err)
+	return cfg
+}
+
+func TestPrepare(t *testing.T) {
+	config.SetDefaultValidationConfig()
+
+	thumbnails := []Thumbnail{{Name: "small", Width: 40, Height: 40}, {Name: "large", Width: 400, Height: 400}}
+
+	t.Run("png", func(t *testing.T) {
+		img, err := Prepare(encodePNG(t, testImage(200, 100)), thumbnails)
+		require.NoError(t, err)
+		assert.Equal(t, "image/png", img.ContentType)
+		assert.Equal(t, ".png", img.Ext)
+
+		cfg := decodeConfig(t, img.Thumbnails["small"])
+		assert.Equal(t, 40, cfg.Width)
+		assert.Equal(t, 20, cfg.Height)
+		// Small images are not upscaled.
+		cfg = decodeConfig(t, img.Thumbnails["large"])
+		assert.Equal(t, 200, cfg.Width)
+		assert.Equal(t, 100, cfg.Height)
+	})
+
+	t.Run("jpeg with exif", func(t *testing.T) {
+		data := encodeJPEG(t, testImage(200, 100), 6)
+		require.Equal(t, 6, jpegOrientation(data))
+
+		img, err := Prepare(data, thumbnails)
+		require.NoError(t, err)
+		assert.Equal(t, "image/jpeg", img.ContentType)
+		assert.Equal(t, ".jpg", img.Ext)
+		assert.False(t, bytes.Contains(img.Data, []byte("Exif")))
+
+		// Rotated by 90°.
+		cfg := decodeConfig(t, img.Data)
+		assert.Equal(t, 100, cfg.Width)
+		assert.Equal(t, 200, cfg.Height)
+		cfg = decodeConfig(t, img.Thumbnails["small"])
+		assert.Equal(t, 20, cfg.Width)
+		assert.Equal(t, 40, cfg.Height)
+	})
+
+	t.Run("animated gif", func(t *testing.T) {
+		palette := color.Palette{color.Black, color.White}
+		frames := &gif.GIF{
+			Image: []*image.Paletted{
+				image.NewPaletted(image.Rect(0, 0, 80, 80), palette),
+				image.NewPaletted(image.Rect(0, 0, 80, 80), palette),
+			},
+			Delay: []int{10, 10},
+		}
+		var buf bytes.Buffer
+		require.NoError(t, gif.EncodeAll(&buf, frames))
+
+		img, err := Prepare(buf.Bytes(), thumbnails)
+		require.NoError(t, err)
+		assert.Equal(t, "image/gif", img.ContentType)
+		animation, err := gif.DecodeAll(bytes.NewReader(img.Data))
+		require.NoError(t, err)
+		assert.Len(t, animation.Image, 2)
+
+		_, format, err := image.DecodeConfig(bytes.NewReader(img.Thumbnails["small"]))
+		require.NoError(t, err)
+		assert.Equal(t, "png", format)
+	})
+
+	t.Run("webp", func(t *testing.T) {
+		data, err := base64.StdEncoding.DecodeString(webp1x1)
+		require.NoError(t, err)
+
+		img, err := Prepare(data, thumbnails)
+		require.NoError(t, err)
+		assert.Equal(t, "image/png", img.ContentType)
+		assert.Equal(t, ".png", img.Ext)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := Prepare(nil, thumbnails)
+		assert.ErrorIs(t, err, pkgErrors.ErrBadImage)
+
+		_, err = Prepare([]byte("<svg xmlns=\"http://www.w3.org/2000/svg\"/>"), thumbnails)
+		assert.ErrorIs(t, err, pkgErrors.ErrBadImage)
+
+		// The PNG signature alone.
+		_, err = Prepare([]byte("\x89PNG\x0D\x0A\x1A\x0A"), thumbnails)
+		assert.ErrorIs(t, err, pkgErrors.ErrBadImage)
+
+		_, err = Prepare(make([]byte, viper.GetInt(config.MaxImageSize)+1), thumbnails)
+		assert.ErrorIs(t, err, pkgErrors.ErrTooLargeImage)
+
+		// Only the header is read, so the image itself can be cheap.
+		wide := image.NewGray(image.Rect(0, 0, viper.GetInt(config.MaxImageDimension)+1, 1))
+		_, err = Prepare(encodePNG(t, wide), thumbnails)
+		assert.ErrorIs(t, err, pkgErrors.ErrTooLargeImageDimensions)
+	})
+}
+
+func TestOrient(t *testing.T) {
+	// 2x1 image: red, green.
+	img := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	red := color.RGBA{R: 255, A: 255}
+	green := color.RGBA{G: 255, A: 255}
+	img.Set(0, 0, red)
+	img.Set(1, 0, green)
+
+	tests := map[int][]color.Color{
+		1: {red, green},
+		2: {green, red},
+		3: {green, red},
+		4: {red, green},
+		5: {red, green},
+		6: {red, green},
+		7: {green, red},
+		8: {green, red},
+	}
+
+	for orientation, pixels := range tests {
+		oriented := orient(img, orientation)
+		bounds := oriented.Bounds()
+		var got []color.Color
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				got = append(got, oriented.At(x, y))
+			}
+		}
+		assert.Equal(t, pixels, got, "orientation %d", orientation)
+		if orientation >= 5 {
+			assert.Equal(t, 1, bounds.Dx(), "orientation %d", orientation)
+		}
+	}
+}
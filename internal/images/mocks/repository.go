@@ -7,6 +7,7 @@ package mocks
 import (
 	reflect "reflect"
 
+	images "github.com/SlavaShagalov/my-trello-backend/internal/images"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -34,59 +35,97 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockRepository) Create(imgName string, imgData []byte) (string, error) {
+func (m *MockRepository) Create(key string, data []byte, contentType string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", imgName, imgData)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Create", key, data, contentType)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockRepositoryMockRecorder) Create(imgName, imgData interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Create(key, data, contentType interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), imgName, imgData)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), key, data, contentType)
 }
 
 // Delete mocks base method.
-func (m *MockRepository) Delete(location string) error {
+func (m *MockRepository) Delete(key string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", location)
+	ret := m.ctrl.Call(m, "Delete", key)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockRepositoryMockRecorder) Delete(location interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Delete(key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), location)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), key)
 }
 
 // Get mocks base method.
-func (m *MockRepository) Get(location string) ([]byte, error) {
+func (m *MockRepository) Get(key string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", location)
+	ret := m.ctrl.Call(m, "Get", key)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockRepositoryMockRecorder) Get(location interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Get(key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRepository)(nil).Get), location)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRepository)(nil).Get), key)
 }
 
-// Update mocks base method.
-func (m *MockRepository) Update(location string, imgData []byte) error {
+// List mocks base method.
+func (m *MockRepository) List(prefix string) ([]images.Object, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", location, imgData)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "List", prefix)
+	ret0, _ := ret[0].([]images.Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRepositoryMockRecorder) List(prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), prefix)
+}
+
+// MockReferencesRepository is a mock of ReferencesRepository interface.
+type MockReferencesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReferencesRepositoryMockRecorder
+}
+
+// MockReferencesRepositoryMockRecorder is the mock recorder for MockReferencesRepository.
+type MockReferencesRepositoryMockRecorder struct {
+	mock *MockReferencesRepository
+}
+
+// NewMockReferencesRepository creates a new mock instance.
+func NewMockReferencesRepository(ctrl *gomock.Controller) *MockReferencesRepository {
+	mock := &MockReferencesRepository{ctrl: ctrl}
+	mock.recorder = &MockReferencesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReferencesRepository) EXPECT() *MockReferencesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListKeys mocks base method.
+func (m *MockReferencesRepository) ListKeys() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKeys")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Update indicates an expected call of Update.
-func (mr *MockRepositoryMockRecorder) Update(location, imgData interface{}) *gomock.Call {
+// ListKeys indicates an expected call of ListKeys.
+func (mr *MockReferencesRepositoryMockRecorder) ListKeys() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), location, imgData)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeys", reflect.TypeOf((*MockReferencesRepository)(nil).ListKeys))
 }
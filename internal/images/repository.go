@@ -1,8 +1,28 @@
 package images
 
+import "time"
+
+// Object is a stored file as listed by Repository.List.
+type Object struct {
+	Key        string
+	Size       int64
+	ModifiedAt time.Time
+}
+
+// Repository stores uploaded files: avatars, board backgrounds and card attachments.
+// Files are addressed by object keys, the public address of a key is given by URL.
 type Repository interface {
-	Create(imgName string, imgData []byte) (location string, err error)
-	Get(location string) (imgData []byte, err error)
-	Update(location string, imgData []byte) (err error)
-	Delete(location string) (err error)
+	// Create stores data under key. An empty contentType leaves it to the storage.
+	Create(key string, data []byte, contentType string) (err error)
+	Get(key string) (data []byte, err error)
+	// Delete does not fail if there is nothing stored under key.
+	Delete(key string) (err error)
+	// List returns all files whose keys start with prefix.
+	List(prefix string) (objects []Object, err error)
+}
+
+// ReferencesRepository tells which stored files are in use.
+type ReferencesRepository interface {
+	// ListKeys returns the keys of all avatars, board backgrounds and attachments.
+	ListKeys() (keys []string, err error)
 }
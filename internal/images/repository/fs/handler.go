@@ -0,0 +1,45 @@
+package fs
+
+import (
+	pImages "github.com/SlavaShagalov/my-trello-backend/internal/images"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+)
+
+// Handler serves the files of images.PublicFolders stored under dir.
+// Directories are not listed.
+func Handler(dir string) http.Handler {
+	files := http.FileServer(filesOnly{http.Dir(dir)})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !pImages.IsPublic(strings.TrimPrefix(path.Clean("/"+r.URL.Path), "/")) {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		files.ServeHTTP(w, r)
+	})
+}
+
+type filesOnly struct {
+	fs http.FileSystem
+}
+
+func (f filesOnly) Open(name string) (http.File, error) {
+	file, err := f.fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := file.Stat()
+	if err == nil && info.IsDir() {
+		err = os.ErrNotExist
+	}
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	return file, nil
+}
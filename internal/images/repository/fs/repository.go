@@ -1 +1,143 @@
 package fs
+
+import (
+	pImages "github.com/SlavaShagalov/my-trello-backend/internal/images"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+var errBadKey = errors.New("key is outside of the images directory")
+
+type repository struct {
+	dir string
+	log *zap.Logger
+}
+
+// New stores files under dir, Handler serves them.
+func New(dir string, log *zap.Logger) pImages.Repository {
+	return &repository{
+		dir: dir,
+		log: log,
+	}
+}
+
+func (repo *repository) Create(key string, data []byte, contentType string) (err error) {
+	repo.log.Debug("Start image creating...")
+
+	filename, err := repo.path(key)
+	if err != nil {
+		repo.log.Error("Failed to create image", zap.Error(err), zap.String("key", key))
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(filename), 0o755)
+	if err == nil {
+		err = os.WriteFile(filename, data, 0o644)
+	}
+	if err != nil {
+		repo.log.Error("Failed to create image", zap.Error(err), zap.String("key", key))
+		return err
+	}
+
+	repo.log.Debug("Image created", zap.String("key", key))
+	return nil
+}
+
+func (repo *repository) Get(key string) (data []byte, err error) {
+	filename, err := repo.path(key)
+	if err != nil {
+		repo.log.Error("Failed to get image", zap.Error(err), zap.String("key", key))
+		return nil, err
+	}
+
+	data, err = os.ReadFile(filename)
+	if err != nil {
+		repo.log.Error("Failed to get image", zap.Error(err), zap.String("key", key))
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (repo *repository) Delete(key string) (err error) {
+	repo.log.Debug("Start image deleting...")
+
+	filename, err := repo.path(key)
+	if err != nil {
+		repo.log.Error("Failed to delete image", zap.Error(err), zap.String("key", key))
+		return err
+	}
+
+	err = os.Remove(filename)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		repo.log.Error("Failed to delete image", zap.Error(err), zap.String("key", key))
+		return err
+	}
+
+	repo.log.Debug("Image deleted", zap.String("key", key))
+	return nil
+}
+
+func (repo *repository) List(prefix string) (objects []pImages.Object, err error) {
+	// Keys are file paths, so only the directory part of prefix is walked.
+	root := repo.dir
+	if dir := prefix[:strings.LastIndex(prefix, "/")+1]; dir != "" {
+		root, err = repo.path(dir)
+		if err != nil {
+			repo.log.Error("Failed to list images", zap.Error(err), zap.String("prefix", prefix))
+			return nil, err
+		}
+	}
+
+	err = filepath.WalkDir(root, func(filename string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(repo.dir, filename)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, pImages.Object{
+			Key:        key,
+			Size:       info.Size(),
+			ModifiedAt: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		repo.log.Error("Failed to list images", zap.Error(err), zap.String("prefix", prefix))
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+// path returns the file name of the image. Keys cannot escape the directory.
+func (repo *repository) path(key string) (string, error) {
+	name := path.Clean("/" + key)
+	if name == "/" {
+		return "", errBadKey
+	}
+	return filepath.Join(repo.dir, filepath.FromSlash(name)), nil
+}
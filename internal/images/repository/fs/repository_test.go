@@ -0,0 +1,113 @@
+package fs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRepository(t *testing.T) {
+	dir := t.TempDir()
+	repo := New(dir, zap.NewNop())
+
+	const key = "avatars/a.png"
+	err := repo.Create(key, []byte("first"), "image/png")
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(dir, "avatars", "a.png"))
+
+	data, err := repo.Get(key)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("first"), data)
+
+	err = repo.Delete(key)
+	require.NoError(t, err)
+	_, err = repo.Get(key)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	// Deleting twice is fine.
+	assert.NoError(t, repo.Delete(key))
+}
+
+func TestRepository_List(t *testing.T) {
+	dir := t.TempDir()
+	repo := New(dir, zap.NewNop())
+
+	for _, key := range []string{"avatars/a.png", "avatars/a_small.png", "backgrounds/b.png", "attachments/c.txt"} {
+		require.NoError(t, repo.Create(key, []byte(key), ""))
+	}
+
+	objects, err := repo.List("avatars/")
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	assert.Equal(t, "avatars/a.png", objects[0].Key)
+	assert.Equal(t, int64(len("avatars/a.png")), objects[0].Size)
+	assert.False(t, objects[0].ModifiedAt.IsZero())
+	assert.Equal(t, "avatars/a_small.png", objects[1].Key)
+
+	objects, err = repo.List("avatars/a_")
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+	assert.Equal(t, "avatars/a_small.png", objects[0].Key)
+
+	objects, err = repo.List("")
+	require.NoError(t, err)
+	assert.Len(t, objects, 4)
+
+	// Nothing was ever stored there.
+	objects, err = repo.List("covers/")
+	require.NoError(t, err)
+	assert.Empty(t, objects)
+}
+
+func TestRepository_OutsideDir(t *testing.T) {
+	parent := t.TempDir()
+	dir := filepath.Join(parent, "images")
+	repo := New(dir, zap.NewNop())
+
+	err := repo.Create("../secret.txt", []byte("data"), "")
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(dir, "secret.txt"))
+	assert.NoFileExists(t, filepath.Join(parent, "secret.txt"))
+
+	assert.ErrorIs(t, repo.Delete(".."), errBadKey)
+}
+
+func TestHandler(t *testing.T) {
+	dir := t.TempDir()
+	repo := New(dir, zap.NewNop())
+	err := repo.Create("backgrounds/b.txt", []byte("background"), "")
+	require.NoError(t, err)
+	err = repo.Create("attachments/1/a", []byte("attachment"), "")
+	require.NoError(t, err)
+
+	handler := http.StripPrefix("/images", Handler(dir))
+
+	tests := map[string]struct {
+		path string
+		code int
+		body string
+	}{
+		"file":      {path: "/images/backgrounds/b.txt", code: http.StatusOK, body: "background"},
+		"directory": {path: "/images/backgrounds/", code: http.StatusNotFound},
+		"missing":   {path: "/images/backgrounds/c.txt", code: http.StatusNotFound},
+		"private":   {path: "/images/attachments/1/a", code: http.StatusNotFound},
+		"escaped":   {path: "/images/backgrounds/../attachments/1/a", code: http.StatusNotFound},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, test.path, nil))
+			assert.Equal(t, test.code, w.Code)
+			if test.body != "" {
+				assert.Equal(t, test.body, w.Body.String())
+				assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"database/sql"
+	pImages "github.com/SlavaShagalov/my-trello-backend/internal/images"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+type repository struct {
+	db  *sql.DB
+	log *zap.Logger
+}
+
+func New(db *sql.DB, log *zap.Logger) pImages.ReferencesRepository {
+	return &repository{db: db, log: log}
+}
+
+const listKeysCmd = `
+	SELECT avatar
+	FROM users
+	WHERE avatar IS NOT NULL
+	UNION ALL
+	SELECT background
+	FROM boards
+	WHERE background IS NOT NULL
+	UNION ALL
+	SELECT object_key
+	FROM attachments;`
+
+func (repo *repository) ListKeys() ([]string, error) {
+	rows, err := repo.db.Query(listKeysCmd)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listKeysCmd))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	keys := []string{}
+	var key string
+	for rows.Next() {
+		err = rows.Scan(&key)
+		if err != nil {
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", listKeysCmd))
+			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+		keys = append(keys, key)
+	}
+	// A partial list would make the collector delete images in use.
+	if err = rows.Err(); err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listKeysCmd))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	return keys, nil
+}
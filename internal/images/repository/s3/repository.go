@@ -5,9 +5,10 @@ import (
 	"context"
 	pImages "github.com/SlavaShagalov/my-trello-backend/internal/images"
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/spf13/viper"
-	"strings"
+	"io"
 
 	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 
@@ -15,63 +16,104 @@ import (
 )
 
 type repository struct {
+	client   *s3.Client
 	uploader *manager.Uploader
 	log      *zap.Logger
 }
 
 func New(s3Client *s3.Client, log *zap.Logger) pImages.Repository {
 	return &repository{
+		client:   s3Client,
 		uploader: manager.NewUploader(s3Client),
 		log:      log,
 	}
 }
 
-func (repo *repository) Create(imgName string, imgData []byte) (location string, err error) {
+func (repo *repository) Create(key string, data []byte, contentType string) (err error) {
 	repo.log.Debug("Start image creating...")
 
 	bucketName := viper.GetString(config.S3BucketName)
-	output, err := repo.uploader.Upload(context.TODO(), &s3.PutObjectInput{
+	input := &s3.PutObjectInput{
 		Bucket: &bucketName,
-		Key:    &imgName,
-		Body:   bytes.NewReader(imgData),
-	})
+		Key:    &key,
+		Body:   bytes.NewReader(data),
+	}
+	if contentType != "" {
+		input.ContentType = &contentType
+	}
+
+	_, err = repo.uploader.Upload(context.TODO(), input)
 	if err != nil {
-		repo.log.Error("Failed to create image", zap.Error(err))
-		return "", err
+		repo.log.Error("Failed to create image", zap.Error(err), zap.String("key", key))
+		return err
 	}
 
-	repo.log.Debug("Image created", zap.String("location", output.Location))
-	return output.Location, nil
+	repo.log.Debug("Image created", zap.String("key", key))
+	return nil
 }
 
-func (repo *repository) Get(location string) (imgData []byte, err error) {
-	return nil, nil
-}
+func (repo *repository) Get(key string) (data []byte, err error) {
+	bucketName := viper.GetString(config.S3BucketName)
+	output, err := repo.client.GetObject(context.TODO(), &s3.GetObjectInput{
+		Bucket: &bucketName,
+		Key:    &key,
+	})
+	if err != nil {
+		repo.log.Error("Failed to get image", zap.Error(err), zap.String("key", key))
+		return nil, err
+	}
+	defer func() {
+		_ = output.Body.Close()
+	}()
 
-func (repo *repository) Update(location string, imgData []byte) (err error) {
-	repo.log.Debug("Start image updating...")
+	data, err = io.ReadAll(output.Body)
+	if err != nil {
+		repo.log.Error("Failed to get image", zap.Error(err), zap.String("key", key))
+		return nil, err
+	}
 
-	bucketName := viper.GetString(config.S3BucketName)
+	return data, nil
+}
 
-	prefixS := "https://" + bucketName + ".hb.vkcs.cloud/"
-	prefix := "http://" + bucketName + ".hb.vkcs.cloud/"
-	imgName := strings.TrimPrefix(location, prefixS)
-	imgName = strings.TrimPrefix(imgName, prefix)
+func (repo *repository) Delete(key string) (err error) {
+	repo.log.Debug("Start image deleting...")
 
-	output, err := repo.uploader.Upload(context.TODO(), &s3.PutObjectInput{
+	bucketName := viper.GetString(config.S3BucketName)
+	_, err = repo.client.DeleteObject(context.TODO(), &s3.DeleteObjectInput{
 		Bucket: &bucketName,
-		Key:    &imgName,
-		Body:   bytes.NewReader(imgData),
+		Key:    &key,
 	})
 	if err != nil {
-		repo.log.Error("Failed to update image", zap.Error(err))
+		repo.log.Error("Failed to delete image", zap.Error(err), zap.String("key", key))
 		return err
 	}
 
-	repo.log.Debug("Image updated", zap.String("location", output.Location))
+	repo.log.Debug("Image deleted", zap.String("key", key))
 	return nil
 }
 
-func (repo *repository) Delete(location string) (err error) {
-	return nil
+func (repo *repository) List(prefix string) (objects []pImages.Object, err error) {
+	bucketName := viper.GetString(config.S3BucketName)
+	paginator := s3.NewListObjectsV2Paginator(repo.client, &s3.ListObjectsV2Input{
+		Bucket: &bucketName,
+		Prefix: &prefix,
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			repo.log.Error("Failed to list images", zap.Error(err), zap.String("prefix", prefix))
+			return nil, err
+		}
+
+		for _, object := range page.Contents {
+			objects = append(objects, pImages.Object{
+				Key:        aws.ToString(object.Key),
+				Size:       object.Size,
+				ModifiedAt: aws.ToTime(object.LastModified),
+			})
+		}
+	}
+
+	return objects, nil
 }
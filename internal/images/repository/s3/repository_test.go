@@ -0,0 +1,150 @@
+package s3
+
+import (
+	"fmt"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeS3 is a path-style object storage keeping objects in memory.
+type fakeS3 struct {
+	mu           sync.Mutex
+	objects      map[string][]byte
+	contentTypes map[string]string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.objects[r.URL.Path] = data
+		f.contentTypes[r.URL.Path] = r.Header.Get("Content-Type")
+	case http.MethodGet:
+		if r.URL.Query().Get("list-type") == "2" {
+			f.list(w, r)
+			return
+		}
+		data, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<Error><Code>NoSuchKey</Code></Error>`))
+			return
+		}
+		_, _ = w.Write(data)
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// list answers ListObjectsV2 with one object per page to exercise pagination.
+func (f *fakeS3) list(w http.ResponseWriter, r *http.Request) {
+	bucket := "/" + strings.Trim(r.URL.Path, "/") + "/"
+	prefix := r.URL.Query().Get("prefix")
+	after := r.URL.Query().Get("continuation-token")
+
+	var keys []string
+	for name := range f.objects {
+		key := strings.TrimPrefix(name, bucket)
+		if strings.HasPrefix(key, prefix) && key > after {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	result := `<ListBucketResult>`
+	if len(keys) > 0 {
+		result += fmt.Sprintf(`<Contents><Key>%s</Key><Size>%d</Size><LastModified>2024-01-02T03:04:05.000Z</LastModified></Contents>`,
+			keys[0], len(f.objects[bucket+keys[0]]))
+	}
+	if len(keys) > 1 {
+		result += fmt.Sprintf(`<IsTruncated>true</IsTruncated><NextContinuationToken>%s</NextContinuationToken>`, keys[0])
+	}
+	result += `</ListBucketResult>`
+	_, _ = w.Write([]byte(result))
+}
+
+func TestRepository(t *testing.T) {
+	storage := &fakeS3{objects: map[string][]byte{}, contentTypes: map[string]string{}}
+	server := httptest.NewServer(storage)
+	defer server.Close()
+
+	viper.Set(config.S3BucketName, "trello")
+	client := s3.New(s3.Options{
+		Region:           "ru-msk",
+		Credentials:      aws.AnonymousCredentials{},
+		EndpointResolver: s3.EndpointResolverFromURL(server.URL),
+		UsePathStyle:     true,
+	})
+	repo := New(client, zap.NewNop())
+
+	const key = "avatars/a.png"
+	err := repo.Create(key, []byte("avatar"), "image/png")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("avatar"), storage.objects["/trello/"+key])
+	assert.Equal(t, "image/png", storage.contentTypes["/trello/"+key])
+
+	data, err := repo.Get(key)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("avatar"), data)
+
+	err = repo.Delete(key)
+	require.NoError(t, err)
+	assert.Empty(t, storage.objects)
+
+	_, err = repo.Get(key)
+	assert.Error(t, err)
+}
+
+func TestRepository_List(t *testing.T) {
+	storage := &fakeS3{objects: map[string][]byte{}, contentTypes: map[string]string{}}
+	server := httptest.NewServer(storage)
+	defer server.Close()
+
+	viper.Set(config.S3BucketName, "trello")
+	client := s3.New(s3.Options{
+		Region:           "ru-msk",
+		Credentials:      aws.AnonymousCredentials{},
+		EndpointResolver: s3.EndpointResolverFromURL(server.URL),
+		UsePathStyle:     true,
+	})
+	repo := New(client, zap.NewNop())
+
+	for _, key := range []string{"avatars/a.png", "avatars/a_small.png", "backgrounds/b.png"} {
+		require.NoError(t, repo.Create(key, []byte(key), "image/png"))
+	}
+
+	objects, err := repo.List("avatars/")
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	assert.Equal(t, "avatars/a.png", objects[0].Key)
+	assert.Equal(t, int64(len("avatars/a.png")), objects[0].Size)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), objects[0].ModifiedAt)
+	assert.Equal(t, "avatars/a_small.png", objects[1].Key)
+
+	objects, err = repo.List("covers/")
+	require.NoError(t, err)
+	assert.Empty(t, objects)
+}
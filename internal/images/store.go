@@ -0,0 +1,38 @@
+package images
+
+// Store saves img under key and its thumbnails next to it. Nothing is left
+// behind if any of them fails.
+func Store(repo Repository, key string, img *Image) error {
+	err := repo.Create(key, img.Data, img.ContentType)
+	if err != nil {
+		return err
+	}
+
+	stored := []string{key}
+	for name, data := range img.Thumbnails {
+		thumbnailKey := ThumbnailKey(key, name)
+		err = repo.Create(thumbnailKey, data, thumbnailContentType(thumbnailKey))
+		if err != nil {
+			for _, storedKey := range stored {
+				_ = repo.Delete(storedKey)
+			}
+			return err
+		}
+		stored = append(stored, thumbnailKey)
+	}
+
+	return nil
+}
+
+// Remove deletes the image stored under key with its thumbnails. It tries all
+// of them and returns the first error.
+func Remove(repo Repository, key string, thumbnails []Thumbnail) error {
+	err := repo.Delete(key)
+	for _, thumbnail := range thumbnails {
+		thumbnailErr := repo.Delete(ThumbnailKey(key, thumbnail.Name))
+		if err == nil {
+			err = thumbnailErr
+		}
+	}
+	return err
+}
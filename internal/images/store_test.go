@@ -0,0 +1,39 @@
+package images_test
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/images"
+	"github.com/SlavaShagalov/my-trello-backend/internal/images/mocks"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestStoreAndRemove(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockRepository(ctrl)
+	img := &images.Image{
+		Data:        []byte("original"),
+		ContentType: "image/jpeg",
+		Ext:         ".jpg",
+		Thumbnails:  map[string][]byte{"small": []byte("small")},
+	}
+
+	repo.EXPECT().Create("avatars/a.jpg", []byte("original"), "image/jpeg").Return(nil)
+	repo.EXPECT().Create("avatars/a_small.jpg", []byte("small"), "image/jpeg").Return(nil)
+	require.NoError(t, images.Store(repo, "avatars/a.jpg", img))
+
+	// A failed thumbnail takes the original with it.
+	repo.EXPECT().Create("avatars/b.jpg", []byte("original"), "image/jpeg").Return(nil)
+	repo.EXPECT().Create("avatars/b_small.jpg", []byte("small"), "image/jpeg").Return(pkgErrors.ErrDb)
+	repo.EXPECT().Delete("avatars/b.jpg").Return(nil)
+	assert.ErrorIs(t, images.Store(repo, "avatars/b.jpg", img), pkgErrors.ErrDb)
+
+	// All files are tried.
+	repo.EXPECT().Delete("avatars/a.gif").Return(pkgErrors.ErrDb)
+	repo.EXPECT().Delete("avatars/a_small.png").Return(nil)
+	assert.ErrorIs(t, images.Remove(repo, "avatars/a.gif", []images.Thumbnail{{Name: "small"}}), pkgErrors.ErrDb)
+}
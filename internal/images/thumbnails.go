@@ -0,0 +1,61 @@
+package images
+
+import (
+	"golang.org/x/image/draw"
+	"image"
+	"math"
+	"path"
+	"strings"
+)
+
+// Thumbnail is a downscaled copy of an image stored next to it. The image is
+// fitted into Width x Height keeping its proportions and is never upscaled.
+type Thumbnail struct {
+	Name   string
+	Width  int
+	Height int
+}
+
+var (
+	AvatarThumbnails = []Thumbnail{
+		{Name: "small", Width: 64, Height: 64},
+		{Name: "medium", Width: 256, Height: 256},
+	}
+
+	BackgroundThumbnails = []Thumbnail{
+		{Name: "small", Width: 320, Height: 180},
+		{Name: "medium", Width: 1280, Height: 720},
+	}
+)
+
+// ThumbnailKey returns the key of the thumbnail of the image stored under key.
+// Thumbnails of JPEG images are JPEG, others are PNG.
+func ThumbnailKey(key, name string) string {
+	ext := path.Ext(key)
+	thumbnailExt := ".png"
+	if ext == ".jpg" {
+		thumbnailExt = ".jpg"
+	}
+	return strings.TrimSuffix(key, ext) + "_" + name + thumbnailExt
+}
+
+func thumbnailContentType(key string) string {
+	if path.Ext(key) == ".jpg" {
+		return "image/jpeg"
+	}
+	return "image/png"
+}
+
+func resize(img image.Image, maxWidth, maxHeight int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width > maxWidth || height > maxHeight {
+		scale := math.Min(float64(maxWidth)/float64(width), float64(maxHeight)/float64(height))
+		width = int(math.Max(1, math.Round(float64(width)*scale)))
+		height = int(math.Max(1, math.Round(float64(height)*scale)))
+	}
+
+	resized := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(resized, resized.Bounds(), img, bounds, draw.Src, nil)
+	return resized
+}
@@ -0,0 +1,48 @@
+package images
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	"github.com/spf13/viper"
+	"strings"
+)
+
+// PublicFolders hold the files served at their URL. Attachments are stored
+// next to them but are only downloaded through the API.
+var PublicFolders = []string{"avatars/", "backgrounds/"}
+
+// IsPublic reports whether the file stored under key is served at its URL.
+func IsPublic(key string) bool {
+	for _, folder := range PublicFolders {
+		if strings.HasPrefix(key, folder) {
+			return true
+		}
+	}
+	return false
+}
+
+// URL returns the public address of the file stored under key.
+func URL(key string) string {
+	return strings.TrimSuffix(viper.GetString(config.ImagesURL), "/") + "/" + key
+}
+
+// OptionalURL is URL for a key that may be unset.
+func OptionalURL(key *string) *string {
+	if key == nil {
+		return nil
+	}
+	url := URL(*key)
+	return &url
+}
+
+// ThumbnailURLs returns the public addresses of the thumbnails of the image stored
+// under key by their names.
+func ThumbnailURLs(key *string, thumbnails []Thumbnail) map[string]string {
+	if key == nil {
+		return nil
+	}
+	urls := make(map[string]string, len(thumbnails))
+	for _, thumbnail := range thumbnails {
+		urls[thumbnail.Name] = URL(ThumbnailKey(*key, thumbnail.Name))
+	}
+	return urls
+}
@@ -0,0 +1,289 @@
+package http
+
+import (
+	pAccess "github.com/SlavaShagalov/my-trello-backend/internal/access"
+	pInvitations "github.com/SlavaShagalov/my-trello-backend/internal/invitations"
+	mw "github.com/SlavaShagalov/my-trello-backend/internal/middleware"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pHTTP "github.com/SlavaShagalov/my-trello-backend/internal/pkg/http"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+type delivery struct {
+	uc       pInvitations.Usecase
+	accessUC pAccess.Usecase
+	log      *zap.Logger
+}
+
+func RegisterHandlers(mux *mux.Router, uc pInvitations.Usecase, accessUC pAccess.Usecase, log *zap.Logger,
+	checkAuth mw.Middleware, metrics mw.Middleware) {
+	del := delivery{
+		uc:       uc,
+		accessUC: accessUC,
+		log:      log,
+	}
+
+	const (
+		workspaceInvitationsPrefix = "/workspaces/{id}/invitations"
+		workspaceInvitationsPath   = constants.ApiPrefix + workspaceInvitationsPrefix
+		workspaceInvitationPath    = workspaceInvitationsPath + "/{invitation_id}"
+
+		invitationsPrefix = "/invitations"
+		invitationsPath   = constants.ApiPrefix + invitationsPrefix
+		acceptPath        = invitationsPath + "/{token}/accept"
+		declinePath       = invitationsPath + "/{token}/decline"
+	)
+
+	mux.HandleFunc(workspaceInvitationsPath, metrics(checkAuth(del.create))).Methods(http.MethodPost)
+	mux.HandleFunc(workspaceInvitationsPath, metrics(checkAuth(del.listPending))).Methods(http.MethodGet)
+	mux.HandleFunc(workspaceInvitationPath, metrics(checkAuth(del.revoke))).Methods(http.MethodDelete)
+
+	mux.HandleFunc(acceptPath, metrics(checkAuth(del.accept))).Methods(http.MethodPost)
+	mux.HandleFunc(declinePath, metrics(checkAuth(del.decline))).Methods(http.MethodPost)
+}
+
+// create godoc
+//
+//	@Summary		Invite user to workspace
+//	@Description	Invite user to workspace by username or email. The token is delivered to the invitee only.
+//	@Tags			workspaces
+//	@Accept			json
+//	@Produce		json
+//	@Param			id					path		int				true	"Workspace ID"
+//	@Param			InvitationData		body		createRequest	true	"Invitation data"
+//	@Success		200					{object}	getResponse		"Created invitation data."
+//	@Failure		400					{object}	http.JSONError
+//	@Failure		401					{object}	http.JSONError
+//	@Failure		403					{object}	http.JSONError
+//	@Failure		404					{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/workspaces/{id}/invitations [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) create(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	workspaceID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckWorkspace(userID, workspaceID, pAccess.Manage)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	body, err := pHTTP.ReadBody(r, del.log)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	var request createRequest
+	err = request.UnmarshalJSON(body)
+	if err != nil {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	params := pInvitations.InviteParams{
+		WorkspaceID: workspaceID,
+		InviterID:   userID,
+		Invitee:     request.Invitee,
+		Role:        request.Role,
+	}
+
+	invitation, err := del.uc.Create(&params)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newGetResponse(&invitation)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// listPending godoc
+//
+//	@Summary		Returns pending invitations of workspace
+//	@Description	Returns pending invitations of workspace
+//	@Tags			workspaces
+//	@Produce		json
+//	@Param			id	path		int				true	"Workspace ID"
+//	@Success		200	{object}	listResponse	"Invitations data"
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/workspaces/{id}/invitations [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) listPending(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	workspaceID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckWorkspace(userID, workspaceID, pAccess.Manage)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	invitations, err := del.uc.ListPending(workspaceID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newListResponse(invitations)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// revoke godoc
+//
+//	@Summary		Revoke invitation
+//	@Description	Revoke pending invitation
+//	@Tags			workspaces
+//	@Produce		json
+//	@Param			id				path	int	true	"Workspace ID"
+//	@Param			invitation_id	path	int	true	"Invitation ID"
+//	@Success		204				"Invitation revoked successfully"
+//	@Failure		400				{object}	http.JSONError
+//	@Failure		401				{object}	http.JSONError
+//	@Failure		403				{object}	http.JSONError
+//	@Failure		404				{object}	http.JSONError
+//	@Failure		409				{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/workspaces/{id}/invitations/{invitation_id} [delete]
+//
+//	@Security		cookieAuth
+func (del *delivery) revoke(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	workspaceID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	invitationID, err := strconv.Atoi(vars["invitation_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckWorkspace(userID, workspaceID, pAccess.Manage)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	err = del.uc.Revoke(workspaceID, invitationID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// accept godoc
+//
+//	@Summary		Accept invitation
+//	@Description	Accept invitation and join the workspace with the offered role
+//	@Tags			invitations
+//	@Produce		json
+//	@Param			token	path		string			true	"Invitation token"
+//	@Success		200		{object}	acceptResponse	"Membership data."
+//	@Failure		401		{object}	http.JSONError
+//	@Failure		403		{object}	http.JSONError
+//	@Failure		404		{object}	http.JSONError
+//	@Failure		409		{object}	http.JSONError
+//	@Failure		410		{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/invitations/{token}/accept [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) accept(w http.ResponseWriter, r *http.Request) {
+	token := mux.Vars(r)["token"]
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	member, err := del.uc.Accept(userID, token)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newAcceptResponse(&member)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// decline godoc
+//
+//	@Summary		Decline invitation
+//	@Description	Decline invitation
+//	@Tags			invitations
+//	@Produce		json
+//	@Param			token	path	string	true	"Invitation token"
+//	@Success		204		"Invitation declined successfully"
+//	@Failure		401		{object}	http.JSONError
+//	@Failure		403		{object}	http.JSONError
+//	@Failure		404		{object}	http.JSONError
+//	@Failure		409		{object}	http.JSONError
+//	@Failure		410		{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/invitations/{token}/decline [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) decline(w http.ResponseWriter, r *http.Request) {
+	token := mux.Vars(r)["token"]
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err := del.uc.Decline(userID, token)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,65 @@
+package http
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"time"
+)
+
+//go:generate easyjson -all -snake_case models.go
+
+// API requests
+type createRequest struct {
+	Invitee string `json:"invitee"`
+	Role    string `json:"role"`
+}
+
+// API responses
+type listResponse struct {
+	Invitations []models.Invitation `json:"invitations"`
+}
+
+func newListResponse(invitations []models.Invitation) *listResponse {
+	return &listResponse{
+		Invitations: invitations,
+	}
+}
+
+type getResponse struct {
+	ID          int       `json:"id"`
+	WorkspaceID int       `json:"workspace_id"`
+	InviterID   int       `json:"inviter_id"`
+	Invitee     string    `json:"invitee"`
+	Role        string    `json:"role"`
+	Status      string    `json:"status"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func newGetResponse(invitation *models.Invitation) *getResponse {
+	return &getResponse{
+		ID:          invitation.ID,
+		WorkspaceID: invitation.WorkspaceID,
+		InviterID:   invitation.InviterID,
+		Invitee:     invitation.Invitee,
+		Role:        invitation.Role,
+		Status:      invitation.Status,
+		ExpiresAt:   invitation.ExpiresAt,
+		CreatedAt:   invitation.CreatedAt,
+		UpdatedAt:   invitation.UpdatedAt,
+	}
+}
+
+type acceptResponse struct {
+	WorkspaceID int    `json:"workspace_id"`
+	UserID      int    `json:"user_id"`
+	Role        string `json:"role"`
+}
+
+func newAcceptResponse(member *models.Member) *acceptResponse {
+	return &acceptResponse{
+		WorkspaceID: member.WorkspaceID,
+		UserID:      member.UserID,
+		Role:        member.Role,
+	}
+}
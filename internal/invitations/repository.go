@@ -0,0 +1,28 @@
+package invitations
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"time"
+)
+
+type CreateParams struct {
+	WorkspaceID int
+	InviterID   int
+	Invitee     string
+	Role        string
+	Token       string
+	ExpiresAt   time.Time
+}
+
+type Repository interface {
+	Create(params *CreateParams) (models.Invitation, error)
+	ListPending(workspaceID int) ([]models.Invitation, error)
+	Get(id int) (models.Invitation, error)
+	GetByToken(token string) (models.Invitation, error)
+	// UpdateStatus moves a pending invitation to status. It fails with
+	// ErrInvitationNotPending if the invitation was answered or revoked already.
+	UpdateStatus(id int, status string) (models.Invitation, error)
+	// Accept marks a pending invitation accepted and adds userID to its
+	// workspace in one transaction: if either fails, neither happens.
+	Accept(id, userID int) (models.Member, error)
+}
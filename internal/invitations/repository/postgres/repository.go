@@ -0,0 +1,264 @@
+package postgres
+
+import (
+	"database/sql"
+	"github.com/SlavaShagalov/my-trello-backend/internal/images"
+	pkgInvitations "github.com/SlavaShagalov/my-trello-backend/internal/invitations"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+type repository struct {
+	db  *sql.DB
+	log *zap.Logger
+}
+
+func New(db *sql.DB, log *zap.Logger) pkgInvitations.Repository {
+	return &repository{db: db, log: log}
+}
+
+const createCmd = `
+	INSERT INTO invitations (workspace_id, inviter_id, invitee, role, token, expires_at)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING id, workspace_id, inviter_id, invitee, role, token, status, expires_at, created_at, updated_at;`
+
+func (repo *repository) Create(params *pkgInvitations.CreateParams) (models.Invitation, error) {
+	row := repo.db.QueryRow(createCmd, params.WorkspaceID, params.InviterID, params.Invitee, params.Role,
+		params.Token, params.ExpiresAt)
+
+	var invitation models.Invitation
+	err := scanInvitation(row, &invitation)
+	if err != nil {
+		pgErr, ok := err.(*pq.Error)
+		if !ok {
+			repo.log.Error("Cannot convert err to pq.Error", zap.Error(err))
+			return models.Invitation{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+		if pgErr.Constraint == "invitations_workspace_id_fkey" {
+			return models.Invitation{}, errors.Wrap(pkgErrors.ErrWorkspaceNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", createCmd),
+			zap.Int("workspace_id", params.WorkspaceID), zap.String("invitee", params.Invitee))
+		return models.Invitation{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("New invitation created", zap.Int("id", invitation.ID),
+		zap.Int("workspace_id", invitation.WorkspaceID))
+	return invitation, nil
+}
+
+const listPendingCmd = `
+	SELECT id, workspace_id, inviter_id, invitee, role, token, status, expires_at, created_at, updated_at
+	FROM invitations
+	WHERE workspace_id = $1 AND status = 'pending'
+	ORDER BY created_at, id;`
+
+func (repo *repository) ListPending(workspaceID int) ([]models.Invitation, error) {
+	rows, err := repo.db.Query(listPendingCmd, workspaceID)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listPendingCmd),
+			zap.Int("workspace_id", workspaceID))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	invitations := []models.Invitation{}
+	var invitation models.Invitation
+	for rows.Next() {
+		err = rows.Scan(
+			&invitation.ID,
+			&invitation.WorkspaceID,
+			&invitation.InviterID,
+			&invitation.Invitee,
+			&invitation.Role,
+			&invitation.Token,
+			&invitation.Status,
+			&invitation.ExpiresAt,
+			&invitation.CreatedAt,
+			&invitation.UpdatedAt,
+		)
+		if err != nil {
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", listPendingCmd),
+				zap.Int("workspace_id", workspaceID))
+			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+		invitations = append(invitations, invitation)
+	}
+
+	return invitations, nil
+}
+
+const getCmd = `
+	SELECT id, workspace_id, inviter_id, invitee, role, token, status, expires_at, created_at, updated_at
+	FROM invitations
+	WHERE id = $1;`
+
+func (repo *repository) Get(id int) (models.Invitation, error) {
+	row := repo.db.QueryRow(getCmd, id)
+
+	var invitation models.Invitation
+	err := scanInvitation(row, &invitation)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Invitation{}, errors.Wrap(pkgErrors.ErrInvitationNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", getCmd),
+			zap.Int("id", id))
+		return models.Invitation{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	return invitation, nil
+}
+
+const getByTokenCmd = `
+	SELECT id, workspace_id, inviter_id, invitee, role, token, status, expires_at, created_at, updated_at
+	FROM invitations
+	WHERE token = $1;`
+
+func (repo *repository) GetByToken(token string) (models.Invitation, error) {
+	row := repo.db.QueryRow(getByTokenCmd, token)
+
+	var invitation models.Invitation
+	err := scanInvitation(row, &invitation)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Invitation{}, errors.Wrap(pkgErrors.ErrInvitationNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", getByTokenCmd))
+		return models.Invitation{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	return invitation, nil
+}
+
+const updateStatusCmd = `
+	UPDATE invitations
+	SET status     = $1,
+		updated_at = now()
+	WHERE id = $2 AND status = 'pending'
+	RETURNING id, workspace_id, inviter_id, invitee, role, token, status, expires_at, created_at, updated_at;`
+
+func (repo *repository) UpdateStatus(id int, status string) (models.Invitation, error) {
+	row := repo.db.QueryRow(updateStatusCmd, status, id)
+
+	var invitation models.Invitation
+	err := scanInvitation(row, &invitation)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Invitation{}, errors.Wrap(pkgErrors.ErrInvitationNotPending, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", updateStatusCmd),
+			zap.Int("id", id), zap.String("status", status))
+		return models.Invitation{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("Invitation status updated", zap.Int("id", id), zap.String("status", status))
+	return invitation, nil
+}
+
+const acceptCmd = `
+	UPDATE invitations
+	SET status     = 'accepted',
+		updated_at = now()
+	WHERE id = $1 AND status = 'pending'
+	RETURNING workspace_id, role;`
+
+const addMemberCmd = `
+	WITH m AS (
+		INSERT INTO workspace_members (workspace_id, user_id, role)
+		VALUES ($1, $2, $3)
+		RETURNING workspace_id, user_id, role, created_at, updated_at
+	)
+	SELECT m.workspace_id, m.user_id, u.username, u.name, u.avatar, m.role, m.created_at, m.updated_at
+	FROM m
+	JOIN users u on u.id = m.user_id;`
+
+func (repo *repository) Accept(id, userID int) (models.Member, error) {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Int("id", id), zap.Int("user_id", userID))
+		return models.Member{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var workspaceID int
+	var role string
+	err = tx.QueryRow(acceptCmd, id).Scan(&workspaceID, &role)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Member{}, errors.Wrap(pkgErrors.ErrInvitationNotPending, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", acceptCmd),
+			zap.Int("id", id))
+		return models.Member{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	var member models.Member
+	avatar := new(sql.NullString)
+	err = tx.QueryRow(addMemberCmd, workspaceID, userID, role).Scan(
+		&member.WorkspaceID,
+		&member.UserID,
+		&member.Username,
+		&member.Name,
+		avatar,
+		&member.Role,
+		&member.CreatedAt,
+		&member.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pq.Error
+		if errors.As(err, &pgErr) {
+			switch pgErr.Constraint {
+			case "workspace_members_user_id_fkey":
+				return models.Member{}, errors.Wrap(pkgErrors.ErrUserNotFound, err.Error())
+			case "workspace_members_pkey":
+				return models.Member{}, errors.Wrap(pkgErrors.ErrMemberAlreadyExists, err.Error())
+			}
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", addMemberCmd),
+			zap.Int("id", id), zap.Int("user_id", userID))
+		return models.Member{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	if avatar.Valid {
+		url := images.URL(avatar.String)
+		member.Avatar = &url
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Int("id", id), zap.Int("user_id", userID))
+		return models.Member{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("Invitation accepted", zap.Int("id", id), zap.Any("member", member))
+	return member, nil
+}
+
+func scanInvitation(row *sql.Row, invitation *models.Invitation) error {
+	return row.Scan(
+		&invitation.ID,
+		&invitation.WorkspaceID,
+		&invitation.InviterID,
+		&invitation.Invitee,
+		&invitation.Role,
+		&invitation.Token,
+		&invitation.Status,
+		&invitation.ExpiresAt,
+		&invitation.CreatedAt,
+		&invitation.UpdatedAt,
+	)
+}
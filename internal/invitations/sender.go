@@ -0,0 +1,8 @@
+package invitations
+
+import "github.com/SlavaShagalov/my-trello-backend/internal/models"
+
+// Sender delivers an invitation with its token to the invitee.
+type Sender interface {
+	Send(invitation *models.Invitation) error
+}
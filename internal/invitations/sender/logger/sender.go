@@ -0,0 +1,30 @@
+package logger
+
+import (
+	pkgInvitations "github.com/SlavaShagalov/my-trello-backend/internal/invitations"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	"go.uber.org/zap"
+)
+
+// sender writes invitations to the log instead of mailing them. It stands in
+// for a real mail transport in local and test environments.
+type sender struct {
+	log *zap.Logger
+}
+
+func New(log *zap.Logger) pkgInvitations.Sender {
+	return &sender{log: log}
+}
+
+func (s *sender) Send(invitation *models.Invitation) error {
+	s.log.Info("Invitation sent",
+		zap.String("invitee", invitation.Invitee),
+		zap.Int("workspace_id", invitation.WorkspaceID),
+		zap.String("role", invitation.Role),
+		zap.String("accept_url", constants.ApiPrefix+"/invitations/"+invitation.Token+"/accept"),
+		zap.String("decline_url", constants.ApiPrefix+"/invitations/"+invitation.Token+"/decline"),
+		zap.Time("expires_at", invitation.ExpiresAt),
+	)
+	return nil
+}
@@ -0,0 +1,18 @@
+package invitations
+
+import "github.com/SlavaShagalov/my-trello-backend/internal/models"
+
+type InviteParams struct {
+	WorkspaceID int
+	InviterID   int
+	Invitee     string
+	Role        string
+}
+
+type Usecase interface {
+	Create(params *InviteParams) (models.Invitation, error)
+	ListPending(workspaceID int) ([]models.Invitation, error)
+	Revoke(workspaceID, invitationID int) error
+	Accept(userID int, token string) (models.Member, error)
+	Decline(userID int, token string) error
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/SlavaShagalov/my-trello-backend/internal/invitations"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/SlavaShagalov/my-trello-backend/internal/users"
+	"github.com/pkg/errors"
+	"strings"
+	"time"
+)
+
+type usecase struct {
+	repo      invitations.Repository
+	sender    invitations.Sender
+	usersRepo users.Repository
+}
+
+func New(repo invitations.Repository, sender invitations.Sender, usersRepo users.Repository) invitations.Usecase {
+	return &usecase{
+		repo:      repo,
+		sender:    sender,
+		usersRepo: usersRepo,
+	}
+}
+
+func (uc *usecase) Create(params *invitations.InviteParams) (models.Invitation, error) {
+	invitee := strings.TrimSpace(params.Invitee)
+	if invitee == "" {
+		return models.Invitation{}, pkgErrors.ErrEmptyInvitee
+	}
+	if !models.IsAssignableRole(params.Role) {
+		return models.Invitation{}, pkgErrors.ErrInvalidRole
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return models.Invitation{}, errors.Wrap(pkgErrors.ErrInvitationTokenFailure, err.Error())
+	}
+
+	invitation, err := uc.repo.Create(&invitations.CreateParams{
+		WorkspaceID: params.WorkspaceID,
+		InviterID:   params.InviterID,
+		Invitee:     invitee,
+		Role:        params.Role,
+		Token:       token,
+		ExpiresAt:   time.Now().UTC().Add(constants.InvitationLivingTime),
+	})
+	if err != nil {
+		return models.Invitation{}, err
+	}
+
+	err = uc.sender.Send(&invitation)
+	if err != nil {
+		// Nobody can accept an invitation that was never delivered.
+		_, _ = uc.repo.UpdateStatus(invitation.ID, models.InvitationRevoked)
+		return models.Invitation{}, errors.Wrap(pkgErrors.ErrSendInvitation, err.Error())
+	}
+
+	return invitation, nil
+}
+
+func (uc *usecase) ListPending(workspaceID int) ([]models.Invitation, error) {
+	all, err := uc.repo.ListPending(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]models.Invitation, 0, len(all))
+	for _, invitation := range all {
+		if !isExpired(&invitation) {
+			pending = append(pending, invitation)
+		}
+	}
+	return pending, nil
+}
+
+func (uc *usecase) Revoke(workspaceID, invitationID int) error {
+	invitation, err := uc.repo.Get(invitationID)
+	if err != nil {
+		return err
+	}
+	if invitation.WorkspaceID != workspaceID {
+		return pkgErrors.ErrInvitationNotFound
+	}
+
+	_, err = uc.repo.UpdateStatus(invitationID, models.InvitationRevoked)
+	return err
+}
+
+func (uc *usecase) Accept(userID int, token string) (models.Member, error) {
+	invitation, err := uc.answerable(userID, token)
+	if err != nil {
+		return models.Member{}, err
+	}
+
+	// A revoke or another answer racing this one leaves no member behind.
+	return uc.repo.Accept(invitation.ID, userID)
+}
+
+func (uc *usecase) Decline(userID int, token string) error {
+	invitation, err := uc.answerable(userID, token)
+	if err != nil {
+		return err
+	}
+
+	_, err = uc.repo.UpdateStatus(invitation.ID, models.InvitationDeclined)
+	return err
+}
+
+// answerable returns the invitation behind token if userID may still accept
+// or decline it.
+func (uc *usecase) answerable(userID int, token string) (models.Invitation, error) {
+	invitation, err := uc.repo.GetByToken(token)
+	if err != nil {
+		return models.Invitation{}, err
+	}
+	if invitation.Status != models.InvitationPending {
+		return models.Invitation{}, pkgErrors.ErrInvitationNotPending
+	}
+	if isExpired(&invitation) {
+		return models.Invitation{}, pkgErrors.ErrInvitationExpired
+	}
+
+	user, err := uc.usersRepo.Get(userID)
+	if err != nil {
+		return models.Invitation{}, err
+	}
+	if invitation.Invitee != user.Username && !strings.EqualFold(invitation.Invitee, user.Email) {
+		return models.Invitation{}, pkgErrors.ErrInvitationNotForUser
+	}
+
+	return invitation, nil
+}
+
+// isExpired compares wall clocks in UTC: expires_at is stored without a time
+// zone, so it comes back from the database labelled as UTC.
+func isExpired(invitation *models.Invitation) bool {
+	return time.Now().UTC().After(invitation.ExpiresAt)
+}
+
+func newToken() (string, error) {
+	buf := make([]byte, constants.InvitationTokenLen)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,266 @@
+package usecase
+
+import (
+	pkgInvitations "github.com/SlavaShagalov/my-trello-backend/internal/invitations"
+	"github.com/SlavaShagalov/my-trello-backend/internal/invitations/mocks"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	usersMocks "github.com/SlavaShagalov/my-trello-backend/internal/users/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type fields struct {
+	repo      *mocks.MockRepository
+	sender    *mocks.MockSender
+	usersRepo *usersMocks.MockRepository
+}
+
+func newFields(ctrl *gomock.Controller) fields {
+	return fields{
+		repo:      mocks.NewMockRepository(ctrl),
+		sender:    mocks.NewMockSender(ctrl),
+		usersRepo: usersMocks.NewMockRepository(ctrl),
+	}
+}
+
+func TestUsecase_Create(t *testing.T) {
+	type testCase struct {
+		prepare func(f *fields)
+		params  *pkgInvitations.InviteParams
+		err     error
+	}
+
+	created := models.Invitation{ID: 5, WorkspaceID: 1, InviterID: 1, Invitee: "kirill", Role: models.RoleMember,
+		Token: "token", Status: models.InvitationPending}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Create(gomock.Any()).DoAndReturn(
+					func(params *pkgInvitations.CreateParams) (models.Invitation, error) {
+						assert.Equal(t, "kirill", params.Invitee)
+						assert.Len(t, params.Token, 64)
+						assert.True(t, params.ExpiresAt.After(time.Now().UTC()))
+						return created, nil
+					})
+				f.sender.EXPECT().Send(&created).Return(nil)
+			},
+			params: &pkgInvitations.InviteParams{WorkspaceID: 1, InviterID: 1, Invitee: " kirill ",
+				Role: models.RoleMember},
+			err: nil,
+		},
+		"empty invitee": {
+			params: &pkgInvitations.InviteParams{WorkspaceID: 1, InviterID: 1, Invitee: "  ",
+				Role: models.RoleMember},
+			err: pkgErrors.ErrEmptyInvitee,
+		},
+		"owner role": {
+			params: &pkgInvitations.InviteParams{WorkspaceID: 1, InviterID: 1, Invitee: "kirill",
+				Role: models.RoleOwner},
+			err: pkgErrors.ErrInvalidRole,
+		},
+		"send failed": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Create(gomock.Any()).Return(created, nil)
+				f.sender.EXPECT().Send(&created).Return(errors.New("smtp is down"))
+				f.repo.EXPECT().UpdateStatus(created.ID, models.InvitationRevoked).Return(models.Invitation{}, nil)
+			},
+			params: &pkgInvitations.InviteParams{WorkspaceID: 1, InviterID: 1, Invitee: "kirill",
+				Role: models.RoleMember},
+			err: pkgErrors.ErrSendInvitation,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := newFields(ctrl)
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo, f.sender, f.usersRepo)
+			_, err := uc.Create(test.params)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_Accept(t *testing.T) {
+	type testCase struct {
+		prepare func(f *fields)
+		userID  int
+		token   string
+		member  models.Member
+		err     error
+	}
+
+	invitation := models.Invitation{ID: 5, WorkspaceID: 1, Invitee: "Kirill@vk.com", Role: models.RoleMember,
+		Token: "token", Status: models.InvitationPending, ExpiresAt: time.Now().UTC().Add(time.Hour)}
+	user := models.User{ID: 2, Username: "kirill", Email: "kirill@vk.com"}
+	member := models.Member{WorkspaceID: 1, UserID: 2, Role: models.RoleMember}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().GetByToken("token").Return(invitation, nil)
+				f.usersRepo.EXPECT().Get(2).Return(user, nil)
+				f.repo.EXPECT().Accept(5, 2).Return(member, nil)
+			},
+			userID: 2,
+			token:  "token",
+			member: member,
+			err:    nil,
+		},
+		"invitation not found": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().GetByToken("token").Return(models.Invitation{}, pkgErrors.ErrInvitationNotFound)
+			},
+			userID: 2,
+			token:  "token",
+			err:    pkgErrors.ErrInvitationNotFound,
+		},
+		"already declined": {
+			prepare: func(f *fields) {
+				declined := invitation
+				declined.Status = models.InvitationDeclined
+				f.repo.EXPECT().GetByToken("token").Return(declined, nil)
+			},
+			userID: 2,
+			token:  "token",
+			err:    pkgErrors.ErrInvitationNotPending,
+		},
+		"expired": {
+			prepare: func(f *fields) {
+				expired := invitation
+				expired.ExpiresAt = time.Now().UTC().Add(-time.Minute)
+				f.repo.EXPECT().GetByToken("token").Return(expired, nil)
+			},
+			userID: 2,
+			token:  "token",
+			err:    pkgErrors.ErrInvitationExpired,
+		},
+		"another user": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().GetByToken("token").Return(invitation, nil)
+				f.usersRepo.EXPECT().Get(3).Return(models.User{ID: 3, Username: "petya", Email: "petya@vk.com"}, nil)
+			},
+			userID: 3,
+			token:  "token",
+			err:    pkgErrors.ErrInvitationNotForUser,
+		},
+		"already member": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().GetByToken("token").Return(invitation, nil)
+				f.usersRepo.EXPECT().Get(2).Return(user, nil)
+				f.repo.EXPECT().Accept(5, 2).Return(models.Member{}, pkgErrors.ErrMemberAlreadyExists)
+			},
+			userID: 2,
+			token:  "token",
+			err:    pkgErrors.ErrMemberAlreadyExists,
+		},
+		"revoked meanwhile": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().GetByToken("token").Return(invitation, nil)
+				f.usersRepo.EXPECT().Get(2).Return(user, nil)
+				f.repo.EXPECT().Accept(5, 2).Return(models.Member{}, pkgErrors.ErrInvitationNotPending)
+			},
+			userID: 2,
+			token:  "token",
+			err:    pkgErrors.ErrInvitationNotPending,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := newFields(ctrl)
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo, f.sender, f.usersRepo)
+			member, err := uc.Accept(test.userID, test.token)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			assert.Equal(t, test.member, member)
+		})
+	}
+}
+
+func TestUsecase_Revoke(t *testing.T) {
+	type testCase struct {
+		prepare      func(f *fields)
+		workspaceID  int
+		invitationID int
+		err          error
+	}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(5).Return(models.Invitation{ID: 5, WorkspaceID: 1}, nil)
+				f.repo.EXPECT().UpdateStatus(5, models.InvitationRevoked).Return(models.Invitation{}, nil)
+			},
+			workspaceID:  1,
+			invitationID: 5,
+			err:          nil,
+		},
+		"another workspace": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(5).Return(models.Invitation{ID: 5, WorkspaceID: 2}, nil)
+			},
+			workspaceID:  1,
+			invitationID: 5,
+			err:          pkgErrors.ErrInvitationNotFound,
+		},
+		"already accepted": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(5).Return(models.Invitation{ID: 5, WorkspaceID: 1}, nil)
+				f.repo.EXPECT().UpdateStatus(5, models.InvitationRevoked).
+					Return(models.Invitation{}, pkgErrors.ErrInvitationNotPending)
+			},
+			workspaceID:  1,
+			invitationID: 5,
+			err:          pkgErrors.ErrInvitationNotPending,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := newFields(ctrl)
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo, f.sender, f.usersRepo)
+			err := uc.Revoke(test.workspaceID, test.invitationID)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+		})
+	}
+}
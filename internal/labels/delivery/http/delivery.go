@@ -0,0 +1,455 @@
+package http
+
+import (
+	pAccess "github.com/SlavaShagalov/my-trello-backend/internal/access"
+	pLabels "github.com/SlavaShagalov/my-trello-backend/internal/labels"
+	mw "github.com/SlavaShagalov/my-trello-backend/internal/middleware"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pHTTP "github.com/SlavaShagalov/my-trello-backend/internal/pkg/http"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+type delivery struct {
+	uc       pLabels.Usecase
+	accessUC pAccess.Usecase
+	log      *zap.Logger
+}
+
+func RegisterHandlers(mux *mux.Router, uc pLabels.Usecase, accessUC pAccess.Usecase, log *zap.Logger,
+	checkAuth mw.Middleware, metrics mw.Middleware) {
+	del := delivery{
+		uc:       uc,
+		accessUC: accessUC,
+		log:      log,
+	}
+
+	const (
+		boardLabelsPrefix = "/boards/{id}/labels"
+		boardLabelsPath   = constants.ApiPrefix + boardLabelsPrefix
+		boardLabelPath    = boardLabelsPath + "/{label_id}"
+
+		cardLabelsPrefix = "/cards/{id}/labels"
+		cardLabelsPath   = constants.ApiPrefix + cardLabelsPrefix
+		cardLabelPath    = cardLabelsPath + "/{label_id}"
+	)
+
+	mux.HandleFunc(boardLabelsPath, metrics(checkAuth(del.create))).Methods(http.MethodPost)
+	mux.HandleFunc(boardLabelsPath, metrics(checkAuth(del.listByBoard))).Methods(http.MethodGet)
+	mux.HandleFunc(boardLabelPath, metrics(checkAuth(del.partialUpdate))).Methods(http.MethodPatch)
+	mux.HandleFunc(boardLabelPath, metrics(checkAuth(del.delete))).Methods(http.MethodDelete)
+
+	mux.HandleFunc(cardLabelsPath, metrics(checkAuth(del.listByCard))).Methods(http.MethodGet)
+	mux.HandleFunc(cardLabelsPath, metrics(checkAuth(del.attach))).Methods(http.MethodPost)
+	mux.HandleFunc(cardLabelPath, metrics(checkAuth(del.detach))).Methods(http.MethodDelete)
+}
+
+// create godoc
+//
+//	@Summary		Create a new label
+//	@Description	Create a new label in the board palette
+//	@Tags			boards
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		int				true	"Board ID"
+//	@Param			LabelData	body		createRequest	true	"Label data"
+//	@Success		200			{object}	getResponse		"Created label data."
+//	@Failure		400			{object}	http.JSONError
+//	@Failure		401			{object}	http.JSONError
+//	@Failure		403			{object}	http.JSONError
+//	@Failure		404			{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/boards/{id}/labels [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) create(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, boardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	body, err := pHTTP.ReadBody(r, del.log)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	var request createRequest
+	err = request.UnmarshalJSON(body)
+	if err != nil {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	params := pLabels.CreateParams{
+		BoardID: boardID,
+		Name:    request.Name,
+		Color:   request.Color,
+	}
+
+	label, err := del.uc.Create(&params)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newGetResponse(&label)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// listByBoard godoc
+//
+//	@Summary		Returns labels of board
+//	@Description	Returns the label palette of the board
+//	@Tags			boards
+//	@Produce		json
+//	@Param			id	path		int				true	"Board ID"
+//	@Success		200	{object}	listResponse	"Labels data"
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/boards/{id}/labels [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) listByBoard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, boardID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	labels, err := del.uc.ListByBoard(boardID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newListResponse(labels)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// partialUpdate godoc
+//
+//	@Summary		Partial update of label
+//	@Description	Update name and/or color of label
+//	@Tags			boards
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		int						true	"Board ID"
+//	@Param			label_id	path		int						true	"Label ID"
+//	@Param			LabelData	body		partialUpdateRequest	true	"Label data"
+//	@Success		200			{object}	getResponse				"Updated label data."
+//	@Failure		400			{object}	http.JSONError
+//	@Failure		401			{object}	http.JSONError
+//	@Failure		403			{object}	http.JSONError
+//	@Failure		404			{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/boards/{id}/labels/{label_id} [patch]
+//
+//	@Security		cookieAuth
+func (del *delivery) partialUpdate(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	labelID, err := strconv.Atoi(vars["label_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, boardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	body, err := pHTTP.ReadBody(r, del.log)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	var request partialUpdateRequest
+	err = request.UnmarshalJSON(body)
+	if err != nil {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	params := pLabels.PartialUpdateParams{ID: labelID}
+	params.UpdateName = request.Name != nil
+	if params.UpdateName {
+		params.Name = *request.Name
+	}
+	params.UpdateColor = request.Color != nil
+	if params.UpdateColor {
+		params.Color = *request.Color
+	}
+
+	label, err := del.uc.PartialUpdate(boardID, &params)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newGetResponse(&label)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// delete godoc
+//
+//	@Summary		Delete label
+//	@Description	Delete label and detach it from all cards
+//	@Tags			boards
+//	@Produce		json
+//	@Param			id			path	int	true	"Board ID"
+//	@Param			label_id	path	int	true	"Label ID"
+//	@Success		204			"Label deleted successfully"
+//	@Failure		400			{object}	http.JSONError
+//	@Failure		401			{object}	http.JSONError
+//	@Failure		403			{object}	http.JSONError
+//	@Failure		404			{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/boards/{id}/labels/{label_id} [delete]
+//
+//	@Security		cookieAuth
+func (del *delivery) delete(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	labelID, err := strconv.Atoi(vars["label_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, boardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	err = del.uc.Delete(boardID, labelID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listByCard godoc
+//
+//	@Summary		Returns labels of card
+//	@Description	Returns labels attached to card
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id	path		int				true	"Card ID"
+//	@Success		200	{object}	listResponse	"Labels data"
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/labels [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) listByCard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	labels, err := del.uc.ListByCard(cardID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newListResponse(labels)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// attach godoc
+//
+//	@Summary		Attach label to card
+//	@Description	Attach a label of the card's board to the card
+//	@Tags			cards
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		int				true	"Card ID"
+//	@Param			LabelData	body		attachRequest	true	"Label to attach"
+//	@Success		200			{object}	listResponse	"Labels of card"
+//	@Failure		400			{object}	http.JSONError
+//	@Failure		401			{object}	http.JSONError
+//	@Failure		403			{object}	http.JSONError
+//	@Failure		404			{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/labels [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) attach(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	body, err := pHTTP.ReadBody(r, del.log)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	var request attachRequest
+	err = request.UnmarshalJSON(body)
+	if err != nil {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	labels, err := del.uc.Attach(cardID, request.LabelID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newListResponse(labels)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// detach godoc
+//
+//	@Summary		Detach label from card
+//	@Description	Detach label from card
+//	@Tags			cards
+//	@Produce		json
+//	@Param			id			path		int				true	"Card ID"
+//	@Param			label_id	path		int				true	"Label ID"
+//	@Success		200			{object}	listResponse	"Labels of card"
+//	@Failure		400			{object}	http.JSONError
+//	@Failure		401			{object}	http.JSONError
+//	@Failure		403			{object}	http.JSONError
+//	@Failure		404			{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/cards/{id}/labels/{label_id} [delete]
+//
+//	@Security		cookieAuth
+func (del *delivery) detach(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	cardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	labelID, err := strconv.Atoi(vars["label_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckCard(userID, cardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	labels, err := del.uc.Detach(cardID, labelID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newListResponse(labels)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
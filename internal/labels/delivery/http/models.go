@@ -0,0 +1,54 @@
+package http
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"time"
+)
+
+//go:generate easyjson -all -snake_case models.go
+
+// API requests
+type createRequest struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type partialUpdateRequest struct {
+	Name  *string `json:"name"`
+	Color *string `json:"color"`
+}
+
+type attachRequest struct {
+	LabelID int `json:"label_id"`
+}
+
+// API responses
+type listResponse struct {
+	Labels []models.Label `json:"labels"`
+}
+
+func newListResponse(labels []models.Label) *listResponse {
+	return &listResponse{
+		Labels: labels,
+	}
+}
+
+type getResponse struct {
+	ID        int       `json:"id"`
+	BoardID   int       `json:"board_id"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func newGetResponse(label *models.Label) *getResponse {
+	return &getResponse{
+		ID:        label.ID,
+		BoardID:   label.BoardID,
+		Name:      label.Name,
+		Color:     label.Color,
+		CreatedAt: label.CreatedAt,
+		UpdatedAt: label.UpdatedAt,
+	}
+}
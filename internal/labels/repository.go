@@ -0,0 +1,33 @@
+package labels
+
+import "github.com/SlavaShagalov/my-trello-backend/internal/models"
+
+type CreateParams struct {
+	BoardID int
+	Name    string
+	Color   string
+}
+
+type PartialUpdateParams struct {
+	ID          int
+	Name        string
+	UpdateName  bool
+	Color       string
+	UpdateColor bool
+}
+
+type Repository interface {
+	Create(params *CreateParams) (models.Label, error)
+	ListByBoard(boardID int) ([]models.Label, error)
+	ListByCard(cardID int) ([]models.Label, error)
+	Get(id int) (models.Label, error)
+	PartialUpdate(params *PartialUpdateParams) (models.Label, error)
+	// Delete detaches the label from all cards and deletes it in one
+	// transaction.
+	Delete(id int) error
+
+	// Attach is idempotent. It fails with ErrLabelNotOnBoard if the label
+	// and the card are on different boards.
+	Attach(cardID, labelID int) error
+	Detach(cardID, labelID int) error
+}
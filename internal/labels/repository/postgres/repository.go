@@ -0,0 +1,291 @@
+package postgres
+
+import (
+	"database/sql"
+	pkgLabels "github.com/SlavaShagalov/my-trello-backend/internal/labels"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+type repository struct {
+	db  *sql.DB
+	log *zap.Logger
+}
+
+func New(db *sql.DB, log *zap.Logger) pkgLabels.Repository {
+	return &repository{db: db, log: log}
+}
+
+const createCmd = `
+	INSERT INTO labels (board_id, name, color)
+	VALUES ($1, $2, $3)
+	RETURNING id, board_id, name, color, created_at, updated_at;`
+
+func (repo *repository) Create(params *pkgLabels.CreateParams) (models.Label, error) {
+	row := repo.db.QueryRow(createCmd, params.BoardID, params.Name, params.Color)
+
+	var label models.Label
+	err := scanLabel(row, &label)
+	if err != nil {
+		pgErr, ok := err.(*pq.Error)
+		if !ok {
+			repo.log.Error("Cannot convert err to pq.Error", zap.Error(err))
+			return models.Label{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+		if pgErr.Constraint == "labels_board_id_fkey" {
+			return models.Label{}, errors.Wrap(pkgErrors.ErrBoardNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", createCmd),
+			zap.Any("create_params", params))
+		return models.Label{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("New label created", zap.Any("label", label))
+	return label, nil
+}
+
+const listByBoardCmd = `
+	SELECT id, board_id, name, color, created_at, updated_at
+	FROM labels
+	WHERE board_id = $1
+	ORDER BY id;`
+
+func (repo *repository) ListByBoard(boardID int) ([]models.Label, error) {
+	return repo.list(listByBoardCmd, boardID)
+}
+
+const listByCardCmd = `
+	SELECT lb.id, lb.board_id, lb.name, lb.color, lb.created_at, lb.updated_at
+	FROM labels lb
+	JOIN card_labels cl on cl.label_id = lb.id
+	WHERE cl.card_id = $1
+	ORDER BY lb.id;`
+
+func (repo *repository) ListByCard(cardID int) ([]models.Label, error) {
+	return repo.list(listByCardCmd, cardID)
+}
+
+func (repo *repository) list(query string, id int) ([]models.Label, error) {
+	rows, err := repo.db.Query(query, id)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", query), zap.Int("id", id))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	labels := []models.Label{}
+	var label models.Label
+	for rows.Next() {
+		err = rows.Scan(
+			&label.ID,
+			&label.BoardID,
+			&label.Name,
+			&label.Color,
+			&label.CreatedAt,
+			&label.UpdatedAt,
+		)
+		if err != nil {
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", query), zap.Int("id", id))
+			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+		labels = append(labels, label)
+	}
+
+	return labels, nil
+}
+
+const getCmd = `
+	SELECT id, board_id, name, color, created_at, updated_at
+	FROM labels
+	WHERE id = $1;`
+
+func (repo *repository) Get(id int) (models.Label, error) {
+	row := repo.db.QueryRow(getCmd, id)
+
+	var label models.Label
+	err := scanLabel(row, &label)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Label{}, errors.Wrap(pkgErrors.ErrLabelNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", getCmd), zap.Int("id", id))
+		return models.Label{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	return label, nil
+}
+
+const partialUpdateCmd = `
+	UPDATE labels
+	SET name       = CASE WHEN $1::boolean THEN $2 ELSE name END,
+		color      = CASE WHEN $3::boolean THEN $4 ELSE color END,
+		updated_at = now()
+	WHERE id = $5
+	RETURNING id, board_id, name, color, created_at, updated_at;`
+
+func (repo *repository) PartialUpdate(params *pkgLabels.PartialUpdateParams) (models.Label, error) {
+	row := repo.db.QueryRow(partialUpdateCmd,
+		params.UpdateName,
+		params.Name,
+		params.UpdateColor,
+		params.Color,
+		params.ID,
+	)
+
+	var label models.Label
+	err := scanLabel(row, &label)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Label{}, errors.Wrap(pkgErrors.ErrLabelNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", partialUpdateCmd),
+			zap.Any("params", params))
+		return models.Label{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("Label partial updated", zap.Any("label", label))
+	return label, nil
+}
+
+const (
+	detachAllCmd = `
+	DELETE FROM card_labels
+	WHERE label_id = $1;`
+
+	deleteCmd = `
+	DELETE FROM labels
+	WHERE id = $1;`
+)
+
+func (repo *repository) Delete(id int) error {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Int("id", id))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(detachAllCmd, id)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", detachAllCmd), zap.Int("id", id))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	result, err := tx.Exec(deleteCmd, id)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", deleteCmd), zap.Int("id", id))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", deleteCmd), zap.Int("id", id))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	if rowsAffected == 0 {
+		return pkgErrors.ErrLabelNotFound
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Int("id", id))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("Label deleted", zap.Int("id", id))
+	return nil
+}
+
+// attachCmd inserts nothing unless the label is on the board of the card.
+const attachCmd = `
+	INSERT INTO card_labels (card_id, label_id)
+	SELECT c.id, lb.id
+	FROM cards c
+	JOIN lists l on l.id = c.list_id
+	JOIN labels lb on lb.board_id = l.board_id
+	WHERE c.id = $1 AND lb.id = $2
+	ON CONFLICT DO NOTHING;`
+
+const isAttachedCmd = `
+	SELECT EXISTS(SELECT 1 FROM card_labels WHERE card_id = $1 AND label_id = $2);`
+
+func (repo *repository) Attach(cardID, labelID int) error {
+	result, err := repo.db.Exec(attachCmd, cardID, labelID)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", attachCmd),
+			zap.Int("card_id", cardID), zap.Int("label_id", labelID))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", attachCmd),
+			zap.Int("card_id", cardID), zap.Int("label_id", labelID))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	if rowsAffected > 0 {
+		repo.log.Debug("Label attached", zap.Int("card_id", cardID), zap.Int("label_id", labelID))
+		return nil
+	}
+
+	var attached bool
+	err = repo.db.QueryRow(isAttachedCmd, cardID, labelID).Scan(&attached)
+	if err != nil {
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", isAttachedCmd),
+			zap.Int("card_id", cardID), zap.Int("label_id", labelID))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	if !attached {
+		return pkgErrors.ErrLabelNotOnBoard
+	}
+
+	return nil
+}
+
+const detachCmd = `
+	DELETE FROM card_labels
+	WHERE card_id = $1 AND label_id = $2;`
+
+func (repo *repository) Detach(cardID, labelID int) error {
+	result, err := repo.db.Exec(detachCmd, cardID, labelID)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", detachCmd),
+			zap.Int("card_id", cardID), zap.Int("label_id", labelID))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", detachCmd),
+			zap.Int("card_id", cardID), zap.Int("label_id", labelID))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	if rowsAffected == 0 {
+		return pkgErrors.ErrLabelNotAttached
+	}
+
+	repo.log.Debug("Label detached", zap.Int("card_id", cardID), zap.Int("label_id", labelID))
+	return nil
+}
+
+func scanLabel(row *sql.Row, label *models.Label) error {
+	return row.Scan(
+		&label.ID,
+		&label.BoardID,
+		&label.Name,
+		&label.Color,
+		&label.CreatedAt,
+		&label.UpdatedAt,
+	)
+}
@@ -0,0 +1,17 @@
+package labels
+
+import "github.com/SlavaShagalov/my-trello-backend/internal/models"
+
+type Usecase interface {
+	Create(params *CreateParams) (models.Label, error)
+	ListByBoard(boardID int) ([]models.Label, error)
+	// PartialUpdate and Delete fail with ErrLabelNotFound unless the label
+	// belongs to boardID.
+	PartialUpdate(boardID int, params *PartialUpdateParams) (models.Label, error)
+	Delete(boardID, id int) error
+
+	ListByCard(cardID int) ([]models.Label, error)
+	// Attach and Detach return the labels of the card after the change.
+	Attach(cardID, labelID int) ([]models.Label, error)
+	Detach(cardID, labelID int) ([]models.Label, error)
+}
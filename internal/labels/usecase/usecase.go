@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/labels"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var colorRe = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+type usecase struct {
+	repo labels.Repository
+}
+
+func New(repo labels.Repository) labels.Usecase {
+	return &usecase{repo: repo}
+}
+
+func (uc *usecase) Create(params *labels.CreateParams) (models.Label, error) {
+	name, err := validateName(params.Name)
+	if err != nil {
+		return models.Label{}, err
+	}
+	color, err := validateColor(params.Color)
+	if err != nil {
+		return models.Label{}, err
+	}
+
+	return uc.repo.Create(&labels.CreateParams{
+		BoardID: params.BoardID,
+		Name:    name,
+		Color:   color,
+	})
+}
+
+func (uc *usecase) ListByBoard(boardID int) ([]models.Label, error) {
+	return uc.repo.ListByBoard(boardID)
+}
+
+func (uc *usecase) PartialUpdate(boardID int, params *labels.PartialUpdateParams) (models.Label, error) {
+	validated := *params
+
+	var err error
+	if params.UpdateName {
+		validated.Name, err = validateName(params.Name)
+		if err != nil {
+			return models.Label{}, err
+		}
+	}
+	if params.UpdateColor {
+		validated.Color, err = validateColor(params.Color)
+		if err != nil {
+			return models.Label{}, err
+		}
+	}
+
+	err = uc.checkBoard(boardID, params.ID)
+	if err != nil {
+		return models.Label{}, err
+	}
+
+	return uc.repo.PartialUpdate(&validated)
+}
+
+func (uc *usecase) Delete(boardID, id int) error {
+	err := uc.checkBoard(boardID, id)
+	if err != nil {
+		return err
+	}
+
+	return uc.repo.Delete(id)
+}
+
+func (uc *usecase) ListByCard(cardID int) ([]models.Label, error) {
+	return uc.repo.ListByCard(cardID)
+}
+
+func (uc *usecase) Attach(cardID, labelID int) ([]models.Label, error) {
+	_, err := uc.repo.Get(labelID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.repo.Attach(cardID, labelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.ListByCard(cardID)
+}
+
+func (uc *usecase) Detach(cardID, labelID int) ([]models.Label, error) {
+	err := uc.repo.Detach(cardID, labelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.ListByCard(cardID)
+}
+
+func (uc *usecase) checkBoard(boardID, id int) error {
+	label, err := uc.repo.Get(id)
+	if err != nil {
+		return err
+	}
+	if label.BoardID != boardID {
+		return pkgErrors.ErrLabelNotFound
+	}
+	return nil
+}
+
+func validateName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if utf8.RuneCountInString(name) > constants.MaxLabelNameLen {
+		return "", pkgErrors.ErrTooLongLabelName
+	}
+	return name, nil
+}
+
+// validateColor accepts #rrggbb in any case and returns it lowercased.
+func validateColor(color string) (string, error) {
+	color = strings.ToLower(strings.TrimSpace(color))
+	if !colorRe.MatchString(color) {
+		return "", pkgErrors.ErrInvalidLabelColor
+	}
+	return color, nil
+}
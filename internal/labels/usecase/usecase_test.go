@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	pkgLabels "github.com/SlavaShagalov/my-trello-backend/internal/labels"
+	"github.com/SlavaShagalov/my-trello-backend/internal/labels/mocks"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestUsecase_Create(t *testing.T) {
+	type fields struct {
+		repo  *mocks.MockRepository
+		label *models.Label
+	}
+
+	type testCase struct {
+		prepare func(f *fields)
+		params  *pkgLabels.CreateParams
+		label   models.Label
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Create(&pkgLabels.CreateParams{BoardID: 1, Name: "bug", Color: "#eb5a46"}).
+					Return(*f.label, nil)
+			},
+			params: &pkgLabels.CreateParams{BoardID: 1, Name: " bug ", Color: "#EB5A46"},
+			label:  models.Label{ID: 1, BoardID: 1, Name: "bug", Color: "#eb5a46"},
+			err:    nil,
+		},
+		"no name": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Create(&pkgLabels.CreateParams{BoardID: 1, Color: "#61bd4f"}).Return(*f.label, nil)
+			},
+			params: &pkgLabels.CreateParams{BoardID: 1, Color: "#61bd4f"},
+			label:  models.Label{ID: 2, BoardID: 1, Color: "#61bd4f"},
+			err:    nil,
+		},
+		"too long name": {
+			params: &pkgLabels.CreateParams{BoardID: 1, Name: strings.Repeat("ы", 31), Color: "#61bd4f"},
+			label:  models.Label{},
+			err:    pkgErrors.ErrTooLongLabelName,
+		},
+		"color name": {
+			params: &pkgLabels.CreateParams{BoardID: 1, Name: "bug", Color: "red"},
+			label:  models.Label{},
+			err:    pkgErrors.ErrInvalidLabelColor,
+		},
+		"short color": {
+			params: &pkgLabels.CreateParams{BoardID: 1, Name: "bug", Color: "#fff"},
+			label:  models.Label{},
+			err:    pkgErrors.ErrInvalidLabelColor,
+		},
+		"board not found": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Create(gomock.Any()).Return(models.Label{}, pkgErrors.ErrBoardNotFound)
+			},
+			params: &pkgLabels.CreateParams{BoardID: 999, Name: "bug", Color: "#eb5a46"},
+			label:  models.Label{},
+			err:    pkgErrors.ErrBoardNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), label: &test.label}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo)
+			label, err := uc.Create(test.params)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			assert.Equal(t, test.label, label)
+		})
+	}
+}
+
+func TestUsecase_PartialUpdate(t *testing.T) {
+	type fields struct {
+		repo  *mocks.MockRepository
+		label *models.Label
+	}
+
+	type testCase struct {
+		prepare func(f *fields)
+		boardID int
+		params  *pkgLabels.PartialUpdateParams
+		label   models.Label
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(3).Return(models.Label{ID: 3, BoardID: 1, Name: "bug", Color: "#eb5a46"}, nil)
+				f.repo.EXPECT().PartialUpdate(&pkgLabels.PartialUpdateParams{ID: 3, Color: "#0079bf",
+					UpdateColor: true}).Return(*f.label, nil)
+			},
+			boardID: 1,
+			params:  &pkgLabels.PartialUpdateParams{ID: 3, Color: "#0079BF", UpdateColor: true},
+			label:   models.Label{ID: 3, BoardID: 1, Name: "bug", Color: "#0079bf"},
+			err:     nil,
+		},
+		"another board": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(3).Return(models.Label{ID: 3, BoardID: 2, Name: "bug", Color: "#eb5a46"}, nil)
+			},
+			boardID: 1,
+			params:  &pkgLabels.PartialUpdateParams{ID: 3, Name: "feature", UpdateName: true},
+			label:   models.Label{},
+			err:     pkgErrors.ErrLabelNotFound,
+		},
+		"invalid color": {
+			boardID: 1,
+			params:  &pkgLabels.PartialUpdateParams{ID: 3, Color: "blue", UpdateColor: true},
+			label:   models.Label{},
+			err:     pkgErrors.ErrInvalidLabelColor,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), label: &test.label}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo)
+			label, err := uc.PartialUpdate(test.boardID, test.params)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			assert.Equal(t, test.label, label)
+		})
+	}
+}
+
+func TestUsecase_Attach(t *testing.T) {
+	type fields struct {
+		repo   *mocks.MockRepository
+		labels []models.Label
+	}
+
+	type testCase struct {
+		prepare func(f *fields)
+		cardID  int
+		labelID int
+		labels  []models.Label
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(3).Return(f.labels[0], nil)
+				f.repo.EXPECT().Attach(1, 3).Return(nil)
+				f.repo.EXPECT().ListByCard(1).Return(f.labels, nil)
+			},
+			cardID:  1,
+			labelID: 3,
+			labels:  []models.Label{{ID: 3, BoardID: 1, Name: "bug", Color: "#eb5a46"}},
+			err:     nil,
+		},
+		"label not found": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(3).Return(models.Label{}, pkgErrors.ErrLabelNotFound)
+			},
+			cardID:  1,
+			labelID: 3,
+			labels:  nil,
+			err:     pkgErrors.ErrLabelNotFound,
+		},
+		"another board": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(3).Return(models.Label{ID: 3, BoardID: 2}, nil)
+				f.repo.EXPECT().Attach(1, 3).Return(pkgErrors.ErrLabelNotOnBoard)
+			},
+			cardID:  1,
+			labelID: 3,
+			labels:  nil,
+			err:     pkgErrors.ErrLabelNotOnBoard,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), labels: test.labels}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo)
+			labels, err := uc.Attach(test.cardID, test.labelID)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			assert.Equal(t, test.labels, labels)
+		})
+	}
+}
@@ -1,9 +1,11 @@
 package http
 
 import (
+	pAccess "github.com/SlavaShagalov/my-trello-backend/internal/access"
 	pCards "github.com/SlavaShagalov/my-trello-backend/internal/cards"
 	pLists "github.com/SlavaShagalov/my-trello-backend/internal/lists"
 	mw "github.com/SlavaShagalov/my-trello-backend/internal/middleware"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
 	pErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
 	pHTTP "github.com/SlavaShagalov/my-trello-backend/internal/pkg/http"
@@ -14,16 +16,19 @@ import (
 )
 
 type delivery struct {
-	uc      pLists.Usecase
-	cardsUC pCards.Usecase
-	log     *zap.Logger
+	uc       pLists.Usecase
+	cardsUC  pCards.Usecase
+	accessUC pAccess.Usecase
+	log      *zap.Logger
 }
 
-func RegisterHandlers(mux *mux.Router, uc pLists.Usecase, cardsUC pCards.Usecase, log *zap.Logger, checkAuth mw.Middleware, metrics mw.Middleware) {
+func RegisterHandlers(mux *mux.Router, uc pLists.Usecase, cardsUC pCards.Usecase, accessUC pAccess.Usecase,
+	log *zap.Logger, checkAuth mw.Middleware, metrics mw.Middleware) {
 	del := delivery{
-		uc:      uc,
-		cardsUC: cardsUC,
-		log:     log,
+		uc:       uc,
+		cardsUC:  cardsUC,
+		accessUC: accessUC,
+		log:      log,
 	}
 
 	const (
@@ -44,6 +49,10 @@ func RegisterHandlers(mux *mux.Router, uc pLists.Usecase, cardsUC pCards.Usecase
 	mux.HandleFunc(listPath, metrics(checkAuth(del.get))).Methods(http.MethodGet)
 	mux.HandleFunc(listPath, metrics(checkAuth(del.partialUpdate))).Methods(http.MethodPatch)
 	mux.HandleFunc(listPath, metrics(checkAuth(del.delete))).Methods(http.MethodDelete)
+	mux.HandleFunc(listPath+"/archive", metrics(checkAuth(del.archive))).Methods(http.MethodPost)
+	mux.HandleFunc(listPath+"/unarchive", metrics(checkAuth(del.unarchive))).Methods(http.MethodPost)
+	mux.HandleFunc(listPath+"/move", metrics(checkAuth(del.move))).Methods(http.MethodPost)
+	mux.HandleFunc(listPath+"/copy", metrics(checkAuth(del.copy))).Methods(http.MethodPost)
 }
 
 // create godoc
@@ -58,6 +67,7 @@ func RegisterHandlers(mux *mux.Router, uc pLists.Usecase, cardsUC pCards.Usecase
 //	@Success		200				{object}	createResponse	"Created list data."
 //	@Failure		400				{object}	http.JSONError
 //	@Failure		401				{object}	http.JSONError
+//	@Failure		403				{object}	http.JSONError
 //	@Failure		405
 //	@Failure		500
 //	@Router			/boards/{id}/lists [post]
@@ -71,6 +81,18 @@ func (del *delivery) create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, boardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
 	body, err := pHTTP.ReadBody(r, del.log)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
@@ -102,13 +124,16 @@ func (del *delivery) create(w http.ResponseWriter, r *http.Request) {
 // listByWorkspace godoc
 //
 //	@Summary		Returns lists by board id
-//	@Description	Returns lists by board id
+//	@Description	Returns active lists by board id with their active cards. Archived lists are returned instead
+//	@Description	with archived=true, the last archived first.
 //	@Tags			boards
 //	@Produce		json
-//	@Param			id	path		int				true	"Board ID"
-//	@Success		200	{object}	listResponse	"Lists data"
-//	@Failure		400	{object}	http.JSONError
+//	@Param			id			path		int				true	"Board ID"
+//	@Param			archived	query		bool			false	"Return archived lists"
+//	@Success		200			{object}	listResponse	"Lists data"
+//	@Failure		400			{object}	http.JSONError
 //	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
 //	@Failure		405
 //	@Failure		500
 //	@Router			/boards/{id}/lists [get]
@@ -122,7 +147,25 @@ func (del *delivery) listByBoard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	lists, err := del.uc.ListByBoard(boardID)
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, boardID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	archived, err := pHTTP.ParseArchived(r)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	lists, err := del.uc.ListByBoard(boardID, archived)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
 		return
@@ -135,10 +178,11 @@ func (del *delivery) listByBoard(w http.ResponseWriter, r *http.Request) {
 		response.Lists[i].BoardID = lists[i].BoardID
 		response.Lists[i].Title = lists[i].Title
 		response.Lists[i].Position = lists[i].Position
+		response.Lists[i].ArchivedAt = lists[i].ArchivedAt
 		response.Lists[i].CreatedAt = lists[i].CreatedAt
 		response.Lists[i].UpdatedAt = lists[i].UpdatedAt
 
-		cards, err := del.cardsUC.ListByList(lists[i].ID)
+		cards, err := del.cardsUC.ListByList(lists[i].ID, nil)
 		if err != nil {
 			pHTTP.HandleError(w, r, err)
 			return
@@ -194,6 +238,7 @@ func (del *delivery) list(w http.ResponseWriter, r *http.Request) {
 //	@Success		200	{object}	getResponse	"Board data"
 //	@Failure		400	{object}	http.JSONError
 //	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
 //	@Failure		404	{object}	http.JSONError
 //	@Failure		405
 //	@Failure		500
@@ -208,6 +253,18 @@ func (del *delivery) get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckList(userID, listID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
 	list, err := del.uc.Get(listID)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
@@ -221,7 +278,9 @@ func (del *delivery) get(w http.ResponseWriter, r *http.Request) {
 // partialUpdate godoc
 //
 //	@Summary		Partial update of list
-//	@Description	Partial update of list
+//	@Description	Partial update of list. Changing board_id or position moves the list, to the end of the new board
+//	@Description	without a position. Instead of the position, before_id puts the list right after that list and
+//	@Description	after_id right before it. With both, the lists must be adjacent. Only the moved list is written.
 //	@Tags			lists
 //	@Accept			json
 //	@Produce		json
@@ -230,6 +289,7 @@ func (del *delivery) get(w http.ResponseWriter, r *http.Request) {
 //	@Success		200				{object}	getResponse				"Updated list data."
 //	@Failure		400				{object}	http.JSONError
 //	@Failure		401				{object}	http.JSONError
+//	@Failure		403				{object}	http.JSONError
 //	@Failure		405
 //	@Failure		500
 //	@Router			/lists/{id}  [patch]
@@ -243,6 +303,18 @@ func (del *delivery) partialUpdate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckList(userID, listID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
 	body, err := pHTTP.ReadBody(r, del.log)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
@@ -263,12 +335,19 @@ func (del *delivery) partialUpdate(w http.ResponseWriter, r *http.Request) {
 	}
 	params.UpdateBoardID = request.BoardID != nil
 	if params.UpdateBoardID {
+		err = del.accessUC.CheckBoard(userID, *request.BoardID, pAccess.Write)
+		if err != nil {
+			pHTTP.HandleError(w, r, err)
+			return
+		}
 		params.BoardID = *request.BoardID
 	}
 	params.UpdatePosition = request.Position != nil
 	if params.UpdatePosition {
 		params.Position = *request.Position
 	}
+	params.BeforeID = request.BeforeID
+	params.AfterID = request.AfterID
 
 	list, err := del.uc.PartialUpdate(&params)
 	if err != nil {
@@ -280,6 +359,173 @@ func (del *delivery) partialUpdate(w http.ResponseWriter, r *http.Request) {
 	pHTTP.SendJSON(w, r, http.StatusOK, response)
 }
 
+// move godoc
+//
+//	@Summary		Move list
+//	@Description	Move list to the position of a board of the same workspace. Positions start at 1, an absent or too
+//	@Description	large one puts the list last. Instead of the position, before_id puts the list right after that list
+//	@Description	and after_id right before it. With both, the lists must be adjacent. Its cards move with it.
+//	@Tags			lists
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		int			true	"List ID"
+//	@Param			MoveData	body		moveRequest	true	"Target board and position"
+//	@Success		200			{object}	getResponse	"Moved list data."
+//	@Failure		400			{object}	http.JSONError
+//	@Failure		401			{object}	http.JSONError
+//	@Failure		403			{object}	http.JSONError
+//	@Failure		404			{object}	http.JSONError
+//	@Failure		405
+//	@Failure		409			{object}	http.JSONError
+//	@Failure		500
+//	@Router			/lists/{id}/move [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) move(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	listID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckList(userID, listID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	body, err := pHTTP.ReadBody(r, del.log)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	var request moveRequest
+	err = request.UnmarshalJSON(body)
+	if err != nil {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, request.BoardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	params := pLists.MoveParams{
+		ID:       listID,
+		BoardID:  request.BoardID,
+		Position: pLists.LastPosition,
+		BeforeID: request.BeforeID,
+		AfterID:  request.AfterID,
+	}
+	if request.Position != nil {
+		params.Position = *request.Position
+	}
+
+	list, err := del.uc.Move(&params)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newGetResponse(&list)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// copy godoc
+//
+//	@Summary		Copy list
+//	@Description	Copy list with its active cards to a board of the same workspace, placed like POST /lists/{id}/move.
+//	@Description	With checklists=true the checklists of the cards are copied too. With labels=true the copies get the
+//	@Description	labels of the cards, created on another board when it has none of the same name and color.
+//	@Tags			lists
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		int			true	"List ID"
+//	@Param			CopyData	body		copyRequest	true	"Target board, position and what to copy"
+//	@Success		200			{object}	getResponse	"Copied list data."
+//	@Failure		400			{object}	http.JSONError
+//	@Failure		401			{object}	http.JSONError
+//	@Failure		403			{object}	http.JSONError
+//	@Failure		404			{object}	http.JSONError
+//	@Failure		405
+//	@Failure		409			{object}	http.JSONError
+//	@Failure		500
+//	@Router			/lists/{id}/copy [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) copy(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	listID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckList(userID, listID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	body, err := pHTTP.ReadBody(r, del.log)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	var request copyRequest
+	err = request.UnmarshalJSON(body)
+	if err != nil {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, request.BoardID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	params := pLists.CopyParams{
+		ID:         listID,
+		BoardID:    request.BoardID,
+		Title:      request.Title,
+		Position:   pLists.LastPosition,
+		BeforeID:   request.BeforeID,
+		AfterID:    request.AfterID,
+		Checklists: request.Checklists,
+		Labels:     request.Labels,
+	}
+	if request.Position != nil {
+		params.Position = *request.Position
+	}
+
+	list, err := del.uc.Copy(&params)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newGetResponse(&list)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
 // delete godoc
 //
 //	@Summary		Delete list by id
@@ -290,6 +536,7 @@ func (del *delivery) partialUpdate(w http.ResponseWriter, r *http.Request) {
 //	@Success		204	"List deleted successfully"
 //	@Failure		400	{object}	http.JSONError
 //	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
 //	@Failure		404	{object}	http.JSONError
 //	@Failure		405
 //	@Failure		500
@@ -304,6 +551,18 @@ func (del *delivery) delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckList(userID, listID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
 	err = del.uc.Delete(listID)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
@@ -312,3 +571,79 @@ func (del *delivery) delete(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// archive godoc
+//
+//	@Summary		Archive list
+//	@Description	Archive list. It leaves the board listings and the following lists move up, its cards are kept.
+//	@Tags			lists
+//	@Produce		json
+//	@Param			id	path		int			true	"List ID"
+//	@Success		200	{object}	getResponse	"Updated list data."
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/lists/{id}/archive [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) archive(w http.ResponseWriter, r *http.Request) {
+	// setArchived refuses requests without the user.
+	userID, _ := r.Context().Value(mw.ContextUserID).(int)
+	del.setArchived(w, r, func(id int) (models.List, error) {
+		return del.uc.Archive(id, userID)
+	})
+}
+
+// unarchive godoc
+//
+//	@Summary		Restore archived list
+//	@Description	Restore archived list between the lists it was archived from.
+//	@Tags			lists
+//	@Produce		json
+//	@Param			id	path		int			true	"List ID"
+//	@Success		200	{object}	getResponse	"Updated list data."
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/lists/{id}/unarchive [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) unarchive(w http.ResponseWriter, r *http.Request) {
+	del.setArchived(w, r, del.uc.Unarchive)
+}
+
+func (del *delivery) setArchived(w http.ResponseWriter, r *http.Request, set func(id int) (models.List, error)) {
+	vars := mux.Vars(r)
+	listID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckList(userID, listID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	list, err := set(listID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newGetResponse(&list)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
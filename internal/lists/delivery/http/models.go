@@ -16,17 +16,41 @@ type partialUpdateRequest struct {
 	Title    *string `json:"title"`
 	Position *int    `json:"position"`
 	BoardID  *int    `json:"board_id"`
+	BeforeID int     `json:"before_id"`
+	AfterID  int     `json:"after_id"`
+}
+
+// moveRequest puts the list after the list BeforeID or before the list AfterID
+// when either is set, at the position otherwise, and last when it is absent.
+type moveRequest struct {
+	BoardID  int  `json:"board_id"`
+	Position *int `json:"position"`
+	BeforeID int  `json:"before_id"`
+	AfterID  int  `json:"after_id"`
+}
+
+// copyRequest places the copy like moveRequest. An empty title keeps the title
+// of the list.
+type copyRequest struct {
+	BoardID    int    `json:"board_id"`
+	Title      string `json:"title"`
+	Position   *int   `json:"position"`
+	BeforeID   int    `json:"before_id"`
+	AfterID    int    `json:"after_id"`
+	Checklists bool   `json:"checklists"`
+	Labels     bool   `json:"labels"`
 }
 
 // API responses
 type itemResponse struct {
-	ID        int           `json:"id"`
-	BoardID   int           `json:"board_id"`
-	Title     string        `json:"title"`
-	Position  int           `json:"position"`
-	CreatedAt time.Time     `json:"created_at"`
-	UpdatedAt time.Time     `json:"updated_at"`
-	Cards     []models.Card `json:"cards"`
+	ID         int           `json:"id"`
+	BoardID    int           `json:"board_id"`
+	Title      string        `json:"title"`
+	Position   int           `json:"position"`
+	ArchivedAt *time.Time    `json:"archived_at"`
+	CreatedAt  time.Time     `json:"created_at"`
+	UpdatedAt  time.Time     `json:"updated_at"`
+	Cards      []models.Card `json:"cards"`
 }
 
 type listResponse struct {
@@ -64,21 +88,23 @@ func newCreateResponse(list *models.List) *createResponse {
 }
 
 type getResponse struct {
-	ID        int       `json:"id"`
-	BoardID   int       `json:"board_id"`
-	Title     string    `json:"title"`
-	Position  int       `json:"position"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID         int        `json:"id"`
+	BoardID    int        `json:"board_id"`
+	Title      string     `json:"title"`
+	Position   int        `json:"position"`
+	ArchivedAt *time.Time `json:"archived_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
 }
 
 func newGetResponse(list *models.List) *getResponse {
 	return &getResponse{
-		ID:        list.ID,
-		BoardID:   list.BoardID,
-		Title:     list.Title,
-		Position:  list.Position,
-		CreatedAt: list.CreatedAt,
-		UpdatedAt: list.UpdatedAt,
+		ID:         list.ID,
+		BoardID:    list.BoardID,
+		Title:      list.Title,
+		Position:   list.Position,
+		ArchivedAt: list.ArchivedAt,
+		CreatedAt:  list.CreatedAt,
+		UpdatedAt:  list.UpdatedAt,
 	}
 }
@@ -8,6 +8,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -67,6 +68,10 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDel
 				}
 				*out.BoardID = int(in.Int())
 			}
+		case "before_id":
+			out.BeforeID = int(in.Int())
+		case "after_id":
+			out.AfterID = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -108,6 +113,16 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDel
 			out.Int(int(*in.BoardID))
 		}
 	}
+	{
+		const prefix string = ",\"before_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.BeforeID))
+	}
+	{
+		const prefix string = ",\"after_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.AfterID))
+	}
 	out.RawByte('}')
 }
 
@@ -134,7 +149,106 @@ func (v *partialUpdateRequest) UnmarshalJSON(data []byte) error {
 func (v *partialUpdateRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp1(in *jlexer.Lexer, out *listSimpleResponse) {
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp1(in *jlexer.Lexer, out *moveRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "board_id":
+			out.BoardID = int(in.Int())
+		case "position":
+			if in.IsNull() {
+				in.Skip()
+				out.Position = nil
+			} else {
+				if out.Position == nil {
+					out.Position = new(int)
+				}
+				*out.Position = int(in.Int())
+			}
+		case "before_id":
+			out.BeforeID = int(in.Int())
+		case "after_id":
+			out.AfterID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp1(out *jwriter.Writer, in moveRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.BoardID))
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		if in.Position == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.Position))
+		}
+	}
+	{
+		const prefix string = ",\"before_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.BeforeID))
+	}
+	{
+		const prefix string = ",\"after_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.AfterID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v moveRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v moveRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *moveRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *moveRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp1(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp2(in *jlexer.Lexer, out *listSimpleResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -186,7 +300,7 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDel
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp1(out *jwriter.Writer, in listSimpleResponse) {
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp2(out *jwriter.Writer, in listSimpleResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -212,25 +326,25 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDel
 // MarshalJSON supports json.Marshaler interface
 func (v listSimpleResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp1(&w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v listSimpleResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp1(w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *listSimpleResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp1(&r, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *listSimpleResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp1(l, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp2(l, v)
 }
 func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels(in *jlexer.Lexer, out *models.List) {
 	isTopLevel := in.IsStart()
@@ -259,6 +373,18 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels(i
 			out.Title = string(in.String())
 		case "position":
 			out.Position = int(in.Int())
+		case "archived_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ArchivedAt = nil
+			} else {
+				if out.ArchivedAt == nil {
+					out.ArchivedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ArchivedAt).UnmarshalJSON(data))
+				}
+			}
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
@@ -301,6 +427,15 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels(o
 		out.RawString(prefix)
 		out.Int(int(in.Position))
 	}
+	{
+		const prefix string = ",\"archived_at\":"
+		out.RawString(prefix)
+		if in.ArchivedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ArchivedAt).MarshalJSON())
+		}
+	}
 	{
 		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
@@ -313,7 +448,7 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels(o
 	}
 	out.RawByte('}')
 }
-func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp2(in *jlexer.Lexer, out *listResponse) {
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp3(in *jlexer.Lexer, out *listResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -365,7 +500,7 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDel
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp2(out *jwriter.Writer, in listResponse) {
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp3(out *jwriter.Writer, in listResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -391,27 +526,27 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDel
 // MarshalJSON supports json.Marshaler interface
 func (v listResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp2(&w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v listResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp2(w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *listResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp2(&r, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *listResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp2(l, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp3(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp3(in *jlexer.Lexer, out *itemResponse) {
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp4(in *jlexer.Lexer, out *itemResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -438,6 +573,18 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDel
 			out.Title = string(in.String())
 		case "position":
 			out.Position = int(in.Int())
+		case "archived_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ArchivedAt = nil
+			} else {
+				if out.ArchivedAt == nil {
+					out.ArchivedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ArchivedAt).UnmarshalJSON(data))
+				}
+			}
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
@@ -479,7 +626,7 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDel
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp3(out *jwriter.Writer, in itemResponse) {
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp4(out *jwriter.Writer, in itemResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -503,6 +650,15 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDel
 		out.RawString(prefix)
 		out.Int(int(in.Position))
 	}
+	{
+		const prefix string = ",\"archived_at\":"
+		out.RawString(prefix)
+		if in.ArchivedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ArchivedAt).MarshalJSON())
+		}
+	}
 	{
 		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
@@ -535,25 +691,25 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDel
 // MarshalJSON supports json.Marshaler interface
 func (v itemResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp3(&w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v itemResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp3(w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *itemResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp3(&r, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *itemResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp3(l, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp4(l, v)
 }
 func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels1(in *jlexer.Lexer, out *models.Card) {
 	isTopLevel := in.IsStart()
@@ -584,6 +740,104 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels1(
 			out.Content = string(in.String())
 		case "position":
 			out.Position = int(in.Int())
+		case "labels":
+			if in.IsNull() {
+				in.Skip()
+				out.Labels = nil
+			} else {
+				in.Delim('[')
+				if out.Labels == nil {
+					if !in.IsDelim(']') {
+						out.Labels = make([]models.Label, 0, 0)
+					} else {
+						out.Labels = []models.Label{}
+					}
+				} else {
+					out.Labels = (out.Labels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 models.Label
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels2(in, &v10)
+					out.Labels = append(out.Labels, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "assignees":
+			if in.IsNull() {
+				in.Skip()
+				out.Assignees = nil
+			} else {
+				in.Delim('[')
+				if out.Assignees == nil {
+					if !in.IsDelim(']') {
+						out.Assignees = make([]models.Assignee, 0, 0)
+					} else {
+						out.Assignees = []models.Assignee{}
+					}
+				} else {
+					out.Assignees = (out.Assignees)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v11 models.Assignee
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels3(in, &v11)
+					out.Assignees = append(out.Assignees, v11)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "checklist_progress":
+			easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels4(in, &out.ChecklistProgress)
+		case "comments_count":
+			out.CommentsCount = int(in.Int())
+		case "start_at":
+			if in.IsNull() {
+				in.Skip()
+				out.StartAt = nil
+			} else {
+				if out.StartAt == nil {
+					out.StartAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.StartAt).UnmarshalJSON(data))
+				}
+			}
+		case "due_at":
+			if in.IsNull() {
+				in.Skip()
+				out.DueAt = nil
+			} else {
+				if out.DueAt == nil {
+					out.DueAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DueAt).UnmarshalJSON(data))
+				}
+			}
+		case "completed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.CompletedAt = nil
+			} else {
+				if out.CompletedAt == nil {
+					out.CompletedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CompletedAt).UnmarshalJSON(data))
+				}
+			}
+		case "archived_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ArchivedAt = nil
+			} else {
+				if out.ArchivedAt == nil {
+					out.ArchivedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ArchivedAt).UnmarshalJSON(data))
+				}
+			}
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
@@ -631,6 +885,84 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels1(
 		out.RawString(prefix)
 		out.Int(int(in.Position))
 	}
+	{
+		const prefix string = ",\"labels\":"
+		out.RawString(prefix)
+		if in.Labels == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v12, v13 := range in.Labels {
+				if v12 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels2(out, v13)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"assignees\":"
+		out.RawString(prefix)
+		if in.Assignees == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.Assignees {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels3(out, v15)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"checklist_progress\":"
+		out.RawString(prefix)
+		easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels4(out, in.ChecklistProgress)
+	}
+	{
+		const prefix string = ",\"comments_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.CommentsCount))
+	}
+	{
+		const prefix string = ",\"start_at\":"
+		out.RawString(prefix)
+		if in.StartAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.StartAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"due_at\":"
+		out.RawString(prefix)
+		if in.DueAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.DueAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"completed_at\":"
+		out.RawString(prefix)
+		if in.CompletedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.CompletedAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"archived_at\":"
+		out.RawString(prefix)
+		if in.ArchivedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ArchivedAt).MarshalJSON())
+		}
+	}
 	{
 		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
@@ -643,7 +975,7 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels1(
 	}
 	out.RawByte('}')
 }
-func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp4(in *jlexer.Lexer, out *getResponse) {
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels4(in *jlexer.Lexer, out *models.ChecklistProgress) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -662,19 +994,152 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDel
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = int(in.Int())
-		case "board_id":
-			out.BoardID = int(in.Int())
-		case "title":
-			out.Title = string(in.String())
-		case "position":
-			out.Position = int(in.Int())
-		case "created_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "updated_at":
+		case "done":
+			out.Done = int(in.Int())
+		case "total":
+			out.Total = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels4(out *jwriter.Writer, in models.ChecklistProgress) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"done\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Done))
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels3(in *jlexer.Lexer, out *models.Assignee) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "username":
+			out.Username = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "avatar":
+			if in.IsNull() {
+				in.Skip()
+				out.Avatar = nil
+			} else {
+				if out.Avatar == nil {
+					out.Avatar = new(string)
+				}
+				*out.Avatar = string(in.String())
+			}
+		case "assigned_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.AssignedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels3(out *jwriter.Writer, in models.Assignee) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"avatar\":"
+		out.RawString(prefix)
+		if in.Avatar == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Avatar))
+		}
+	}
+	{
+		const prefix string = ",\"assigned_at\":"
+		out.RawString(prefix)
+		out.Raw((in.AssignedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels2(in *jlexer.Lexer, out *models.Label) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "board_id":
+			out.BoardID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "color":
+			out.Color = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
 			}
@@ -688,7 +1153,100 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDel
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp4(out *jwriter.Writer, in getResponse) {
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels2(out *jwriter.Writer, in models.Label) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.BoardID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"color\":"
+		out.RawString(prefix)
+		out.String(string(in.Color))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp5(in *jlexer.Lexer, out *getResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "board_id":
+			out.BoardID = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "position":
+			out.Position = int(in.Int())
+		case "archived_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ArchivedAt = nil
+			} else {
+				if out.ArchivedAt == nil {
+					out.ArchivedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ArchivedAt).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp5(out *jwriter.Writer, in getResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -712,6 +1270,15 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDel
 		out.RawString(prefix)
 		out.Int(int(in.Position))
 	}
+	{
+		const prefix string = ",\"archived_at\":"
+		out.RawString(prefix)
+		if in.ArchivedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ArchivedAt).MarshalJSON())
+		}
+	}
 	{
 		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
@@ -728,27 +1295,27 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDel
 // MarshalJSON supports json.Marshaler interface
 func (v getResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp4(&w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v getResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp4(w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *getResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp4(&r, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *getResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp4(l, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp5(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp5(in *jlexer.Lexer, out *createResponse) {
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp6(in *jlexer.Lexer, out *createResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -793,7 +1360,7 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDel
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp5(out *jwriter.Writer, in createResponse) {
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp6(out *jwriter.Writer, in createResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -833,27 +1400,27 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDel
 // MarshalJSON supports json.Marshaler interface
 func (v createResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp5(&w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v createResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp5(w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *createResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp5(&r, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *createResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp5(l, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp6(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp6(in *jlexer.Lexer, out *createRequest) {
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp7(in *jlexer.Lexer, out *createRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -884,7 +1451,7 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDel
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp6(out *jwriter.Writer, in createRequest) {
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp7(out *jwriter.Writer, in createRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -899,23 +1466,143 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDel
 // MarshalJSON supports json.Marshaler interface
 func (v createRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp6(&w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v createRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp6(w, v)
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *createRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp6(&r, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *createRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp6(l, v)
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp7(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp8(in *jlexer.Lexer, out *copyRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "board_id":
+			out.BoardID = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "position":
+			if in.IsNull() {
+				in.Skip()
+				out.Position = nil
+			} else {
+				if out.Position == nil {
+					out.Position = new(int)
+				}
+				*out.Position = int(in.Int())
+			}
+		case "before_id":
+			out.BeforeID = int(in.Int())
+		case "after_id":
+			out.AfterID = int(in.Int())
+		case "checklists":
+			out.Checklists = bool(in.Bool())
+		case "labels":
+			out.Labels = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp8(out *jwriter.Writer, in copyRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.BoardID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		if in.Position == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.Position))
+		}
+	}
+	{
+		const prefix string = ",\"before_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.BeforeID))
+	}
+	{
+		const prefix string = ",\"after_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.AfterID))
+	}
+	{
+		const prefix string = ",\"checklists\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Checklists))
+	}
+	{
+		const prefix string = ",\"labels\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Labels))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v copyRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v copyRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *copyRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *copyRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalListsDeliveryHttp8(l, v)
 }
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	lists "github.com/SlavaShagalov/my-trello-backend/internal/lists"
 	models "github.com/SlavaShagalov/my-trello-backend/internal/models"
@@ -35,6 +36,21 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Copy mocks base method.
+func (m *MockRepository) Copy(params *lists.CopyParams) (models.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", params)
+	ret0, _ := ret[0].(models.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Copy indicates an expected call of Copy.
+func (mr *MockRepositoryMockRecorder) Copy(params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockRepository)(nil).Copy), params)
+}
+
 // Create mocks base method.
 func (m *MockRepository) Create(params *lists.CreateParams) (models.List, error) {
 	m.ctrl.T.Helper()
@@ -95,18 +111,18 @@ func (mr *MockRepositoryMockRecorder) Get(id interface{}) *gomock.Call {
 }
 
 // ListByBoard mocks base method.
-func (m *MockRepository) ListByBoard(boardID int) ([]models.List, error) {
+func (m *MockRepository) ListByBoard(boardID int, archived bool) ([]models.List, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListByBoard", boardID)
+	ret := m.ctrl.Call(m, "ListByBoard", boardID, archived)
 	ret0, _ := ret[0].([]models.List)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListByBoard indicates an expected call of ListByBoard.
-func (mr *MockRepositoryMockRecorder) ListByBoard(boardID interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) ListByBoard(boardID, archived interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByBoard", reflect.TypeOf((*MockRepository)(nil).ListByBoard), boardID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByBoard", reflect.TypeOf((*MockRepository)(nil).ListByBoard), boardID, archived)
 }
 
 // ListByTitle mocks base method.
@@ -124,6 +140,21 @@ func (mr *MockRepositoryMockRecorder) ListByTitle(title, userID interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTitle", reflect.TypeOf((*MockRepository)(nil).ListByTitle), title, userID)
 }
 
+// Move mocks base method.
+func (m *MockRepository) Move(params *lists.MoveParams) (models.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", params)
+	ret0, _ := ret[0].(models.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockRepositoryMockRecorder) Move(params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockRepository)(nil).Move), params)
+}
+
 // PartialUpdate mocks base method.
 func (m *MockRepository) PartialUpdate(params *lists.PartialUpdateParams) (models.List, error) {
 	m.ctrl.T.Helper()
@@ -138,3 +169,18 @@ func (mr *MockRepositoryMockRecorder) PartialUpdate(params interface{}) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PartialUpdate", reflect.TypeOf((*MockRepository)(nil).PartialUpdate), params)
 }
+
+// SetArchived mocks base method.
+func (m *MockRepository) SetArchived(id int, archivedAt *time.Time, userID int) (models.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetArchived", id, archivedAt, userID)
+	ret0, _ := ret[0].(models.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetArchived indicates an expected call of SetArchived.
+func (mr *MockRepositoryMockRecorder) SetArchived(id, archivedAt, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetArchived", reflect.TypeOf((*MockRepository)(nil).SetArchived), id, archivedAt, userID)
+}
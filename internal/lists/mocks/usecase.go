@@ -35,6 +35,36 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// Archive mocks base method.
+func (m *MockUsecase) Archive(id, userID int) (models.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", id, userID)
+	ret0, _ := ret[0].(models.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockUsecaseMockRecorder) Archive(id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockUsecase)(nil).Archive), id, userID)
+}
+
+// Copy mocks base method.
+func (m *MockUsecase) Copy(params *lists.CopyParams) (models.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", params)
+	ret0, _ := ret[0].(models.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Copy indicates an expected call of Copy.
+func (mr *MockUsecaseMockRecorder) Copy(params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockUsecase)(nil).Copy), params)
+}
+
 // Create mocks base method.
 func (m *MockUsecase) Create(params *lists.CreateParams) (models.List, error) {
 	m.ctrl.T.Helper()
@@ -95,18 +125,18 @@ func (mr *MockUsecaseMockRecorder) Get(id interface{}) *gomock.Call {
 }
 
 // ListByBoard mocks base method.
-func (m *MockUsecase) ListByBoard(boardID int) ([]models.List, error) {
+func (m *MockUsecase) ListByBoard(boardID int, archived bool) ([]models.List, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListByBoard", boardID)
+	ret := m.ctrl.Call(m, "ListByBoard", boardID, archived)
 	ret0, _ := ret[0].([]models.List)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListByBoard indicates an expected call of ListByBoard.
-func (mr *MockUsecaseMockRecorder) ListByBoard(boardID interface{}) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) ListByBoard(boardID, archived interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByBoard", reflect.TypeOf((*MockUsecase)(nil).ListByBoard), boardID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByBoard", reflect.TypeOf((*MockUsecase)(nil).ListByBoard), boardID, archived)
 }
 
 // ListByTitle mocks base method.
@@ -124,6 +154,21 @@ func (mr *MockUsecaseMockRecorder) ListByTitle(title, userID interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTitle", reflect.TypeOf((*MockUsecase)(nil).ListByTitle), title, userID)
 }
 
+// Move mocks base method.
+func (m *MockUsecase) Move(params *lists.MoveParams) (models.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", params)
+	ret0, _ := ret[0].(models.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockUsecaseMockRecorder) Move(params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockUsecase)(nil).Move), params)
+}
+
 // PartialUpdate mocks base method.
 func (m *MockUsecase) PartialUpdate(params *lists.PartialUpdateParams) (models.List, error) {
 	m.ctrl.T.Helper()
@@ -138,3 +183,18 @@ func (mr *MockUsecaseMockRecorder) PartialUpdate(params interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PartialUpdate", reflect.TypeOf((*MockUsecase)(nil).PartialUpdate), params)
 }
+
+// Unarchive mocks base method.
+func (m *MockUsecase) Unarchive(id int) (models.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unarchive", id)
+	ret0, _ := ret[0].(models.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unarchive indicates an expected call of Unarchive.
+func (mr *MockUsecaseMockRecorder) Unarchive(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unarchive", reflect.TypeOf((*MockUsecase)(nil).Unarchive), id)
+}
@@ -1,6 +1,10 @@
 package lists
 
-import "github.com/SlavaShagalov/my-trello-backend/internal/models"
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"math"
+	"time"
+)
 
 type CreateParams struct {
 	Title   string
@@ -8,10 +12,8 @@ type CreateParams struct {
 }
 
 type FullUpdateParams struct {
-	ID       int
-	Title    string
-	Position int
-	BoardID  int
+	ID    int
+	Title string
 }
 
 type PartialUpdateParams struct {
@@ -22,14 +24,56 @@ type PartialUpdateParams struct {
 	UpdatePosition bool
 	BoardID        int
 	UpdateBoardID  bool
+	BeforeID       int
+	AfterID        int
 }
 
+// MoveParams put the list on the board BoardID at Position, or between the
+// lists BeforeID and AfterID when either is set. Positions before the first or
+// past the last one put the list first or last.
+type MoveParams struct {
+	ID       int
+	BoardID  int
+	Position int
+	BeforeID int
+	AfterID  int
+}
+
+// CopyParams copy the list to the board BoardID, placed like MoveParams. The
+// copy gets the active cards of the list and, when asked, their checklists and
+// labels. An empty Title keeps the title of the list.
+type CopyParams struct {
+	ID         int
+	BoardID    int
+	Title      string
+	Position   int
+	BeforeID   int
+	AfterID    int
+	Checklists bool
+	Labels     bool
+}
+
+// LastPosition moves the list to the end of the board.
+const LastPosition = math.MaxInt32
+
 type Repository interface {
 	Create(params *CreateParams) (models.List, error)
-	ListByBoard(boardID int) ([]models.List, error)
+	// ListByBoard returns the active lists of the board in their order, or the
+	// archived ones with the last archived first.
+	ListByBoard(boardID int, archived bool) ([]models.List, error)
 	ListByTitle(title string, userID int) ([]models.List, error)
 	Get(id int) (models.List, error)
 	FullUpdate(params *FullUpdateParams) (models.List, error)
 	PartialUpdate(params *PartialUpdateParams) (models.List, error)
+	// Move moves the active list to an active board of the same workspace. Only
+	// the rank of the list changes.
+	Move(params *MoveParams) (models.List, error)
+	// Copy copies the active list to an active board of the same workspace in
+	// one transaction. Cards keep their order, labels missing on another board
+	// are created there.
+	Copy(params *CopyParams) (models.List, error)
+	// SetArchived archives the list by the user at archivedAt, or restores it when
+	// archivedAt is nil. An already archived list keeps its original archiving.
+	SetArchived(id int, archivedAt *time.Time, userID int) (models.List, error)
 	Delete(id int) error
 }
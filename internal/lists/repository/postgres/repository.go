@@ -6,9 +6,11 @@ import (
 	"github.com/SlavaShagalov/my-trello-backend/internal/models"
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
 	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/rank"
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
+	"time"
 )
 
 type repository struct {
@@ -20,48 +22,82 @@ func New(db *sql.DB, log *zap.Logger) pkgLists.Repository {
 	return &repository{db: db, log: log}
 }
 
-const createCmd = `
-	INSERT INTO lists (board_id, title, position) 
-	VALUES ($1, $2, (SELECT COALESCE(MAX(position), 0) + 1
-						FROM lists
-						WHERE board_id = $1))
-	RETURNING id, board_id, title, position, created_at, updated_at;`
+// positionCol counts the position of the list aliased as l among the active
+// lists of its board.
+const positionCol = `
+	(SELECT count(*) + 1
+	 FROM lists p
+	 WHERE p.board_id = l.board_id AND p.archived_at IS NULL AND p.rank < l.rank AND p.id <> l.id)`
 
-func (repo *repository) Create(params *pkgLists.CreateParams) (models.List, error) {
-	row := repo.db.QueryRow(createCmd, params.BoardID, params.Title)
+const (
+	lastRankCmd = `
+	SELECT max(rank)
+	FROM lists
+	WHERE board_id = $1;`
 
-	var list models.List
-	err := scanList(row, &list)
-	if err != nil {
-		pgErr, ok := err.(*pq.Error)
-		if !ok {
-			repo.log.Error("Cannot convert err to pq.Error", zap.Error(err))
-			return models.List{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	createCmd = `
+	INSERT INTO lists AS l (board_id, title, rank)
+	VALUES ($1, $2, $3)
+	RETURNING id, board_id, title,` + positionCol + `, archived_at, created_at, updated_at;`
+)
+
+// maxRankAttempts bounds retries of writes whose rank was taken by another
+// list meanwhile. Writes to the same place get the same rank and one of them
+// wins each time, so the bound is about the number of simultaneous writers.
+const maxRankAttempts = 10
+
+func (repo *repository) Create(params *pkgLists.CreateParams) (models.List, error) {
+	for attempt := 1; ; attempt++ {
+		list, err := repo.create(params)
+		if isRankConflict(err) && attempt < maxRankAttempts {
+			continue
 		}
-		if pgErr.Constraint == "lists_board_id_fkey" || pgErr.Code == "23502" {
-			return models.List{}, errors.Wrap(pkgErrors.ErrBoardNotFound, err.Error())
+		if err != nil {
+			pgErr, ok := err.(*pq.Error)
+			if !ok {
+				repo.log.Error("Cannot convert err to pq.Error", zap.Error(err))
+				return models.List{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+			}
+			if pgErr.Constraint == "lists_board_id_fkey" || pgErr.Code == "23502" {
+				return models.List{}, errors.Wrap(pkgErrors.ErrBoardNotFound, err.Error())
+			}
+
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", createCmd),
+				zap.Any("create_params", params))
+			return models.List{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
 		}
 
-		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", createCmd),
-			zap.Any("create_params", params))
-		return models.List{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		repo.log.Debug("New list created", zap.Any("list", list))
+		return list, nil
 	}
+}
 
-	repo.log.Debug("New list created", zap.Any("list", list))
-	return list, nil
+// create puts the list after all lists of the board, the archived ones too.
+func (repo *repository) create(params *pkgLists.CreateParams) (models.List, error) {
+	var last sql.NullString
+	err := repo.db.QueryRow(lastRankCmd, params.BoardID).Scan(&last)
+	if err != nil {
+		return models.List{}, err
+	}
+
+	row := repo.db.QueryRow(createCmd, params.BoardID, params.Title, rank.After(last.String))
+
+	var list models.List
+	err = scanList(row, &list)
+	return list, err
 }
 
 const listCmd = `
-	SELECT id, board_id, title, position, created_at, updated_at
-	FROM lists
-	WHERE board_id = $1
-	ORDER BY position;`
+	SELECT id, board_id, title,` + positionCol + `, archived_at, created_at, updated_at
+	FROM lists l
+	WHERE board_id = $1 AND (archived_at IS NOT NULL) = $2
+	ORDER BY archived_at DESC, rank;`
 
-func (repo *repository) ListByBoard(boardID int) ([]models.List, error) {
-	rows, err := repo.db.Query(listCmd, boardID)
+func (repo *repository) ListByBoard(boardID int, archived bool) ([]models.List, error) {
+	rows, err := repo.db.Query(listCmd, boardID, archived)
 	if err != nil {
 		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listCmd),
-			zap.Int("board_id", boardID))
+			zap.Int("board_id", boardID), zap.Bool("archived", archived))
 		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
 	}
 	defer func() {
@@ -69,16 +105,9 @@ func (repo *repository) ListByBoard(boardID int) ([]models.List, error) {
 	}()
 
 	lists := []models.List{}
-	var list models.List
 	for rows.Next() {
-		err = rows.Scan(
-			&list.ID,
-			&list.BoardID,
-			&list.Title,
-			&list.Position,
-			&list.CreatedAt,
-			&list.UpdatedAt,
-		)
+		var list models.List
+		err = scanList(rows, &list)
 		if err != nil {
 			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", listCmd),
 				zap.Int("board_id", boardID))
@@ -92,39 +121,33 @@ func (repo *repository) ListByBoard(boardID int) ([]models.List, error) {
 }
 
 const listByTitleCmd = `
-	SELECT l.id, l.board_id, l.title, l.position, l.created_at, l.updated_at
+	SELECT l.id, l.board_id, l.title,` + positionCol + `, l.archived_at, l.created_at, l.updated_at
 	FROM lists l
 	JOIN boards b on b.id = l.board_id
-	JOIN workspaces w on w.id = b.workspace_id
-	WHERE lower(l.title) LIKE lower('%' || $1 || '%') AND w.user_id = $2
-	ORDER BY position;`
+	JOIN workspace_members m on m.workspace_id = b.workspace_id
+	WHERE lower(l.title) LIKE lower('%' || $1 || '%') AND m.user_id = $2
+	  AND l.archived_at IS NULL AND b.archived_at IS NULL
+	ORDER BY l.board_id, l.rank;`
 
-func (repo *repository) ListByTitle(title string, boardID int) ([]models.List, error) {
-	rows, err := repo.db.Query(listByTitleCmd, title, boardID)
+func (repo *repository) ListByTitle(title string, userID int) ([]models.List, error) {
+	rows, err := repo.db.Query(listByTitleCmd, title, userID)
 	if err != nil {
-		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql", listByTitleCmd),
-			zap.String("title", title), zap.Int("board_id", boardID))
-		return nil, pkgErrors.ErrDb
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listByTitleCmd),
+			zap.String("title", title), zap.Int("user_id", userID))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
 	}
 	defer func() {
 		_ = rows.Close()
 	}()
 
 	lists := []models.List{}
-	var list models.List
 	for rows.Next() {
-		err = rows.Scan(
-			&list.ID,
-			&list.BoardID,
-			&list.Title,
-			&list.Position,
-			&list.CreatedAt,
-			&list.UpdatedAt,
-		)
+		var list models.List
+		err = scanList(rows, &list)
 		if err != nil {
-			repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listByTitleCmd),
-				zap.String("title", title), zap.Int("board_id", boardID))
-			return nil, pkgErrors.ErrDb
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", listByTitleCmd),
+				zap.String("title", title), zap.Int("user_id", userID))
+			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
 		}
 
 		lists = append(lists, list)
@@ -134,8 +157,8 @@ func (repo *repository) ListByTitle(title string, boardID int) ([]models.List, e
 }
 
 const getCmd = `
-	SELECT id, board_id, title, position, created_at, updated_at
-	FROM lists
+	SELECT id, board_id, title,` + positionCol + `, archived_at, created_at, updated_at
+	FROM lists l
 	WHERE id = $1;`
 
 func (repo *repository) Get(id int) (models.List, error) {
@@ -157,19 +180,21 @@ func (repo *repository) Get(id int) (models.List, error) {
 }
 
 const fullUpdateCmd = `
-	UPDATE lists
-	SET title    = $1,
-		position = $2,
-		board_id = $3
-	WHERE id = $4
-	RETURNING id, board_id, title, position, created_at, updated_at;`
+	UPDATE lists l
+	SET title = $1
+	WHERE id = $2
+	RETURNING id, board_id, title,` + positionCol + `, archived_at, created_at, updated_at;`
 
 func (repo *repository) FullUpdate(params *pkgLists.FullUpdateParams) (models.List, error) {
-	row := repo.db.QueryRow(fullUpdateCmd, params.Title, params.Position, params.BoardID, params.ID)
+	row := repo.db.QueryRow(fullUpdateCmd, params.Title, params.ID)
 
 	var list models.List
 	err := scanList(row, &list)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.List{}, errors.Wrap(pkgErrors.ErrListNotFound, err.Error())
+		}
+
 		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", fullUpdateCmd),
 			zap.Any("params", params))
 		return models.List{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
@@ -180,33 +205,14 @@ func (repo *repository) FullUpdate(params *pkgLists.FullUpdateParams) (models.Li
 }
 
 const partialUpdateCmd = `
-	UPDATE lists
-	SET title    = CASE WHEN $1 THEN $2 ELSE title END,
-		position = CASE WHEN $3 THEN $4 ELSE position END,
-		board_id = CASE WHEN $5 THEN $6 ELSE board_id END
-	WHERE id = $7
-	RETURNING id, board_id, title, position, created_at, updated_at;`
-
-const partialUpdateAfterCmd = `
-	CALL update_list_positions($1, $2);`
+	UPDATE lists l
+	SET title = CASE WHEN $1 THEN $2 ELSE title END
+	WHERE id = $3
+	RETURNING id, board_id, title,` + positionCol + `, archived_at, created_at, updated_at;`
 
+// PartialUpdate updates the title. Board and position changes go through Move.
 func (repo *repository) PartialUpdate(params *pkgLists.PartialUpdateParams) (models.List, error) {
-	if params.UpdatePosition {
-		_, err := repo.db.Exec(partialUpdateAfterCmd, params.Position, params.ID)
-		if err != nil {
-			return models.List{}, err
-		}
-	}
-
-	row := repo.db.QueryRow(partialUpdateCmd,
-		params.UpdateTitle,
-		params.Title,
-		params.UpdatePosition,
-		params.Position,
-		params.UpdateBoardID,
-		params.BoardID,
-		params.ID,
-	)
+	row := repo.db.QueryRow(partialUpdateCmd, params.UpdateTitle, params.Title, params.ID)
 
 	var list models.List
 	err := scanList(row, &list)
@@ -215,11 +221,6 @@ func (repo *repository) PartialUpdate(params *pkgLists.PartialUpdateParams) (mod
 			return models.List{}, pkgErrors.ErrListNotFound
 		}
 
-		pgErr, _ := err.(*pq.Error)
-		if pgErr.Constraint == "lists_board_id_fkey" || pgErr.Code == "23502" {
-			return models.List{}, errors.Wrap(pkgErrors.ErrBoardNotFound, err.Error())
-		}
-
 		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", partialUpdateCmd),
 			zap.Any("params", params))
 		return models.List{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
@@ -227,8 +228,512 @@ func (repo *repository) PartialUpdate(params *pkgLists.PartialUpdateParams) (mod
 
 	repo.log.Debug("ListByWorkspace partial updated", zap.Any("list", list))
 	return list, nil
+}
+
+const (
+	listBoardCmd = `
+	SELECT board_id
+	FROM lists
+	WHERE id = $1;`
+
+	// lockBoardsCmd locks the source and target boards in the order of their
+	// ids. Moves lock boards before lists, so that moves between the same boards
+	// in both directions do not deadlock. The boards are not archived meanwhile.
+	lockBoardsCmd = `
+	SELECT id, workspace_id, archived_at IS NOT NULL
+	FROM boards
+	WHERE id IN ($1, $2)
+	ORDER BY id
+	FOR UPDATE;`
+
+	lockListCmd = `
+	SELECT board_id, archived_at IS NOT NULL
+	FROM lists
+	WHERE id = $1
+	FOR UPDATE;`
+
+	// neighborRankCmd returns the rank of the active list $1 of the board $2
+	// other than the moved list $3.
+	neighborRankCmd = `
+	SELECT rank
+	FROM lists
+	WHERE id = $1 AND board_id = $2 AND archived_at IS NULL AND id <> $3;`
+
+	// precedingRankCmd returns the rank of the last of the first $3 active lists
+	// of the board $1 without the moved list $2.
+	precedingRankCmd = `
+	SELECT max(rank)
+	FROM (SELECT rank
+		  FROM lists
+		  WHERE board_id = $1 AND archived_at IS NULL AND id <> $2
+		  ORDER BY rank
+		  LIMIT $3) r;`
+
+	// prevRankCmd returns the greatest rank of the board $1 below $3.
+	prevRankCmd = `
+	SELECT max(rank)
+	FROM lists
+	WHERE board_id = $1 AND id <> $2 AND rank < $3;`
+
+	// nextRankCmd returns the least rank of the board $1 above $3, or the least
+	// one when $3 is NULL, of all and of active lists. Archived lists keep their
+	// ranks, so the new rank must not take theirs.
+	nextRankCmd = `
+	SELECT min(rank), min(rank) FILTER (WHERE archived_at IS NULL)
+	FROM lists
+	WHERE board_id = $1 AND id <> $2 AND ($3::varchar IS NULL OR rank > $3);`
+
+	moveCmd = `
+	UPDATE lists l
+	SET board_id = $1,
+		rank     = $2
+	WHERE id = $3 AND archived_at IS NULL
+	RETURNING id, board_id, title,` + positionCol + `, archived_at, created_at, updated_at;`
+
+	// moveLabelsCmd creates on the board of the list $1 the labels of its cards
+	// from another board that the board has no label of the same name and color
+	// for.
+	moveLabelsCmd = `
+	INSERT INTO labels (board_id, name, color)
+	SELECT DISTINCT l.board_id, lb.name, lb.color
+	FROM lists l
+	JOIN cards c on c.list_id = l.id
+	JOIN card_labels cl on cl.card_id = c.id
+	JOIN labels lb on lb.id = cl.label_id
+	WHERE l.id = $1 AND lb.board_id <> l.board_id
+	  AND NOT EXISTS(SELECT 1 FROM labels t WHERE t.board_id = l.board_id AND t.name = lb.name AND t.color = lb.color);`
+
+	// moveCardLabelsCmd attaches to the cards of the list $1 the first label of
+	// its board of the same name and color as each of their labels from another
+	// board.
+	moveCardLabelsCmd = `
+	INSERT INTO card_labels (card_id, label_id)
+	SELECT DISTINCT ON (c.id, lb.id) c.id, t.id
+	FROM lists l
+	JOIN cards c on c.list_id = l.id
+	JOIN card_labels cl on cl.card_id = c.id
+	JOIN labels lb on lb.id = cl.label_id
+	JOIN labels t on t.board_id = l.board_id AND t.name = lb.name AND t.color = lb.color
+	WHERE l.id = $1 AND lb.board_id <> l.board_id
+	ORDER BY c.id, lb.id, t.id
+	ON CONFLICT DO NOTHING;`
+
+	// detachLabelsCmd detaches from the cards of the list $1 the labels of other
+	// boards.
+	detachLabelsCmd = `
+	DELETE FROM card_labels cl
+	USING cards c, lists l, labels lb
+	WHERE l.id = $1 AND c.list_id = l.id AND cl.card_id = c.id AND lb.id = cl.label_id
+	  AND lb.board_id <> l.board_id;`
+)
+
+// errMovedMeanwhile is returned when the list was moved by another transaction
+// between reading its board and locking it.
+var errMovedMeanwhile = errors.New("list moved meanwhile")
+
+func (repo *repository) Move(params *pkgLists.MoveParams) (models.List, error) {
+	for attempt := 1; ; attempt++ {
+		list, err := repo.move(params)
+		if (isRankConflict(err) || errors.Is(err, errMovedMeanwhile)) && attempt < maxRankAttempts {
+			continue
+		}
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return models.List{}, errors.Wrap(pkgErrors.ErrListNotFound, err.Error())
+			}
+			if isRefused(err) {
+				return models.List{}, err
+			}
+
+			repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", moveCmd),
+				zap.Any("params", params))
+			return models.List{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+
+		repo.log.Debug("List moved", zap.Any("list", list))
+		return list, nil
+	}
+}
+
+// move gives the list a rank between its new neighbors. Only its row is written.
+func (repo *repository) move(params *pkgLists.MoveParams) (models.List, error) {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		return models.List{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	err = lockMove(repo.db, tx, params.ID, params.BoardID)
+	if err != nil {
+		return models.List{}, err
+	}
+
+	newRank, err := rankAt(tx, params)
+	if err != nil {
+		return models.List{}, err
+	}
+
+	var list models.List
+	err = scanList(tx.QueryRow(moveCmd, params.BoardID, newRank, params.ID), &list)
+	if err != nil {
+		return models.List{}, err
+	}
+
+	// The cards take the labels of the new board in place of the old ones.
+	for _, cmd := range []string{moveLabelsCmd, moveCardLabelsCmd, detachLabelsCmd} {
+		_, err = tx.Exec(cmd, list.ID)
+		if err != nil {
+			return models.List{}, err
+		}
+	}
+
+	return list, tx.Commit()
+}
+
+// lockMove locks the boards and the list and checks that the list can be moved,
+// or copied, to the board. The checks hold until the end of the transaction.
+func lockMove(db *sql.DB, tx *sql.Tx, id, toBoardID int) error {
+	var fromBoardID int
+	err := db.QueryRow(listBoardCmd, id).Scan(&fromBoardID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := tx.Query(lockBoardsCmd, fromBoardID, toBoardID)
+	if err != nil {
+		return err
+	}
+	workspaces := make(map[int]int, 2)
+	var toArchived bool
+	for rows.Next() {
+		var boardID, workspaceID int
+		var archived bool
+		err = rows.Scan(&boardID, &workspaceID, &archived)
+		if err != nil {
+			_ = rows.Close()
+			return err
+		}
+		workspaces[boardID] = workspaceID
+		if boardID == toBoardID {
+			toArchived = archived
+		}
+	}
+	_ = rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	var boardID int
+	var archived bool
+	err = tx.QueryRow(lockListCmd, id).Scan(&boardID, &archived)
+	if err != nil {
+		return err
+	}
+
+	toWorkspaceID, ok := workspaces[toBoardID]
+	switch {
+	case boardID != fromBoardID:
+		return errMovedMeanwhile
+	case archived:
+		return pkgErrors.ErrListArchivedMove
+	case !ok:
+		return pkgErrors.ErrBoardNotFound
+	case toWorkspaceID != workspaces[fromBoardID]:
+		return pkgErrors.ErrListMoveOtherWorkspace
+	case toArchived:
+		return pkgErrors.ErrBoardArchived
+	}
+	return nil
+}
+
+// isRefused reports whether the move or copy is refused by its checks rather
+// than failed.
+func isRefused(err error) bool {
+	for _, refusal := range []error{
+		pkgErrors.ErrListArchivedMove,
+		pkgErrors.ErrBoardNotFound,
+		pkgErrors.ErrListMoveOtherWorkspace,
+		pkgErrors.ErrBoardArchived,
+		pkgErrors.ErrBadListNeighbors,
+	} {
+		if errors.Is(err, refusal) {
+			return true
+		}
+	}
+	return false
+}
+
+// rankAt returns a rank that puts the list where params say.
+func rankAt(q queryer, params *pkgLists.MoveParams) (string, error) {
+	var prev, next, nextActive sql.NullString
+	var err error
+	switch {
+	case params.BeforeID != 0:
+		err = q.QueryRow(neighborRankCmd, params.BeforeID, params.BoardID, params.ID).Scan(&prev)
+	case params.AfterID != 0:
+		var after string
+		err = q.QueryRow(neighborRankCmd, params.AfterID, params.BoardID, params.ID).Scan(&after)
+		if err == nil {
+			err = q.QueryRow(prevRankCmd, params.BoardID, params.ID, after).Scan(&prev)
+		}
+	default:
+		position := params.Position
+		if position < 1 {
+			position = 1
+		}
+		err = q.QueryRow(precedingRankCmd, params.BoardID, params.ID, position-1).Scan(&prev)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", pkgErrors.ErrBadListNeighbors
+	}
+	if err != nil {
+		return "", err
+	}
+
+	err = q.QueryRow(nextRankCmd, params.BoardID, params.ID, prev).Scan(&next, &nextActive)
+	if err != nil {
+		return "", err
+	}
+
+	if params.BeforeID != 0 && params.AfterID != 0 {
+		// The lists must be adjacent, but archived lists may lie between them.
+		var after string
+		err = q.QueryRow(neighborRankCmd, params.AfterID, params.BoardID, params.ID).Scan(&after)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return "", err
+		}
+		if err != nil || nextActive.String != after {
+			return "", pkgErrors.ErrBadListNeighbors
+		}
+	}
+
+	return rank.Between(prev.String, next.String)
+}
+
+const (
+	copyListCmd = `
+	INSERT INTO lists AS l (board_id, title, rank)
+	SELECT $1::int, CASE WHEN $2 = '' THEN title ELSE $2 END, $3::varchar
+	FROM lists
+	WHERE id = $4
+	RETURNING id, board_id, title,` + positionCol + `, archived_at, created_at, updated_at;`
+
+	// copyCardsCmd copies the active cards of the list $1 to the list $2 with
+	// their ranks, so the copies are matched to the cards by rank later.
+	copyCardsCmd = `
+	INSERT INTO cards (list_id, title, content, rank, start_at, due_at, completed_at)
+	SELECT $2::int, title, content, rank, start_at, due_at, completed_at
+	FROM cards
+	WHERE list_id = $1 AND archived_at IS NULL;`
+
+	// copiedChecklistsCmd returns the checklists of the cards copied from the
+	// list $1 to the list $2 with the copies of their cards.
+	copiedChecklistsCmd = `
+	SELECT ch.id, dst.id, ch.title, ch.position
+	FROM cards src
+	JOIN cards dst on dst.list_id = $2 AND dst.rank = src.rank
+	JOIN checklists ch on ch.card_id = src.id
+	WHERE src.list_id = $1 AND src.archived_at IS NULL
+	ORDER BY ch.id;`
+
+	copyChecklistCmd = `
+	INSERT INTO checklists (card_id, title, position)
+	VALUES ($1, $2, $3)
+	RETURNING id;`
+
+	copyChecklistItemsCmd = `
+	INSERT INTO checklist_items (checklist_id, title, done, position)
+	SELECT $2::int, title, done, position
+	FROM checklist_items
+	WHERE checklist_id = $1;`
+
+	// copyLabelsCmd creates on the board $2 the labels of the cards copied
+	// from the list $1 that the board has no label of the same name and color
+	// for.
+	copyLabelsCmd = `
+	INSERT INTO labels (board_id, name, color)
+	SELECT DISTINCT $2::int, lb.name, lb.color
+	FROM cards src
+	JOIN card_labels cl on cl.card_id = src.id
+	JOIN labels lb on lb.id = cl.label_id
+	WHERE src.list_id = $1 AND src.archived_at IS NULL
+	  AND NOT EXISTS(SELECT 1 FROM labels t WHERE t.board_id = $2 AND t.name = lb.name AND t.color = lb.color);`
+
+	// copyCardLabelsCmd attaches to the cards copied to the list $2 the labels
+	// of the board $3 that match the labels of their cards: the same labels on
+	// the same board, the first of the same name and color on another one.
+	copyCardLabelsCmd = `
+	INSERT INTO card_labels (card_id, label_id)
+	SELECT DISTINCT ON (dst.id, lb.id) dst.id, t.id
+	FROM cards src
+	JOIN cards dst on dst.list_id = $2 AND dst.rank = src.rank
+	JOIN card_labels cl on cl.card_id = src.id
+	JOIN labels lb on lb.id = cl.label_id
+	JOIN labels t on t.board_id = $3 AND
+					 (t.id = lb.id OR lb.board_id <> $3 AND t.name = lb.name AND t.color = lb.color)
+	WHERE src.list_id = $1 AND src.archived_at IS NULL
+	ORDER BY dst.id, lb.id, t.id
+	ON CONFLICT DO NOTHING;`
+)
+
+func (repo *repository) Copy(params *pkgLists.CopyParams) (models.List, error) {
+	for attempt := 1; ; attempt++ {
+		list, err := repo.copy(params)
+		if (isRankConflict(err) || errors.Is(err, errMovedMeanwhile)) && attempt < maxRankAttempts {
+			continue
+		}
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return models.List{}, errors.Wrap(pkgErrors.ErrListNotFound, err.Error())
+			}
+			if isRefused(err) {
+				return models.List{}, err
+			}
+
+			repo.log.Error(constants.DBError, zap.Error(err), zap.Any("params", params))
+			return models.List{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
 
-	//return convert.ListByWorkspace{}, nil
+		repo.log.Debug("List copied", zap.Int("from", params.ID), zap.Any("list", list))
+		return list, nil
+	}
+}
+
+// copy copies the list and its cards, then their checklists and labels.
+func (repo *repository) copy(params *pkgLists.CopyParams) (models.List, error) {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		return models.List{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	err = lockMove(repo.db, tx, params.ID, params.BoardID)
+	if err != nil {
+		return models.List{}, err
+	}
+
+	newRank, err := rankAt(tx, &pkgLists.MoveParams{
+		BoardID:  params.BoardID,
+		Position: params.Position,
+		BeforeID: params.BeforeID,
+		AfterID:  params.AfterID,
+	})
+	if err != nil {
+		return models.List{}, err
+	}
+
+	var list models.List
+	err = scanList(tx.QueryRow(copyListCmd, params.BoardID, params.Title, newRank, params.ID), &list)
+	if err != nil {
+		return models.List{}, err
+	}
+
+	_, err = tx.Exec(copyCardsCmd, params.ID, list.ID)
+	if err != nil {
+		return models.List{}, err
+	}
+
+	if params.Checklists {
+		err = copyChecklists(tx, params.ID, list.ID)
+		if err != nil {
+			return models.List{}, err
+		}
+	}
+
+	if params.Labels {
+		_, err = tx.Exec(copyLabelsCmd, params.ID, params.BoardID)
+		if err != nil {
+			return models.List{}, err
+		}
+		_, err = tx.Exec(copyCardLabelsCmd, params.ID, list.ID, params.BoardID)
+		if err != nil {
+			return models.List{}, err
+		}
+	}
+
+	return list, tx.Commit()
+}
+
+func copyChecklists(tx *sql.Tx, fromListID, toListID int) error {
+	rows, err := tx.Query(copiedChecklistsCmd, fromListID, toListID)
+	if err != nil {
+		return err
+	}
+	type checklist struct {
+		id       int
+		cardID   int
+		title    string
+		position int
+	}
+	var checklists []checklist
+	for rows.Next() {
+		var ch checklist
+		err = rows.Scan(&ch.id, &ch.cardID, &ch.title, &ch.position)
+		if err != nil {
+			_ = rows.Close()
+			return err
+		}
+		checklists = append(checklists, ch)
+	}
+	_ = rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	for _, ch := range checklists {
+		var id int
+		err = tx.QueryRow(copyChecklistCmd, ch.cardID, ch.title, ch.position).Scan(&id)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(copyChecklistItemsCmd, ch.id, id)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isRankConflict reports whether the rank was taken by another list, or the
+// ranks around it were rebalanced, meanwhile.
+func isRankConflict(err error) bool {
+	var pgErr *pq.Error
+	if errors.As(err, &pgErr) {
+		return pgErr.Constraint == "lists_rank_key"
+	}
+	return errors.Is(err, rank.ErrOrder)
+}
+
+// setArchivedCmd keeps the rank of the list, so a restored list gets back
+// between the lists it was archived from.
+const setArchivedCmd = `
+	UPDATE lists l
+	SET archived_at = CASE WHEN $1::timestamp IS NULL THEN NULL ELSE COALESCE(archived_at, $1) END,
+		archived_by = CASE WHEN $1::timestamp IS NULL THEN NULL WHEN archived_at IS NULL THEN $3 ELSE archived_by END
+	WHERE id = $2
+	RETURNING id, board_id, title,` + positionCol + `, archived_at, created_at, updated_at;`
+
+func (repo *repository) SetArchived(id int, archivedAt *time.Time, userID int) (models.List, error) {
+	row := repo.db.QueryRow(setArchivedCmd, archivedAt, id, userID)
+
+	var list models.List
+	err := scanList(row, &list)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.List{}, errors.Wrap(pkgErrors.ErrListNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", setArchivedCmd),
+			zap.Int("id", id))
+		return models.List{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("List archiving updated", zap.Any("list", list))
+	return list, nil
 }
 
 const deleteCmd = `
@@ -258,13 +763,32 @@ func (repo *repository) Delete(id int) error {
 	return nil
 }
 
-func scanList(row *sql.Row, list *models.List) error {
-	return row.Scan(
+// queryer is the database or a transaction.
+type queryer interface {
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanList(row scanner, list *models.List) error {
+	var archivedAt sql.NullTime
+	err := row.Scan(
 		&list.ID,
 		&list.BoardID,
 		&list.Title,
 		&list.Position,
+		&archivedAt,
 		&list.CreatedAt,
 		&list.UpdatedAt,
 	)
+	if err != nil {
+		return err
+	}
+
+	if archivedAt.Valid {
+		list.ArchivedAt = &archivedAt.Time
+	}
+	return nil
 }
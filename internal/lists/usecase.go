@@ -6,10 +6,15 @@ import (
 
 type Usecase interface {
 	Create(params *CreateParams) (models.List, error)
-	ListByBoard(boardID int) ([]models.List, error)
+	ListByBoard(boardID int, archived bool) ([]models.List, error)
 	ListByTitle(title string, userID int) ([]models.List, error)
 	Get(id int) (models.List, error)
 	FullUpdate(params *FullUpdateParams) (models.List, error)
+	// PartialUpdate moves the list first when its board or position changes.
 	PartialUpdate(params *PartialUpdateParams) (models.List, error)
+	Move(params *MoveParams) (models.List, error)
+	Copy(params *CopyParams) (models.List, error)
+	Archive(id, userID int) (models.List, error)
+	Unarchive(id int) (models.List, error)
 	Delete(id int) error
 }
@@ -1,24 +1,36 @@
 package usecase
 
 import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/events"
 	"github.com/SlavaShagalov/my-trello-backend/internal/lists"
 	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"time"
 )
 
 type usecase struct {
 	repo lists.Repository
+	bus  events.Bus
 }
 
-func New(repo lists.Repository) lists.Usecase {
-	return &usecase{repo: repo}
+func New(repo lists.Repository, bus events.Bus) lists.Usecase {
+	return &usecase{
+		repo: repo,
+		bus:  bus,
+	}
 }
 
 func (uc *usecase) Create(params *lists.CreateParams) (models.List, error) {
-	return uc.repo.Create(params)
+	list, err := uc.repo.Create(params)
+	if err != nil {
+		return list, err
+	}
+
+	uc.bus.Publish(events.New(models.EventListCreated, list.BoardID, list))
+	return list, nil
 }
 
-func (uc *usecase) ListByBoard(userID int) ([]models.List, error) {
-	return uc.repo.ListByBoard(userID)
+func (uc *usecase) ListByBoard(boardID int, archived bool) ([]models.List, error) {
+	return uc.repo.ListByBoard(boardID, archived)
 }
 
 func (uc *usecase) ListByTitle(title string, userID int) ([]models.List, error) {
@@ -30,13 +42,114 @@ func (uc *usecase) Get(id int) (models.List, error) {
 }
 
 func (uc *usecase) FullUpdate(params *lists.FullUpdateParams) (models.List, error) {
-	return uc.repo.FullUpdate(params)
+	list, err := uc.repo.FullUpdate(params)
+	if err != nil {
+		return list, err
+	}
+
+	uc.bus.Publish(events.New(models.EventListUpdated, list.BoardID, list))
+	return list, nil
 }
 
 func (uc *usecase) PartialUpdate(params *lists.PartialUpdateParams) (models.List, error) {
-	return uc.repo.PartialUpdate(params)
+	if params.UpdateBoardID || params.UpdatePosition || params.BeforeID != 0 || params.AfterID != 0 {
+		from, err := uc.repo.Get(params.ID)
+		if err != nil {
+			return from, err
+		}
+
+		moveParams := lists.MoveParams{
+			ID:       params.ID,
+			BoardID:  from.BoardID,
+			Position: lists.LastPosition,
+			BeforeID: params.BeforeID,
+			AfterID:  params.AfterID,
+		}
+		if params.UpdateBoardID {
+			moveParams.BoardID = params.BoardID
+		}
+		if params.UpdatePosition {
+			moveParams.Position = params.Position
+		}
+		list, err := uc.move(&moveParams, from.BoardID)
+		if err != nil || !params.UpdateTitle {
+			return list, err
+		}
+	}
+
+	list, err := uc.repo.PartialUpdate(params)
+	if err != nil {
+		return list, err
+	}
+
+	uc.bus.Publish(events.New(models.EventListUpdated, list.BoardID, list))
+	return list, nil
+}
+
+func (uc *usecase) Move(params *lists.MoveParams) (models.List, error) {
+	from, err := uc.repo.Get(params.ID)
+	if err != nil {
+		return from, err
+	}
+
+	return uc.move(params, from.BoardID)
+}
+
+// move moves the list and notifies both boards when the list leaves its board.
+func (uc *usecase) move(params *lists.MoveParams, fromBoardID int) (models.List, error) {
+	list, err := uc.repo.Move(params)
+	if err != nil {
+		return list, err
+	}
+
+	uc.bus.Publish(events.New(models.EventListMoved, list.BoardID, list))
+	if fromBoardID != list.BoardID {
+		uc.bus.Publish(events.New(models.EventListMoved, fromBoardID, list))
+	}
+	return list, nil
+}
+
+func (uc *usecase) Copy(params *lists.CopyParams) (models.List, error) {
+	list, err := uc.repo.Copy(params)
+	if err != nil {
+		return list, err
+	}
+
+	uc.bus.Publish(events.New(models.EventListCreated, list.BoardID, list))
+	return list, nil
+}
+
+func (uc *usecase) Archive(id, userID int) (models.List, error) {
+	now := time.Now().UTC()
+	return uc.setArchived(id, &now, userID)
+}
+
+func (uc *usecase) Unarchive(id int) (models.List, error) {
+	return uc.setArchived(id, nil, 0)
+}
+
+func (uc *usecase) setArchived(id int, archivedAt *time.Time, userID int) (models.List, error) {
+	list, err := uc.repo.SetArchived(id, archivedAt, userID)
+	if err != nil {
+		return list, err
+	}
+
+	uc.bus.Publish(events.New(models.EventListUpdated, list.BoardID, list))
+	return list, nil
 }
 
 func (uc *usecase) Delete(id int) error {
-	return uc.repo.Delete(id)
+	list, err := uc.repo.Get(id)
+	if err != nil {
+		return err
+	}
+
+	err = uc.repo.Delete(id)
+	if err != nil {
+		return err
+	}
+
+	uc.bus.Publish(events.New(models.EventListDeleted, list.BoardID,
+		map[string]int{"id": list.ID, "board_id": list.BoardID}))
+	return nil
 }
@@ -1,6 +1,8 @@
 package usecase
 
 import (
+	"fmt"
+	eventsMocks "github.com/SlavaShagalov/my-trello-backend/internal/events/mocks"
 	pkgLists "github.com/SlavaShagalov/my-trello-backend/internal/lists"
 	"github.com/SlavaShagalov/my-trello-backend/internal/lists/mocks"
 	"github.com/SlavaShagalov/my-trello-backend/internal/models"
@@ -9,11 +11,13 @@ import (
 	"github.com/pkg/errors"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestUsecase_Create(t *testing.T) {
 	type fields struct {
 		repo   *mocks.MockRepository
+		bus    *eventsMocks.MockBus
 		params *pkgLists.CreateParams
 		list   *models.List
 	}
@@ -29,6 +33,7 @@ func TestUsecase_Create(t *testing.T) {
 		"normal": {
 			prepare: func(f *fields) {
 				f.repo.EXPECT().Create(f.params).Return(*f.list, nil)
+				f.bus.EXPECT().Publish(event{models.EventListCreated, 27})
 			},
 			params: &pkgLists.CreateParams{
 				Title:   "MathStat",
@@ -63,12 +68,12 @@ func TestUsecase_Create(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			f := fields{repo: mocks.NewMockRepository(ctrl), params: test.params, list: &test.list}
+			f := fields{repo: mocks.NewMockRepository(ctrl), bus: eventsMocks.NewMockBus(ctrl), params: test.params, list: &test.list}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := New(f.repo)
+			uc := New(f.repo, f.bus)
 			list, err := uc.Create(test.params)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
@@ -97,7 +102,7 @@ func TestUsecase_List(t *testing.T) {
 	tests := map[string]testCase{
 		"normal": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().ListByBoard(f.boardID).Return(f.lists, nil)
+				f.repo.EXPECT().ListByBoard(f.boardID, false).Return(f.lists, nil)
 			},
 			boardID: 27,
 			lists: []models.List{
@@ -109,7 +114,7 @@ func TestUsecase_List(t *testing.T) {
 		},
 		"empty result": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().ListByBoard(f.boardID).Return(f.lists, nil)
+				f.repo.EXPECT().ListByBoard(f.boardID, false).Return(f.lists, nil)
 			},
 			boardID: 27,
 			lists:   []models.List{},
@@ -117,7 +122,7 @@ func TestUsecase_List(t *testing.T) {
 		},
 		"board not found": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().ListByBoard(f.boardID).Return(f.lists, pkgErrors.ErrBoardNotFound)
+				f.repo.EXPECT().ListByBoard(f.boardID, false).Return(f.lists, pkgErrors.ErrBoardNotFound)
 			},
 			boardID: 27,
 			lists:   nil,
@@ -125,7 +130,7 @@ func TestUsecase_List(t *testing.T) {
 		},
 		"storages error": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().ListByBoard(f.boardID).Return(f.lists, pkgErrors.ErrDb)
+				f.repo.EXPECT().ListByBoard(f.boardID, false).Return(f.lists, pkgErrors.ErrDb)
 			},
 			boardID: 27,
 			lists:   nil,
@@ -146,8 +151,8 @@ func TestUsecase_List(t *testing.T) {
 				test.prepare(&f)
 			}
 
-			serv := New(f.repo)
-			lists, err := serv.ListByBoard(test.boardID)
+			serv := New(f.repo, nil)
+			lists, err := serv.ListByBoard(test.boardID, false)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
@@ -212,7 +217,7 @@ func TestUsecase_Get(t *testing.T) {
 				test.prepare(&f)
 			}
 
-			uc := New(f.repo)
+			uc := New(f.repo, nil)
 			list, err := uc.Get(test.id)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
@@ -227,6 +232,7 @@ func TestUsecase_Get(t *testing.T) {
 func TestFullUpdate(t *testing.T) {
 	type fields struct {
 		repo   *mocks.MockRepository
+		bus    *eventsMocks.MockBus
 		params *pkgLists.FullUpdateParams
 		list   *models.List
 	}
@@ -242,8 +248,9 @@ func TestFullUpdate(t *testing.T) {
 		"normal": {
 			prepare: func(f *fields) {
 				f.repo.EXPECT().FullUpdate(f.params).Return(*f.list, nil)
+				f.bus.EXPECT().Publish(event{models.EventListUpdated, 27})
 			},
-			params: &pkgLists.FullUpdateParams{ID: 21, Title: "MathStat", Position: 41, BoardID: 27},
+			params: &pkgLists.FullUpdateParams{ID: 21, Title: "MathStat"},
 			list:   models.List{ID: 21, BoardID: 27, Title: "MathStat", Position: 41},
 			err:    nil,
 		},
@@ -257,12 +264,12 @@ func TestFullUpdate(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			f := fields{repo: mocks.NewMockRepository(ctrl), params: test.params, list: &test.list}
+			f := fields{repo: mocks.NewMockRepository(ctrl), bus: eventsMocks.NewMockBus(ctrl), params: test.params, list: &test.list}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := New(f.repo)
+			uc := New(f.repo, f.bus)
 			list, err := uc.FullUpdate(test.params)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
@@ -277,6 +284,7 @@ func TestFullUpdate(t *testing.T) {
 func TestPartialUpdate(t *testing.T) {
 	type fields struct {
 		repo   *mocks.MockRepository
+		bus    *eventsMocks.MockBus
 		params *pkgLists.PartialUpdateParams
 		list   *models.List
 	}
@@ -291,7 +299,13 @@ func TestPartialUpdate(t *testing.T) {
 	tests := map[string]testCase{
 		"normal": {
 			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(21).Return(models.List{ID: 21, BoardID: 26}, nil)
+				f.repo.EXPECT().Move(&pkgLists.MoveParams{ID: 21, BoardID: 27, Position: 41}).
+					Return(models.List{ID: 21, BoardID: 27, Position: 41}, nil)
+				f.bus.EXPECT().Publish(event{models.EventListMoved, 27})
+				f.bus.EXPECT().Publish(event{models.EventListMoved, 26})
 				f.repo.EXPECT().PartialUpdate(f.params).Return(*f.list, nil)
+				f.bus.EXPECT().Publish(event{models.EventListUpdated, 27})
 			},
 			params: &pkgLists.PartialUpdateParams{
 				ID:             21,
@@ -305,6 +319,26 @@ func TestPartialUpdate(t *testing.T) {
 			list: models.List{ID: 21, BoardID: 27, Title: "MathStat", Position: 41},
 			err:  nil,
 		},
+		"only neighbors": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(21).Return(models.List{ID: 21, BoardID: 27}, nil)
+				f.repo.EXPECT().Move(&pkgLists.MoveParams{ID: 21, BoardID: 27, Position: pkgLists.LastPosition,
+					BeforeID: 30}).Return(*f.list, nil)
+				f.bus.EXPECT().Publish(event{models.EventListMoved, 27})
+			},
+			params: &pkgLists.PartialUpdateParams{ID: 21, BeforeID: 30},
+			list:   models.List{ID: 21, BoardID: 27, Title: "MathStat", Position: 3},
+			err:    nil,
+		},
+		"only title": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().PartialUpdate(f.params).Return(*f.list, nil)
+				f.bus.EXPECT().Publish(event{models.EventListUpdated, 27})
+			},
+			params: &pkgLists.PartialUpdateParams{ID: 21, Title: "MathStat", UpdateTitle: true},
+			list:   models.List{ID: 21, BoardID: 27, Title: "MathStat", Position: 3},
+			err:    nil,
+		},
 	}
 
 	for name, test := range tests {
@@ -315,12 +349,12 @@ func TestPartialUpdate(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			f := fields{repo: mocks.NewMockRepository(ctrl), params: test.params, list: &test.list}
+			f := fields{repo: mocks.NewMockRepository(ctrl), bus: eventsMocks.NewMockBus(ctrl), params: test.params, list: &test.list}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := New(f.repo)
+			uc := New(f.repo, f.bus)
 			list, err := uc.PartialUpdate(test.params)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
@@ -332,9 +366,143 @@ func TestPartialUpdate(t *testing.T) {
 	}
 }
 
+func TestUsecase_Move(t *testing.T) {
+	type fields struct {
+		repo   *mocks.MockRepository
+		bus    *eventsMocks.MockBus
+		params *pkgLists.MoveParams
+	}
+
+	type testCase struct {
+		prepare func(f *fields)
+		params  *pkgLists.MoveParams
+		list    models.List
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"within board": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(21).Return(models.List{ID: 21, BoardID: 9}, nil)
+				f.repo.EXPECT().Move(f.params).Return(models.List{ID: 21, BoardID: 9, Position: 2}, nil)
+				f.bus.EXPECT().Publish(event{models.EventListMoved, 9})
+			},
+			params: &pkgLists.MoveParams{ID: 21, BoardID: 9, BeforeID: 30, AfterID: 31},
+			list:   models.List{ID: 21, BoardID: 9, Position: 2},
+		},
+		"to other board": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(21).Return(models.List{ID: 21, BoardID: 9}, nil)
+				f.repo.EXPECT().Move(f.params).Return(models.List{ID: 21, BoardID: 12, Position: 1}, nil)
+				f.bus.EXPECT().Publish(event{models.EventListMoved, 12})
+				f.bus.EXPECT().Publish(event{models.EventListMoved, 9})
+			},
+			params: &pkgLists.MoveParams{ID: 21, BoardID: 12, Position: 1},
+			list:   models.List{ID: 21, BoardID: 12, Position: 1},
+		},
+		"bad neighbors": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(21).Return(models.List{ID: 21, BoardID: 9}, nil)
+				f.repo.EXPECT().Move(f.params).Return(models.List{}, pkgErrors.ErrBadListNeighbors)
+			},
+			params: &pkgLists.MoveParams{ID: 21, BoardID: 9, BeforeID: 31, AfterID: 30},
+			err:    pkgErrors.ErrBadListNeighbors,
+		},
+		"list not found": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(21).Return(models.List{}, pkgErrors.ErrListNotFound)
+			},
+			params: &pkgLists.MoveParams{ID: 21, BoardID: 9, Position: 1},
+			err:    pkgErrors.ErrListNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), bus: eventsMocks.NewMockBus(ctrl), params: test.params}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo, f.bus)
+			list, err := uc.Move(test.params)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			if list != test.list {
+				t.Errorf("\nExpected: %v\nGot: %v", test.list, list)
+			}
+		})
+	}
+}
+
+func TestUsecase_Copy(t *testing.T) {
+	type fields struct {
+		repo   *mocks.MockRepository
+		bus    *eventsMocks.MockBus
+		params *pkgLists.CopyParams
+	}
+
+	type testCase struct {
+		prepare func(f *fields)
+		params  *pkgLists.CopyParams
+		list    models.List
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Copy(f.params).Return(models.List{ID: 40, BoardID: 12, Title: "Todo", Position: 1}, nil)
+				f.bus.EXPECT().Publish(event{models.EventListCreated, 12})
+			},
+			params: &pkgLists.CopyParams{ID: 21, BoardID: 12, Position: 1, Checklists: true, Labels: true},
+			list:   models.List{ID: 40, BoardID: 12, Title: "Todo", Position: 1},
+		},
+		"other workspace": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Copy(f.params).Return(models.List{}, pkgErrors.ErrListMoveOtherWorkspace)
+			},
+			params: &pkgLists.CopyParams{ID: 21, BoardID: 1, Position: pkgLists.LastPosition},
+			err:    pkgErrors.ErrListMoveOtherWorkspace,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), bus: eventsMocks.NewMockBus(ctrl), params: test.params}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo, f.bus)
+			list, err := uc.Copy(test.params)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			if list != test.list {
+				t.Errorf("\nExpected: %v\nGot: %v", test.list, list)
+			}
+		})
+	}
+}
+
 func TestUsecase_Delete(t *testing.T) {
 	type fields struct {
 		repo *mocks.MockRepository
+		bus  *eventsMocks.MockBus
 		id   int
 	}
 
@@ -347,14 +515,16 @@ func TestUsecase_Delete(t *testing.T) {
 	tests := map[string]testCase{
 		"normal": {
 			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(f.id).Return(models.List{ID: 21, BoardID: 27}, nil)
 				f.repo.EXPECT().Delete(f.id).Return(nil)
+				f.bus.EXPECT().Publish(event{models.EventListDeleted, 27})
 			},
 			id:  21,
 			err: nil,
 		},
 		"list not found": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().Delete(f.id).Return(pkgErrors.ErrListNotFound)
+				f.repo.EXPECT().Get(f.id).Return(models.List{}, pkgErrors.ErrListNotFound)
 			},
 			id:  21,
 			err: pkgErrors.ErrListNotFound,
@@ -369,12 +539,12 @@ func TestUsecase_Delete(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			f := fields{repo: mocks.NewMockRepository(ctrl), id: test.id}
+			f := fields{repo: mocks.NewMockRepository(ctrl), bus: eventsMocks.NewMockBus(ctrl), id: test.id}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := New(f.repo)
+			uc := New(f.repo, f.bus)
 			err := uc.Delete(test.id)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
@@ -382,3 +552,56 @@ func TestUsecase_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestUsecase_Archive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockRepository(ctrl)
+	bus := eventsMocks.NewMockBus(ctrl)
+
+	archivedAt := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	list := models.List{ID: 21, BoardID: 27, Title: "MathStat", Position: 2, ArchivedAt: &archivedAt}
+	repo.EXPECT().SetArchived(21, gomock.Not(gomock.Nil()), 3).Return(list, nil)
+	bus.EXPECT().Publish(event{models.EventListUpdated, 27})
+
+	uc := New(repo, bus)
+	got, err := uc.Archive(21, 3)
+	if err != nil {
+		t.Errorf("\nExpected: nil\nGot: %s", err)
+	}
+	if got != list {
+		t.Errorf("\nExpected: %v\nGot: %v", list, got)
+	}
+}
+
+func TestUsecase_Unarchive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockRepository(ctrl)
+	bus := eventsMocks.NewMockBus(ctrl)
+
+	repo.EXPECT().SetArchived(21, gomock.Nil(), 0).Return(models.List{}, pkgErrors.ErrListNotFound)
+
+	uc := New(repo, bus)
+	_, err := uc.Unarchive(21)
+	if !errors.Is(err, pkgErrors.ErrListNotFound) {
+		t.Errorf("\nExpected: %s\nGot: %s", pkgErrors.ErrListNotFound, err)
+	}
+}
+
+// event matches a published event by its type and board.
+type event struct {
+	eventType string
+	boardID   int
+}
+
+func (e event) Matches(x interface{}) bool {
+	got, ok := x.(*models.Event)
+	return ok && got.Type == e.eventType && got.BoardID == e.boardID
+}
+
+func (e event) String() string {
+	return fmt.Sprintf("%s event of board %d", e.eventType, e.boardID)
+}
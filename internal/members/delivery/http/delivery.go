@@ -0,0 +1,289 @@
+package http
+
+import (
+	pAccess "github.com/SlavaShagalov/my-trello-backend/internal/access"
+	pMembers "github.com/SlavaShagalov/my-trello-backend/internal/members"
+	mw "github.com/SlavaShagalov/my-trello-backend/internal/middleware"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pHTTP "github.com/SlavaShagalov/my-trello-backend/internal/pkg/http"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+type delivery struct {
+	uc       pMembers.Usecase
+	accessUC pAccess.Usecase
+	log      *zap.Logger
+}
+
+func RegisterHandlers(mux *mux.Router, uc pMembers.Usecase, accessUC pAccess.Usecase, log *zap.Logger,
+	checkAuth mw.Middleware, metrics mw.Middleware) {
+	del := delivery{
+		uc:       uc,
+		accessUC: accessUC,
+		log:      log,
+	}
+
+	const (
+		workspaceMembersPrefix = "/workspaces/{id}/members"
+		workspaceMembersPath   = constants.ApiPrefix + workspaceMembersPrefix
+		workspaceMemberPath    = workspaceMembersPath + "/{user_id}"
+	)
+
+	mux.HandleFunc(workspaceMembersPath, metrics(checkAuth(del.add))).Methods(http.MethodPost)
+	mux.HandleFunc(workspaceMembersPath, metrics(checkAuth(del.list))).Methods(http.MethodGet)
+
+	mux.HandleFunc(workspaceMemberPath, metrics(checkAuth(del.updateRole))).Methods(http.MethodPatch)
+	mux.HandleFunc(workspaceMemberPath, metrics(checkAuth(del.remove))).Methods(http.MethodDelete)
+}
+
+// add godoc
+//
+//	@Summary		Add a member to workspace
+//	@Description	Add a member to workspace
+//	@Tags			workspaces
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		int			true	"Workspace ID"
+//	@Param			MemberAddData	body		addRequest	true	"Member data"
+//	@Success		200				{object}	getResponse	"Added member data."
+//	@Failure		400				{object}	http.JSONError
+//	@Failure		401				{object}	http.JSONError
+//	@Failure		403				{object}	http.JSONError
+//	@Failure		404				{object}	http.JSONError
+//	@Failure		409				{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/workspaces/{id}/members [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) add(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	workspaceID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckWorkspace(userID, workspaceID, pAccess.Manage)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	body, err := pHTTP.ReadBody(r, del.log)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	var request addRequest
+	err = request.UnmarshalJSON(body)
+	if err != nil {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	params := pMembers.CreateParams{
+		WorkspaceID: workspaceID,
+		UserID:      request.UserID,
+		Role:        request.Role,
+	}
+
+	member, err := del.uc.Add(&params)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newGetResponse(&member)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// list godoc
+//
+//	@Summary		Returns members of workspace
+//	@Description	Returns members of workspace
+//	@Tags			workspaces
+//	@Produce		json
+//	@Param			id	path		int				true	"Workspace ID"
+//	@Success		200	{object}	listResponse	"Members data"
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/workspaces/{id}/members [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) list(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	workspaceID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckWorkspace(userID, workspaceID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	members, err := del.uc.List(workspaceID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newListResponse(members)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// updateRole godoc
+//
+//	@Summary		Change role of workspace member
+//	@Description	Change role of workspace member
+//	@Tags			workspaces
+//	@Accept			json
+//	@Produce		json
+//	@Param			id					path		int					true	"Workspace ID"
+//	@Param			user_id				path		int					true	"User ID"
+//	@Param			MemberUpdateData	body		updateRoleRequest	true	"New role"
+//	@Success		200					{object}	getResponse			"Updated member data."
+//	@Failure		400					{object}	http.JSONError
+//	@Failure		401					{object}	http.JSONError
+//	@Failure		403					{object}	http.JSONError
+//	@Failure		404					{object}	http.JSONError
+//	@Failure		409					{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/workspaces/{id}/members/{user_id} [patch]
+//
+//	@Security		cookieAuth
+func (del *delivery) updateRole(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	workspaceID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	memberID, err := strconv.Atoi(vars["user_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckWorkspace(userID, workspaceID, pAccess.Manage)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	body, err := pHTTP.ReadBody(r, del.log)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	var request updateRoleRequest
+	err = request.UnmarshalJSON(body)
+	if err != nil {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	params := pMembers.UpdateRoleParams{
+		WorkspaceID: workspaceID,
+		UserID:      memberID,
+		Role:        request.Role,
+	}
+
+	member, err := del.uc.UpdateRole(&params)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newGetResponse(&member)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// remove godoc
+//
+//	@Summary		Remove member from workspace
+//	@Description	Remove member from workspace. Any member can leave the workspace by removing themselves.
+//	@Tags			workspaces
+//	@Produce		json
+//	@Param			id		path	int	true	"Workspace ID"
+//	@Param			user_id	path	int	true	"User ID"
+//	@Success		204		"Member removed successfully"
+//	@Failure		400		{object}	http.JSONError
+//	@Failure		401		{object}	http.JSONError
+//	@Failure		403		{object}	http.JSONError
+//	@Failure		404		{object}	http.JSONError
+//	@Failure		409		{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/workspaces/{id}/members/{user_id} [delete]
+//
+//	@Security		cookieAuth
+func (del *delivery) remove(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	workspaceID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+	memberID, err := strconv.Atoi(vars["user_id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	perm := pAccess.Manage
+	if memberID == userID {
+		perm = pAccess.Read
+	}
+	err = del.accessUC.CheckWorkspace(userID, workspaceID, perm)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	err = del.uc.Remove(workspaceID, memberID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,53 @@
+package http
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"time"
+)
+
+//go:generate easyjson -all -snake_case models.go
+
+// API requests
+type addRequest struct {
+	UserID int    `json:"user_id"`
+	Role   string `json:"role"`
+}
+
+type updateRoleRequest struct {
+	Role string `json:"role"`
+}
+
+// API responses
+type listResponse struct {
+	Members []models.Member `json:"members"`
+}
+
+func newListResponse(members []models.Member) *listResponse {
+	return &listResponse{
+		Members: members,
+	}
+}
+
+type getResponse struct {
+	WorkspaceID int       `json:"workspace_id"`
+	UserID      int       `json:"user_id"`
+	Username    string    `json:"username"`
+	Name        string    `json:"name"`
+	Avatar      *string   `json:"avatar"`
+	Role        string    `json:"role"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func newGetResponse(member *models.Member) *getResponse {
+	return &getResponse{
+		WorkspaceID: member.WorkspaceID,
+		UserID:      member.UserID,
+		Username:    member.Username,
+		Name:        member.Name,
+		Avatar:      member.Avatar,
+		Role:        member.Role,
+		CreatedAt:   member.CreatedAt,
+		UpdatedAt:   member.UpdatedAt,
+	}
+}
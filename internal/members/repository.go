@@ -0,0 +1,23 @@
+package members
+
+import "github.com/SlavaShagalov/my-trello-backend/internal/models"
+
+type CreateParams struct {
+	WorkspaceID int
+	UserID      int
+	Role        string
+}
+
+type UpdateRoleParams struct {
+	WorkspaceID int
+	UserID      int
+	Role        string
+}
+
+type Repository interface {
+	Create(params *CreateParams) (models.Member, error)
+	List(workspaceID int) ([]models.Member, error)
+	Get(workspaceID, userID int) (models.Member, error)
+	UpdateRole(params *UpdateRoleParams) (models.Member, error)
+	Delete(workspaceID, userID int) error
+}
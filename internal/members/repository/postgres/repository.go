@@ -0,0 +1,218 @@
+package postgres
+
+import (
+	"database/sql"
+	"github.com/SlavaShagalov/my-trello-backend/internal/images"
+	pkgMembers "github.com/SlavaShagalov/my-trello-backend/internal/members"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+type repository struct {
+	db  *sql.DB
+	log *zap.Logger
+}
+
+func New(db *sql.DB, log *zap.Logger) pkgMembers.Repository {
+	return &repository{db: db, log: log}
+}
+
+const createCmd = `
+	WITH m AS (
+		INSERT INTO workspace_members (workspace_id, user_id, role)
+		VALUES ($1, $2, $3)
+		RETURNING workspace_id, user_id, role, created_at, updated_at
+	)
+	SELECT m.workspace_id, m.user_id, u.username, u.name, u.avatar, m.role, m.created_at, m.updated_at
+	FROM m
+	JOIN users u on u.id = m.user_id;`
+
+func (repo *repository) Create(params *pkgMembers.CreateParams) (models.Member, error) {
+	row := repo.db.QueryRow(createCmd, params.WorkspaceID, params.UserID, params.Role)
+
+	var member models.Member
+	err := scanMember(row, &member)
+	if err != nil {
+		pgErr, ok := err.(*pq.Error)
+		if !ok {
+			repo.log.Error("Cannot convert err to pq.Error", zap.Error(err))
+			return models.Member{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+		switch {
+		case pgErr.Constraint == "workspace_members_workspace_id_fkey":
+			return models.Member{}, errors.Wrap(pkgErrors.ErrWorkspaceNotFound, err.Error())
+		case pgErr.Constraint == "workspace_members_user_id_fkey":
+			return models.Member{}, errors.Wrap(pkgErrors.ErrUserNotFound, err.Error())
+		case pgErr.Constraint == "workspace_members_pkey":
+			return models.Member{}, errors.Wrap(pkgErrors.ErrMemberAlreadyExists, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", createCmd),
+			zap.Any("create_params", params))
+		return models.Member{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("New member added", zap.Any("member", member))
+	return member, nil
+}
+
+const listCmd = `
+	SELECT m.workspace_id, m.user_id, u.username, u.name, u.avatar, m.role, m.created_at, m.updated_at
+	FROM workspace_members m
+	JOIN users u on u.id = m.user_id
+	WHERE m.workspace_id = $1
+	ORDER BY m.created_at, m.user_id;`
+
+func (repo *repository) List(workspaceID int) ([]models.Member, error) {
+	rows, err := repo.db.Query(listCmd, workspaceID)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listCmd),
+			zap.Int("workspace_id", workspaceID))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	members := []models.Member{}
+	var member models.Member
+	for rows.Next() {
+		avatar := new(sql.NullString)
+		err = rows.Scan(
+			&member.WorkspaceID,
+			&member.UserID,
+			&member.Username,
+			&member.Name,
+			avatar,
+			&member.Role,
+			&member.CreatedAt,
+			&member.UpdatedAt,
+		)
+		if err != nil {
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", listCmd),
+				zap.Int("workspace_id", workspaceID))
+			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+
+		if avatar.Valid {
+			url := images.URL(avatar.String)
+			member.Avatar = &url
+		} else {
+			member.Avatar = nil
+		}
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+const getCmd = `
+	SELECT m.workspace_id, m.user_id, u.username, u.name, u.avatar, m.role, m.created_at, m.updated_at
+	FROM workspace_members m
+	JOIN users u on u.id = m.user_id
+	WHERE m.workspace_id = $1 AND m.user_id = $2;`
+
+func (repo *repository) Get(workspaceID, userID int) (models.Member, error) {
+	row := repo.db.QueryRow(getCmd, workspaceID, userID)
+
+	var member models.Member
+	err := scanMember(row, &member)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Member{}, errors.Wrap(pkgErrors.ErrMemberNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", getCmd),
+			zap.Int("workspace_id", workspaceID), zap.Int("user_id", userID))
+		return models.Member{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	return member, nil
+}
+
+const updateRoleCmd = `
+	WITH m AS (
+		UPDATE workspace_members
+		SET role       = $1,
+			updated_at = now()
+		WHERE workspace_id = $2 AND user_id = $3
+		RETURNING workspace_id, user_id, role, created_at, updated_at
+	)
+	SELECT m.workspace_id, m.user_id, u.username, u.name, u.avatar, m.role, m.created_at, m.updated_at
+	FROM m
+	JOIN users u on u.id = m.user_id;`
+
+func (repo *repository) UpdateRole(params *pkgMembers.UpdateRoleParams) (models.Member, error) {
+	row := repo.db.QueryRow(updateRoleCmd, params.Role, params.WorkspaceID, params.UserID)
+
+	var member models.Member
+	err := scanMember(row, &member)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Member{}, errors.Wrap(pkgErrors.ErrMemberNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", updateRoleCmd),
+			zap.Any("params", params))
+		return models.Member{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("Member role updated", zap.Any("member", member))
+	return member, nil
+}
+
+const deleteCmd = `
+	DELETE FROM workspace_members
+	WHERE workspace_id = $1 AND user_id = $2;`
+
+func (repo *repository) Delete(workspaceID, userID int) error {
+	result, err := repo.db.Exec(deleteCmd, workspaceID, userID)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", deleteCmd),
+			zap.Int("workspace_id", workspaceID), zap.Int("user_id", userID))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", deleteCmd),
+			zap.Int("workspace_id", workspaceID), zap.Int("user_id", userID))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	if rowsAffected == 0 {
+		return pkgErrors.ErrMemberNotFound
+	}
+
+	repo.log.Debug("Member removed", zap.Int("workspace_id", workspaceID), zap.Int("user_id", userID))
+	return nil
+}
+
+func scanMember(row *sql.Row, member *models.Member) error {
+	avatar := new(sql.NullString)
+	err := row.Scan(
+		&member.WorkspaceID,
+		&member.UserID,
+		&member.Username,
+		&member.Name,
+		avatar,
+		&member.Role,
+		&member.CreatedAt,
+		&member.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if avatar.Valid {
+		url := images.URL(avatar.String)
+		member.Avatar = &url
+	} else {
+		member.Avatar = nil
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package members
+
+import "github.com/SlavaShagalov/my-trello-backend/internal/models"
+
+type Usecase interface {
+	Add(params *CreateParams) (models.Member, error)
+	List(workspaceID int) ([]models.Member, error)
+	Get(workspaceID, userID int) (models.Member, error)
+	UpdateRole(params *UpdateRoleParams) (models.Member, error)
+	Remove(workspaceID, userID int) error
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/members"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+)
+
+type usecase struct {
+	repo members.Repository
+}
+
+func New(repo members.Repository) members.Usecase {
+	return &usecase{repo: repo}
+}
+
+func (uc *usecase) Add(params *members.CreateParams) (models.Member, error) {
+	if err := validateRole(params.Role); err != nil {
+		return models.Member{}, err
+	}
+
+	return uc.repo.Create(params)
+}
+
+func (uc *usecase) List(workspaceID int) ([]models.Member, error) {
+	return uc.repo.List(workspaceID)
+}
+
+func (uc *usecase) Get(workspaceID, userID int) (models.Member, error) {
+	return uc.repo.Get(workspaceID, userID)
+}
+
+func (uc *usecase) UpdateRole(params *members.UpdateRoleParams) (models.Member, error) {
+	if err := validateRole(params.Role); err != nil {
+		return models.Member{}, err
+	}
+
+	member, err := uc.repo.Get(params.WorkspaceID, params.UserID)
+	if err != nil {
+		return models.Member{}, err
+	}
+	if member.Role == models.RoleOwner {
+		return models.Member{}, pkgErrors.ErrOwnerRoleImmutable
+	}
+
+	return uc.repo.UpdateRole(params)
+}
+
+func (uc *usecase) Remove(workspaceID, userID int) error {
+	member, err := uc.repo.Get(workspaceID, userID)
+	if err != nil {
+		return err
+	}
+	if member.Role == models.RoleOwner {
+		return pkgErrors.ErrOwnerRoleImmutable
+	}
+
+	return uc.repo.Delete(workspaceID, userID)
+}
+
+func validateRole(role string) error {
+	if !models.IsAssignableRole(role) {
+		return pkgErrors.ErrInvalidRole
+	}
+	return nil
+}
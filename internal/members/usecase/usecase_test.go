@@ -0,0 +1,217 @@
+package usecase
+
+import (
+	pkgMembers "github.com/SlavaShagalov/my-trello-backend/internal/members"
+	"github.com/SlavaShagalov/my-trello-backend/internal/members/mocks"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestUsecase_Add(t *testing.T) {
+	type fields struct {
+		repo   *mocks.MockRepository
+		params *pkgMembers.CreateParams
+		member *models.Member
+	}
+
+	type testCase struct {
+		prepare func(f *fields)
+		params  *pkgMembers.CreateParams
+		member  models.Member
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Create(f.params).Return(*f.member, nil)
+			},
+			params: &pkgMembers.CreateParams{WorkspaceID: 1, UserID: 3, Role: models.RoleMember},
+			member: models.Member{WorkspaceID: 1, UserID: 3, Username: "slava", Role: models.RoleMember},
+			err:    nil,
+		},
+		"owner role": {
+			params: &pkgMembers.CreateParams{WorkspaceID: 1, UserID: 3, Role: models.RoleOwner},
+			member: models.Member{},
+			err:    pkgErrors.ErrInvalidRole,
+		},
+		"unknown role": {
+			params: &pkgMembers.CreateParams{WorkspaceID: 1, UserID: 3, Role: "guest"},
+			member: models.Member{},
+			err:    pkgErrors.ErrInvalidRole,
+		},
+		"already member": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Create(f.params).Return(models.Member{}, pkgErrors.ErrMemberAlreadyExists)
+			},
+			params: &pkgMembers.CreateParams{WorkspaceID: 1, UserID: 3, Role: models.RoleAdmin},
+			member: models.Member{},
+			err:    pkgErrors.ErrMemberAlreadyExists,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), params: test.params, member: &test.member}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo)
+			member, err := uc.Add(test.params)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			assert.Equal(t, test.member, member)
+		})
+	}
+}
+
+func TestUsecase_UpdateRole(t *testing.T) {
+	type fields struct {
+		repo   *mocks.MockRepository
+		params *pkgMembers.UpdateRoleParams
+		member *models.Member
+	}
+
+	type testCase struct {
+		prepare func(f *fields)
+		params  *pkgMembers.UpdateRoleParams
+		member  models.Member
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(f.params.WorkspaceID, f.params.UserID).
+					Return(models.Member{WorkspaceID: 1, UserID: 3, Role: models.RoleObserver}, nil)
+				f.repo.EXPECT().UpdateRole(f.params).Return(*f.member, nil)
+			},
+			params: &pkgMembers.UpdateRoleParams{WorkspaceID: 1, UserID: 3, Role: models.RoleAdmin},
+			member: models.Member{WorkspaceID: 1, UserID: 3, Role: models.RoleAdmin},
+			err:    nil,
+		},
+		"invalid role": {
+			params: &pkgMembers.UpdateRoleParams{WorkspaceID: 1, UserID: 3, Role: models.RoleOwner},
+			member: models.Member{},
+			err:    pkgErrors.ErrInvalidRole,
+		},
+		"owner": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(f.params.WorkspaceID, f.params.UserID).
+					Return(models.Member{WorkspaceID: 1, UserID: 1, Role: models.RoleOwner}, nil)
+			},
+			params: &pkgMembers.UpdateRoleParams{WorkspaceID: 1, UserID: 1, Role: models.RoleMember},
+			member: models.Member{},
+			err:    pkgErrors.ErrOwnerRoleImmutable,
+		},
+		"member not found": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(f.params.WorkspaceID, f.params.UserID).
+					Return(models.Member{}, pkgErrors.ErrMemberNotFound)
+			},
+			params: &pkgMembers.UpdateRoleParams{WorkspaceID: 1, UserID: 7, Role: models.RoleMember},
+			member: models.Member{},
+			err:    pkgErrors.ErrMemberNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), params: test.params, member: &test.member}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo)
+			member, err := uc.UpdateRole(test.params)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			assert.Equal(t, test.member, member)
+		})
+	}
+}
+
+func TestUsecase_Remove(t *testing.T) {
+	type fields struct {
+		repo        *mocks.MockRepository
+		workspaceID int
+		userID      int
+	}
+
+	type testCase struct {
+		prepare     func(f *fields)
+		workspaceID int
+		userID      int
+		err         error
+	}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(f.workspaceID, f.userID).
+					Return(models.Member{WorkspaceID: 1, UserID: 4, Role: models.RoleMember}, nil)
+				f.repo.EXPECT().Delete(f.workspaceID, f.userID).Return(nil)
+			},
+			workspaceID: 1,
+			userID:      4,
+			err:         nil,
+		},
+		"owner": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(f.workspaceID, f.userID).
+					Return(models.Member{WorkspaceID: 1, UserID: 1, Role: models.RoleOwner}, nil)
+			},
+			workspaceID: 1,
+			userID:      1,
+			err:         pkgErrors.ErrOwnerRoleImmutable,
+		},
+		"member not found": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(f.workspaceID, f.userID).Return(models.Member{}, pkgErrors.ErrMemberNotFound)
+			},
+			workspaceID: 1,
+			userID:      7,
+			err:         pkgErrors.ErrMemberNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), workspaceID: test.workspaceID, userID: test.userID}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo)
+			err := uc.Remove(test.workspaceID, test.userID)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+type Assignee struct {
+	UserID     int       `json:"user_id"`
+	Username   string    `json:"username"`
+	Name       string    `json:"name"`
+	Avatar     *string   `json:"avatar"`
+	AssignedAt time.Time `json:"assigned_at"`
+}
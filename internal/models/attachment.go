@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+type Attachment struct {
+	ID          int       `json:"id"`
+	CardID      int       `json:"card_id"`
+	UploaderID  *int      `json:"uploader_id"`
+	Filename    string    `json:"filename"`
+	Size        int64     `json:"size"`
+	ContentType string    `json:"content_type"`
+	Key         string    `json:"-"`
+	CreatedAt   time.Time `json:"created_at"`
+}
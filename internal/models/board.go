@@ -2,12 +2,76 @@ package models
 
 import "time"
 
+const (
+	BackgroundTypeColor    = "color"
+	BackgroundTypeGradient = "gradient"
+	BackgroundTypeImage    = "image"
+)
+
+// Board has a background of one of the types: a solid BackgroundColor, a preset
+// BackgroundGradient or an uploaded image given by Background with its thumbnails.
+// Only the fields of its type are set. Built-in templates have no WorkspaceID.
 type Board struct {
-	ID          int       `json:"id"`
-	WorkspaceID int       `json:"workspace_id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	Background  *string   `json:"background"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID                   int               `json:"id"`
+	WorkspaceID          int               `json:"workspace_id"`
+	Title                string            `json:"title"`
+	Description          string            `json:"description"`
+	IsTemplate           bool              `json:"is_template"`
+	BackgroundType       string            `json:"background_type"`
+	BackgroundColor      *string           `json:"background_color"`
+	BackgroundGradient   *string           `json:"background_gradient"`
+	Background           *string           `json:"background"`
+	BackgroundThumbnails map[string]string `json:"background_thumbnails"`
+	BackgroundKey        *string           `json:"-"`
+	ArchivedAt           *time.Time        `json:"archived_at"`
+	CreatedAt            time.Time         `json:"created_at"`
+	UpdatedAt            time.Time         `json:"updated_at"`
+}
+
+// BoardFull is a board with its labels and its active lists with their active
+// cards, in order.
+type BoardFull struct {
+	Board
+	Labels []Label
+	Lists  []ListFull
+}
+
+type ListFull struct {
+	List
+	Cards []Card
+}
+
+// Gradient is a preset board background. Colors go from the top left corner
+// to the bottom right one.
+type Gradient struct {
+	Name   string   `json:"name"`
+	Colors []string `json:"colors"`
+}
+
+var (
+	// BackgroundColors are suggested solid backgrounds, any other color can be
+	// used as well. New boards get the first one.
+	BackgroundColors = []string{
+		"#0079bf", "#d29034", "#519839", "#b04632", "#89609e",
+		"#cd5a91", "#4bbf6b", "#00aecc", "#838c91",
+	}
+
+	BackgroundGradients = []Gradient{
+		{Name: "ocean", Colors: []string{"#0079bf", "#00aecc"}},
+		{Name: "sunset", Colors: []string{"#ff7e5f", "#feb47b"}},
+		{Name: "forest", Colors: []string{"#134e5e", "#71b280"}},
+		{Name: "lavender", Colors: []string{"#8e2de2", "#4a00e0"}},
+		{Name: "flamingo", Colors: []string{"#cd5a91", "#f87168"}},
+		{Name: "night", Colors: []string{"#0f2027", "#2c5364"}},
+	}
+)
+
+// IsBackgroundGradient reports whether name is one of BackgroundGradients.
+func IsBackgroundGradient(name string) bool {
+	for _, gradient := range BackgroundGradients {
+		if gradient.Name == name {
+			return true
+		}
+	}
+	return false
 }
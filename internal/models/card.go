@@ -3,11 +3,36 @@ package models
 import "time"
 
 type Card struct {
-	ID        int       `json:"id"`
-	ListID    int       `json:"list_id"`
-	Title     string    `json:"title"`
-	Content   string    `json:"content"`
-	Position  int       `json:"position"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID                int               `json:"id"`
+	ListID            int               `json:"list_id"`
+	Title             string            `json:"title"`
+	Content           string            `json:"content"`
+	Position          int               `json:"position"`
+	Labels            []Label           `json:"labels"`
+	Assignees         []Assignee        `json:"assignees"`
+	ChecklistProgress ChecklistProgress `json:"checklist_progress"`
+	CommentsCount     int               `json:"comments_count"`
+	StartAt           *time.Time        `json:"start_at"`
+	DueAt             *time.Time        `json:"due_at"`
+	CompletedAt       *time.Time        `json:"completed_at"`
+	ArchivedAt        *time.Time        `json:"archived_at"`
+	CreatedAt         time.Time         `json:"created_at"`
+	UpdatedAt         time.Time         `json:"updated_at"`
+}
+
+// AssignedCard is a card listed outside of its board, with the title of its list.
+type AssignedCard struct {
+	Card
+	ListTitle   string `json:"list_title"`
+	BoardID     int    `json:"-"`
+	BoardTitle  string `json:"-"`
+	WorkspaceID int    `json:"-"`
+}
+
+// BoardCards groups assigned cards by their board.
+type BoardCards struct {
+	BoardID     int            `json:"board_id"`
+	BoardTitle  string         `json:"board_title"`
+	WorkspaceID int            `json:"workspace_id"`
+	Cards       []AssignedCard `json:"cards"`
 }
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+type Checklist struct {
+	ID        int             `json:"id"`
+	CardID    int             `json:"card_id"`
+	Title     string          `json:"title"`
+	Position  int             `json:"position"`
+	Items     []ChecklistItem `json:"items"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+type ChecklistItem struct {
+	ID          int       `json:"id"`
+	ChecklistID int       `json:"checklist_id"`
+	Title       string    `json:"title"`
+	Done        bool      `json:"done"`
+	Position    int       `json:"position"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ChecklistProgress sums up the items of all checklists of a card.
+type ChecklistProgress struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+type Comment struct {
+	ID             int        `json:"id"`
+	CardID         int        `json:"card_id"`
+	AuthorID       int        `json:"author_id"`
+	AuthorUsername string     `json:"author_username"`
+	Content        string     `json:"content"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	EditedAt       *time.Time `json:"edited_at"`
+}
+
+// CommentVersion is the content a comment had before one of its edits.
+type CommentVersion struct {
+	ID        int       `json:"id"`
+	CommentID int       `json:"comment_id"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
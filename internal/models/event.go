@@ -0,0 +1,45 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	EventCardCreated = "card.created"
+	EventCardUpdated = "card.updated"
+	EventCardMoved   = "card.moved"
+	EventCardDeleted = "card.deleted"
+
+	EventListCreated = "list.created"
+	EventListUpdated = "list.updated"
+	EventListMoved   = "list.moved"
+	EventListDeleted = "list.deleted"
+
+	EventBoardUpdated = "board.updated"
+	EventBoardDeleted = "board.deleted"
+
+	// EventBoardResync tells a resuming subscriber that events were lost and
+	// the board has to be fetched again.
+	EventBoardResync = "board.resync"
+)
+
+// IsEventType reports whether eventType is published on board changes and
+// so can be subscribed to.
+func IsEventType(eventType string) bool {
+	switch eventType {
+	case EventCardCreated, EventCardUpdated, EventCardMoved, EventCardDeleted,
+		EventListCreated, EventListUpdated, EventListMoved, EventListDeleted,
+		EventBoardUpdated, EventBoardDeleted:
+		return true
+	}
+	return false
+}
+
+type Event struct {
+	ID        string          `json:"id,omitempty"`
+	Type      string          `json:"type"`
+	BoardID   int             `json:"board_id"`
+	Data      json.RawMessage `json:"data"`
+	CreatedAt time.Time       `json:"created_at"`
+}
@@ -0,0 +1,56 @@
+package models
+
+import "time"
+
+// BoardExportVersion is the version of the documents boards are exported to.
+// Documents of other versions are not imported.
+const BoardExportVersion = 1
+
+// BoardExport is a board with its labels and its active lists and cards. It
+// has no ids of the instance it comes from: lists, cards, checklists and items
+// are in order, cards refer to the labels by their index. Members, assignees,
+// comments and attachments belong to the users of the instance and are not
+// exported, an uploaded background is exported as the default color.
+type BoardExport struct {
+	Version            int           `json:"version"`
+	ExportedAt         time.Time     `json:"exported_at"`
+	Title              string        `json:"title"`
+	Description        string        `json:"description"`
+	IsTemplate         bool          `json:"is_template"`
+	BackgroundType     string        `json:"background_type"`
+	BackgroundColor    *string       `json:"background_color"`
+	BackgroundGradient *string       `json:"background_gradient"`
+	Labels             []ExportLabel `json:"labels"`
+	Lists              []ExportList  `json:"lists"`
+}
+
+type ExportLabel struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type ExportList struct {
+	Title string       `json:"title"`
+	Cards []ExportCard `json:"cards"`
+}
+
+type ExportCard struct {
+	Title       string     `json:"title"`
+	Content     string     `json:"content"`
+	StartAt     *time.Time `json:"start_at"`
+	DueAt       *time.Time `json:"due_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+	// Labels are distinct indexes of the labels of the board.
+	Labels     []int             `json:"labels"`
+	Checklists []ExportChecklist `json:"checklists"`
+}
+
+type ExportChecklist struct {
+	Title string                `json:"title"`
+	Items []ExportChecklistItem `json:"items"`
+}
+
+type ExportChecklistItem struct {
+	Title string `json:"title"`
+	Done  bool   `json:"done"`
+}
@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+const (
+	InvitationPending  = "pending"
+	InvitationAccepted = "accepted"
+	InvitationDeclined = "declined"
+	InvitationRevoked  = "revoked"
+)
+
+type Invitation struct {
+	ID          int       `json:"id"`
+	WorkspaceID int       `json:"workspace_id"`
+	InviterID   int       `json:"inviter_id"`
+	Invitee     string    `json:"invitee"`
+	Role        string    `json:"role"`
+	Token       string    `json:"-"`
+	Status      string    `json:"status"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+const (
+	RoleOwner    = "owner"
+	RoleAdmin    = "admin"
+	RoleMember   = "member"
+	RoleObserver = "observer"
+)
+
+type Member struct {
+	WorkspaceID int       `json:"workspace_id"`
+	UserID      int       `json:"user_id"`
+	Username    string    `json:"username"`
+	Name        string    `json:"name"`
+	Avatar      *string   `json:"avatar"`
+	Role        string    `json:"role"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// IsAssignableRole reports whether role can be granted to a workspace member.
+// A workspace has exactly one owner, assigned when the workspace is created.
+func IsAssignableRole(role string) bool {
+	switch role {
+	case RoleAdmin, RoleMember, RoleObserver:
+		return true
+	}
+	return false
+}
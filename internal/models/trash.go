@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+const (
+	TrashItemBoard = "board"
+	TrashItemList  = "list"
+	TrashItemCard  = "card"
+)
+
+// TrashItem is an archived board, list or card of a workspace. ListID and
+// ListTitle are set for cards only. ArchivedBy is nil when the user who archived
+// the item is deleted. The item is purged at PurgeAt.
+type TrashItem struct {
+	Type               string    `json:"type"`
+	ID                 int       `json:"id"`
+	Title              string    `json:"title"`
+	BoardID            int       `json:"board_id"`
+	BoardTitle         string    `json:"board_title"`
+	ListID             *int      `json:"list_id"`
+	ListTitle          *string   `json:"list_title"`
+	ArchivedAt         time.Time `json:"archived_at"`
+	ArchivedBy         *int      `json:"archived_by"`
+	ArchivedByUsername *string   `json:"archived_by_username"`
+	PurgeAt            time.Time `json:"purge_at"`
+}
@@ -3,12 +3,14 @@ package models
 import "time"
 
 type User struct {
-	ID        int
-	Username  string
-	Password  string
-	Email     string
-	Name      string
-	Avatar    *string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID               int
+	Username         string
+	Password         string
+	Email            string
+	Name             string
+	Avatar           *string
+	AvatarThumbnails map[string]string
+	AvatarKey        *string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
 }
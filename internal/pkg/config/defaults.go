@@ -47,9 +47,38 @@ func SetDefaultS3Config() {
 	viper.SetDefault(S3Endpoint, "http://hb.vkcs.cloud")
 }
 
+// Images
+
+func SetDefaultImagesConfig() {
+	viper.SetDefault(ImagesStorage, "s3")
+	viper.SetDefault(ImagesDir, "/images")
+	viper.SetDefault(ImagesURL, constants.ImagesPrefix)
+	viper.SetDefault(ImagesGCInterval, constants.ImagesGCInterval)
+	viper.SetDefault(ImagesGCGracePeriod, constants.ImagesGCGracePeriod)
+}
+
+// Trash
+
+func SetDefaultTrashConfig() {
+	viper.SetDefault(TrashRetention, constants.TrashRetention)
+	viper.SetDefault(TrashPurgeInterval, constants.TrashPurgeInterval)
+}
+
+// Ranks
+
+func SetDefaultRanksConfig() {
+	viper.SetDefault(RankRebalanceInterval, constants.RankRebalanceInterval)
+	viper.SetDefault(MaxRankLen, constants.MaxRankLen)
+}
+
 // Validation
 
 func SetDefaultValidationConfig() {
 	viper.SetDefault(MinUsernameLen, constants.MinUsernameLen)
 	viper.SetDefault(MaxUsernameLen, constants.MaxUsernameLen)
+
+	viper.SetDefault(MaxAttachmentSize, constants.MaxAttachmentSize)
+
+	viper.SetDefault(MaxImageSize, constants.MaxImageSize)
+	viper.SetDefault(MaxImageDimension, constants.MaxImageDimension)
 }
@@ -31,8 +31,48 @@ const (
 	S3Endpoint      = "S3_ENDPOINT"
 )
 
+// Images
+const (
+	// ImagesStorage is fs or s3.
+	ImagesStorage = "IMAGES_STORAGE"
+	ImagesDir     = "IMAGES_DIR"
+	// ImagesURL is where the stored files are publicly available: the bucket URL
+	// for s3 or the path of the static handler for fs.
+	ImagesURL = "IMAGES_URL"
+
+	// ImagesGCInterval is how often orphaned avatars and backgrounds are deleted,
+	// 0 turns the collector off.
+	ImagesGCInterval = "IMAGES_GC_INTERVAL"
+	// ImagesGCGracePeriod is how old an unreferenced image has to be to get deleted.
+	ImagesGCGracePeriod = "IMAGES_GC_GRACE_PERIOD"
+)
+
+// Trash
+const (
+	// TrashRetention is how long archived boards, lists and cards stay in the trash.
+	TrashRetention = "TRASH_RETENTION"
+	// TrashPurgeInterval is how often the trash is purged, 0 turns the purger off.
+	TrashPurgeInterval = "TRASH_PURGE_INTERVAL"
+)
+
+// Ranks
+const (
+	// RankRebalanceInterval is how often crowded ranks of lists and cards are
+	// spread out again, 0 turns the rebalancer off.
+	RankRebalanceInterval = "RANK_REBALANCE_INTERVAL"
+	// MaxRankLen is how long ranks may grow before their board or list gets
+	// rebalanced.
+	MaxRankLen = "MAX_RANK_LEN"
+)
+
 // Validation
 const (
 	MinUsernameLen = "MIN_USERNAME_LEN"
 	MaxUsernameLen = "MAX_USERNAME_LEN"
+
+	MaxAttachmentSize = "MAX_ATTACHMENT_SIZE"
+
+	MaxImageSize = "MAX_IMAGE_SIZE"
+	// MaxImageDimension limits both the width and the height of avatars and backgrounds.
+	MaxImageDimension = "MAX_IMAGE_DIMENSION"
 )
@@ -5,9 +5,57 @@ import "time"
 const (
 	ApiPrefix  = "/api/v1"
 	ApiAddress = ":8000"
+	// ImagesPrefix is where images stored in the filesystem are served.
+	ImagesPrefix = "/images"
 )
 
 const (
 	SessionName       = "JSESSIONID"
 	SessionLivingTime = 14 * 24 * time.Hour
 )
+
+const (
+	InvitationLivingTime = 7 * 24 * time.Hour
+	InvitationTokenLen   = 32
+)
+
+const (
+	CommentsPageSize    = 20
+	MaxCommentsPageSize = 100
+)
+
+const (
+	ImagesGCInterval    = 24 * time.Hour
+	ImagesGCGracePeriod = 24 * time.Hour
+)
+
+const (
+	TrashRetention     = 30 * 24 * time.Hour
+	TrashPurgeInterval = time.Hour
+)
+
+const (
+	RankRebalanceInterval = time.Hour
+	// MaxRankLen leaves appended ranks alone, they are 6 characters at most.
+	MaxRankLen = 10
+)
+
+const (
+	// MultipartOverhead is accepted on top of MaxAttachmentSize and MaxImageSize
+	// for multipart boundaries and part headers.
+	MultipartOverhead = 1 << 20
+)
+
+const (
+	WebhookSecretLen = 32
+	// WebhookTimeout bounds a single delivery attempt. It must stay below
+	// WebhookLease, or a slow attempt could be claimed by another worker.
+	WebhookTimeout     = 10 * time.Second
+	WebhookLease       = time.Minute
+	WebhookMaxAttempts = 8
+	WebhookMinBackoff  = 30 * time.Second
+	WebhookMaxBackoff  = time.Hour
+	WebhookPollPeriod  = 5 * time.Second
+	WebhookBatchSize   = 20
+	WebhookLogSize     = 100
+)
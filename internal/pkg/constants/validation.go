@@ -10,4 +10,16 @@ const (
 	MaxListTitleLen = 50
 
 	MaxListDescriptionLen = 200
+
+	MaxLabelNameLen = 30
+
+	MaxChecklistTitleLen = 100
+
+	MaxCommentLen = 5000
+
+	MaxAttachmentNameLen = 255
+	MaxAttachmentSize    = 10 << 20
+
+	MaxImageSize      = 5 << 20
+	MaxImageDimension = 4096
 )
@@ -23,8 +23,29 @@ var (
 	// Workspaces
 	ErrWorkspaceNotFound = errors.New("workspace not found")
 
+	// Members
+	ErrMemberNotFound      = errors.New("member not found")
+	ErrMemberAlreadyExists = errors.New("member already exists")
+	ErrInvalidRole         = errors.New("role must be one of admin, member, observer")
+	ErrOwnerRoleImmutable  = errors.New("workspace owner cannot be changed or removed")
+
+	// Invitations
+	ErrInvitationNotFound     = errors.New("invitation not found")
+	ErrInvitationNotPending   = errors.New("invitation is already accepted, declined or revoked")
+	ErrInvitationExpired      = errors.New("invitation expired")
+	ErrInvitationNotForUser   = errors.New("invitation is addressed to another user")
+	ErrEmptyInvitee           = errors.New("invitee must not be empty")
+	ErrInvitationTokenFailure = errors.New("invitation token generation error")
+	ErrSendInvitation         = errors.New("send invitation error")
+
 	// Boards
-	ErrBoardNotFound = errors.New("board not found")
+	ErrBoardNotFound             = errors.New("board not found")
+	ErrInvalidBackgroundType     = errors.New("background type must be one of color, gradient, images are uploaded")
+	ErrInvalidBackgroundColor    = errors.New("background color must be a hex color like #0079bf")
+	ErrInvalidBackgroundGradient = errors.New("background gradient must be one of the presets")
+	ErrTemplateNotFound          = errors.New("template not found")
+	ErrBadExportVersion          = errors.New("board export version is not supported")
+	ErrBadExportLabel            = errors.New("card labels must be distinct indexes of the exported labels")
 
 	// Lists
 	ErrListNotFound     = errors.New("list not found")
@@ -32,9 +53,77 @@ var (
 		constants.MaxListTitleLen))
 	ErrTooLongListDescription = errors.New(fmt.Sprintf("list description must be no more than %d characters",
 		constants.MaxListDescriptionLen))
+	ErrListArchivedMove       = errors.New("archived list must be restored first")
+	ErrBoardArchived          = errors.New("lists can't be moved to an archived board")
+	ErrBoardArchivedRestore   = errors.New("archived board must be restored first")
+	ErrListMoveOtherWorkspace = errors.New("list can only be moved to a board of its workspace")
+	ErrBadListNeighbors       = errors.New("before_id and after_id must be adjacent active lists of the target board")
 
 	// Cards
-	ErrCardNotFound = errors.New("card not found")
+	ErrCardNotFound           = errors.New("card not found")
+	ErrInvalidCardDates       = errors.New("card start date must not be after its due date")
+	ErrBadCardDate            = errors.New("card dates must be RFC 3339 timestamps")
+	ErrBadCardFilter          = errors.New("overdue and completed filters must be true or false")
+	ErrBadCardSort            = errors.New("sort must be one of position, due")
+	ErrCardArchived           = errors.New("archived card must be restored first")
+	ErrListArchived           = errors.New("cards can't be moved to an archived list")
+	ErrCardMoveOtherWorkspace = errors.New("card can only be moved to a list of its workspace")
+	ErrBadCardNeighbors       = errors.New("before_id and after_id must be adjacent active cards of the target list")
+
+	// Labels
+	ErrLabelNotFound     = errors.New("label not found")
+	ErrLabelNotAttached  = errors.New("label is not attached to card")
+	ErrLabelNotOnBoard   = errors.New("label belongs to another board")
+	ErrInvalidLabelColor = errors.New("label color must be a hex color like #61bd4f")
+	ErrTooLongLabelName  = errors.New(fmt.Sprintf("label name must be no more than %d characters",
+		constants.MaxLabelNameLen))
+
+	// Assignees
+	ErrAssigneeNotFound       = errors.New("user is not assigned to card")
+	ErrAssigneeNotInWorkspace = errors.New("assignee must be a member of the card's workspace")
+
+	// Checklists
+	ErrChecklistNotFound     = errors.New("checklist not found")
+	ErrChecklistItemNotFound = errors.New("checklist item not found")
+	ErrEmptyChecklistTitle   = errors.New("checklist title must not be empty")
+	ErrTooLongChecklistTitle = errors.New(fmt.Sprintf("checklist title must be no more than %d characters",
+		constants.MaxChecklistTitleLen))
+
+	// Comments
+	ErrCommentNotFound  = errors.New("comment not found")
+	ErrNotCommentAuthor = errors.New("only the author can change the comment")
+	ErrEmptyComment     = errors.New("comment must not be empty")
+	ErrTooLongComment   = errors.New(fmt.Sprintf("comment must be no more than %d characters",
+		constants.MaxCommentLen))
+	ErrBadPagination = errors.New("limit and before must be positive integers")
+
+	// Images
+	ErrBadImage                = errors.New("image must be PNG, JPEG, GIF or WebP")
+	ErrTooLargeImage           = errors.New("image is too large")
+	ErrTooLargeImageDimensions = errors.New("image width or height is too large")
+
+	// Attachments
+	ErrAttachmentNotFound    = errors.New("attachment not found")
+	ErrEmptyAttachment       = errors.New("attachment must not be empty")
+	ErrTooLargeAttachment    = errors.New("attachment is too large")
+	ErrTooLongAttachmentName = errors.New(fmt.Sprintf("attachment name must be no more than %d characters",
+		constants.MaxAttachmentNameLen))
+
+	// Access
+	ErrAccessDenied = errors.New("access denied")
+
+	// Events
+	ErrEventBus = errors.New("event bus error")
+
+	// Trash
+	ErrBadTrashItemType = errors.New("trash item type must be one of board, list, card")
+
+	// Webhooks
+	ErrWebhookNotFound      = errors.New("webhook not found")
+	ErrInvalidWebhookURL    = errors.New("webhook url must be an absolute http or https url")
+	ErrWebhookURLNotAllowed = errors.New("webhook url must point to a public address")
+	ErrInvalidWebhookEvent  = errors.New("unknown webhook event type")
+	ErrWebhookSecretFailure = errors.New("webhook secret generation error")
 
 	// Auth
 	ErrWrongLoginOrPassword = errors.New("wrong login or password")
@@ -43,6 +132,7 @@ var (
 	ErrSessionNotFound = errors.New("session not found")
 
 	// HTTP
-	ErrReadBody         = errors.New("read request body error")
-	ErrBadSessionCookie = errors.New("bad session cookie")
+	ErrReadBody          = errors.New("read request body error")
+	ErrBadSessionCookie  = errors.New("bad session cookie")
+	ErrBadArchivedFilter = errors.New("archived filter must be true or false")
 )
@@ -17,24 +17,107 @@ var httpCodes = map[error]int{
 	// Workspaces
 	ErrWorkspaceNotFound: http.StatusNotFound,
 
+	// Members
+	ErrMemberNotFound:      http.StatusNotFound,
+	ErrMemberAlreadyExists: http.StatusConflict,
+	ErrInvalidRole:         http.StatusBadRequest,
+	ErrOwnerRoleImmutable:  http.StatusConflict,
+
+	// Invitations
+	ErrInvitationNotFound:   http.StatusNotFound,
+	ErrInvitationNotPending: http.StatusConflict,
+	ErrInvitationExpired:    http.StatusGone,
+	ErrInvitationNotForUser: http.StatusForbidden,
+	ErrEmptyInvitee:         http.StatusBadRequest,
+
 	// Boards
-	ErrBoardNotFound: http.StatusNotFound,
+	ErrBoardNotFound:             http.StatusNotFound,
+	ErrInvalidBackgroundType:     http.StatusBadRequest,
+	ErrInvalidBackgroundColor:    http.StatusBadRequest,
+	ErrInvalidBackgroundGradient: http.StatusBadRequest,
+	ErrTemplateNotFound:          http.StatusNotFound,
+	ErrBadExportVersion:          http.StatusBadRequest,
+	ErrBadExportLabel:            http.StatusBadRequest,
 
 	// Lists
 	ErrListNotFound:           http.StatusNotFound,
 	ErrTooLongListTitle:       http.StatusBadRequest,
 	ErrTooLongListDescription: http.StatusBadRequest,
+	ErrListArchivedMove:       http.StatusConflict,
+	ErrBoardArchived:          http.StatusConflict,
+	ErrBoardArchivedRestore:   http.StatusConflict,
+	ErrListMoveOtherWorkspace: http.StatusBadRequest,
+	ErrBadListNeighbors:       http.StatusBadRequest,
 
 	// Cards
-	ErrCardNotFound: http.StatusNotFound,
+	ErrCardNotFound:           http.StatusNotFound,
+	ErrInvalidCardDates:       http.StatusBadRequest,
+	ErrBadCardDate:            http.StatusBadRequest,
+	ErrBadCardFilter:          http.StatusBadRequest,
+	ErrBadCardSort:            http.StatusBadRequest,
+	ErrCardArchived:           http.StatusConflict,
+	ErrListArchived:           http.StatusConflict,
+	ErrCardMoveOtherWorkspace: http.StatusBadRequest,
+	ErrBadCardNeighbors:       http.StatusBadRequest,
+
+	// Labels
+	ErrLabelNotFound:     http.StatusNotFound,
+	ErrLabelNotAttached:  http.StatusNotFound,
+	ErrLabelNotOnBoard:   http.StatusBadRequest,
+	ErrInvalidLabelColor: http.StatusBadRequest,
+	ErrTooLongLabelName:  http.StatusBadRequest,
+
+	// Assignees
+	ErrAssigneeNotFound:       http.StatusNotFound,
+	ErrAssigneeNotInWorkspace: http.StatusBadRequest,
+
+	// Checklists
+	ErrChecklistNotFound:     http.StatusNotFound,
+	ErrChecklistItemNotFound: http.StatusNotFound,
+	ErrEmptyChecklistTitle:   http.StatusBadRequest,
+	ErrTooLongChecklistTitle: http.StatusBadRequest,
+
+	// Comments
+	ErrCommentNotFound:  http.StatusNotFound,
+	ErrNotCommentAuthor: http.StatusForbidden,
+	ErrEmptyComment:     http.StatusBadRequest,
+	ErrTooLongComment:   http.StatusBadRequest,
+	ErrBadPagination:    http.StatusBadRequest,
+
+	// Images
+	ErrBadImage:                http.StatusBadRequest,
+	ErrTooLargeImage:           http.StatusRequestEntityTooLarge,
+	ErrTooLargeImageDimensions: http.StatusBadRequest,
+
+	// Attachments
+	ErrAttachmentNotFound:    http.StatusNotFound,
+	ErrEmptyAttachment:       http.StatusBadRequest,
+	ErrTooLargeAttachment:    http.StatusRequestEntityTooLarge,
+	ErrTooLongAttachmentName: http.StatusBadRequest,
+
+	// Access
+	ErrAccessDenied: http.StatusForbidden,
+
+	// Events
+	ErrEventBus: http.StatusInternalServerError,
+
+	// Trash
+	ErrBadTrashItemType: http.StatusBadRequest,
+
+	// Webhooks
+	ErrWebhookNotFound:      http.StatusNotFound,
+	ErrInvalidWebhookURL:    http.StatusBadRequest,
+	ErrWebhookURLNotAllowed: http.StatusBadRequest,
+	ErrInvalidWebhookEvent:  http.StatusBadRequest,
 
 	// Auth
 	ErrWrongLoginOrPassword: http.StatusBadRequest,
 	ErrSessionNotFound:      http.StatusNotFound,
 
 	// HTTP
-	ErrReadBody:         http.StatusBadRequest,
-	ErrBadSessionCookie: http.StatusBadRequest,
+	ErrReadBody:          http.StatusBadRequest,
+	ErrBadSessionCookie:  http.StatusBadRequest,
+	ErrBadArchivedFilter: http.StatusBadRequest,
 }
 
 func GetHTTPCodeByError(err error) (int, bool) {
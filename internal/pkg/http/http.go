@@ -9,6 +9,7 @@ import (
 	"go.uber.org/zap"
 	"io"
 	"net/http"
+	"strconv"
 )
 
 func ReadBody(r *http.Request, log *zap.Logger) ([]byte, error) {
@@ -26,6 +27,21 @@ func ReadBody(r *http.Request, log *zap.Logger) ([]byte, error) {
 	return body, nil
 }
 
+// ParseArchived reads the archived query parameter that switches listings to
+// archived items. It is false when missing.
+func ParseArchived(r *http.Request) (bool, error) {
+	value := r.URL.Query().Get("archived")
+	if value == "" {
+		return false, nil
+	}
+
+	archived, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.Wrap(pErrors.ErrBadArchivedFilter, err.Error())
+	}
+	return archived, nil
+}
+
 type JSONError struct {
 	Error string `json:"error"`
 }
@@ -0,0 +1,158 @@
+// Package rank makes sort keys for ordered lists and cards. A key is a base-36
+// fraction between 0 and 1 written without the leading "0." and trailing zeros,
+// so keys compare as byte strings (COLLATE "C" in Postgres) and there is always
+// room for a key between two others.
+package rank
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	digits = "0123456789abcdefghijklmnopqrstuvwxyz"
+	base   = int64(len(digits))
+
+	// Width is the number of digits of keys appended with After and Before.
+	Width = 6
+	// step leaves room for keys between consecutive appended ones before they
+	// get longer than Width.
+	step = base * base * base
+	// maxWidth keeps Spread within int64.
+	maxWidth = 12
+)
+
+var ErrOrder = errors.New("rank: keys out of order")
+
+// Between returns a key between before and after. An empty before means the
+// start, an empty after means the end. Keys at the ends are a step away from
+// their neighbors, like the ones of After and Before.
+func Between(before, after string) (string, error) {
+	switch {
+	case after == "":
+		return After(before), nil
+	case before == "":
+		return Before(after), nil
+	case before >= after:
+		return "", ErrOrder
+	}
+	return midpoint(before, after), nil
+}
+
+// After returns a key following key a step away, so that appending stays short.
+// An empty key means the start.
+func After(key string) string {
+	if key == "" {
+		return midpoint("", "")
+	}
+
+	value := parse(key, Width) + step
+	if value >= pow(Width) {
+		return midpoint(key, "")
+	}
+	return format(value, Width)
+}
+
+// Before returns a key preceding key a step away. An empty key means the end.
+func Before(key string) string {
+	if key == "" {
+		return midpoint("", "")
+	}
+
+	// The first Width digits are no greater than the key.
+	value := parse(key, Width) - step
+	if value < 1 {
+		return midpoint("", key)
+	}
+	return format(value, Width)
+}
+
+// Spread returns n evenly spaced keys in order. The keys are as long as it takes
+// to leave room between them.
+func Spread(n int) []string {
+	width := Width
+	for width < maxWidth && pow(width)/int64(n+1) < base*base {
+		width++
+	}
+
+	gap := pow(width) / int64(n+1)
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = format(int64(i+1)*gap, width)
+	}
+	return keys
+}
+
+// midpoint returns a key between a and b, where b is greater than a or empty.
+func midpoint(a, b string) string {
+	if b != "" {
+		// The common prefix, with a padded by zeros, stays as it is.
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			return b[:n] + midpoint(suffix(a, n), b[n:])
+		}
+	}
+
+	digitA := int64(0)
+	if a != "" {
+		digitA = int64(strings.IndexByte(digits, a[0]))
+	}
+	digitB := base
+	if b != "" {
+		digitB = int64(strings.IndexByte(digits, b[0]))
+	}
+	if digitB-digitA > 1 {
+		return string(digits[(digitA+digitB+1)/2])
+	}
+
+	// The first digits are consecutive. The first digit of a longer b is
+	// already between them, otherwise a gets one more digit.
+	if len(b) > 1 {
+		return b[:1]
+	}
+	return string(digits[digitA]) + midpoint(suffix(a, 1), "")
+}
+
+func digitAt(key string, i int) byte {
+	if i < len(key) {
+		return key[i]
+	}
+	return digits[0]
+}
+
+func suffix(key string, i int) string {
+	if i < len(key) {
+		return key[i:]
+	}
+	return ""
+}
+
+// parse reads the first width digits of key as an integer.
+func parse(key string, width int) int64 {
+	var value int64
+	for i := 0; i < width; i++ {
+		value = value*base + int64(strings.IndexByte(digits, digitAt(key, i)))
+	}
+	return value
+}
+
+// format writes value as width digits without trailing zeros.
+func format(value int64, width int) string {
+	key := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		key[i] = digits[value%base]
+		value /= base
+	}
+	return strings.TrimRight(string(key), digits[:1])
+}
+
+func pow(width int) int64 {
+	value := int64(1)
+	for i := 0; i < width; i++ {
+		value *= base
+	}
+	return value
+}
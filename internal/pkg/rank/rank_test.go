@@ -0,0 +1,130 @@
+package rank
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func valid(t *testing.T, key string) {
+	t.Helper()
+	require.NotEmpty(t, key)
+	assert.NotEqual(t, byte('0'), key[len(key)-1], "trailing zero in %q", key)
+	for i := range key {
+		assert.True(t, strings.IndexByte(digits, key[i]) >= 0, "bad digit in %q", key)
+	}
+}
+
+func TestMidpoint(t *testing.T) {
+	tests := []struct {
+		a, b, key string
+	}{
+		{"", "", "i"},
+		{"", "i", "9"},
+		{"i", "", "r"},
+		{"i", "j", "ii"},
+		{"i", "i05", "i03"},
+		{"i", "j5", "j"},
+		{"z", "", "zi"},
+		{"", "01", "00i"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.key, midpoint(test.a, test.b), "between %q and %q", test.a, test.b)
+	}
+}
+
+func TestBetween(t *testing.T) {
+	key, err := Between("", "")
+	require.NoError(t, err)
+	assert.Equal(t, "i", key)
+
+	key, err = Between("i", "")
+	require.NoError(t, err)
+	assert.Equal(t, After("i"), key)
+
+	key, err = Between("", "i")
+	require.NoError(t, err)
+	assert.Equal(t, Before("i"), key)
+
+	key, err = Between("i", "j")
+	require.NoError(t, err)
+	assert.Equal(t, "ii", key)
+
+	_, err = Between("i", "i")
+	assert.ErrorIs(t, err, ErrOrder)
+	_, err = Between("j", "i")
+	assert.ErrorIs(t, err, ErrOrder)
+}
+
+func TestBetween_Repeated(t *testing.T) {
+	// Inserting again and again at the same place keeps the keys ordered.
+	keys := []string{"i", "j"}
+	for i := 0; i < 200; i++ {
+		key, err := Between(keys[0], keys[1])
+		require.NoError(t, err)
+		valid(t, key)
+		require.True(t, keys[0] < key && key < keys[1], "%q not between %q and %q", key, keys[0], keys[1])
+		keys[1] = key
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	keys = []string{After("")}
+	for i := 0; i < 1000; i++ {
+		at := rng.Intn(len(keys) + 1)
+		var before, after string
+		if at > 0 {
+			before = keys[at-1]
+		}
+		if at < len(keys) {
+			after = keys[at]
+		}
+		key, err := Between(before, after)
+		require.NoError(t, err)
+		valid(t, key)
+		keys = append(keys[:at], append([]string{key}, keys[at:]...)...)
+	}
+	assert.True(t, sort.StringsAreSorted(keys))
+}
+
+func TestAfterBefore(t *testing.T) {
+	key := After("")
+	assert.Equal(t, "i", key)
+	for i := 0; i < 100; i++ {
+		next := After(key)
+		valid(t, next)
+		require.True(t, key < next, "%q not after %q", next, key)
+		assert.LessOrEqual(t, len(next), Width)
+		key = next
+	}
+	assert.True(t, After("zzzzzz") > "zzzzzz")
+	assert.True(t, After("i00001i") > "i00001i")
+
+	key = Before("")
+	for i := 0; i < 100; i++ {
+		prev := Before(key)
+		valid(t, prev)
+		require.True(t, prev < key, "%q not before %q", prev, key)
+		key = prev
+	}
+	assert.True(t, Before("00001") < "00001")
+	assert.True(t, Before("00001") > "")
+}
+
+func TestSpread(t *testing.T) {
+	assert.Empty(t, Spread(0))
+
+	for _, n := range []int{1, 3, 1000, 100000} {
+		keys := Spread(n)
+		require.Len(t, keys, n)
+		assert.True(t, sort.StringsAreSorted(keys))
+		for i, key := range keys {
+			valid(t, key)
+			if i > 0 {
+				require.NotEqual(t, keys[i-1], key)
+			}
+		}
+	}
+}
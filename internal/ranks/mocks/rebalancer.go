@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/ranks/rebalancer.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	ranks "github.com/SlavaShagalov/my-trello-backend/internal/ranks"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRebalancer is a mock of Rebalancer interface.
+type MockRebalancer struct {
+	ctrl     *gomock.Controller
+	recorder *MockRebalancerMockRecorder
+}
+
+// MockRebalancerMockRecorder is the mock recorder for MockRebalancer.
+type MockRebalancerMockRecorder struct {
+	mock *MockRebalancer
+}
+
+// NewMockRebalancer creates a new mock instance.
+func NewMockRebalancer(ctrl *gomock.Controller) *MockRebalancer {
+	mock := &MockRebalancer{ctrl: ctrl}
+	mock.recorder = &MockRebalancerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRebalancer) EXPECT() *MockRebalancerMockRecorder {
+	return m.recorder
+}
+
+// Rebalance mocks base method.
+func (m *MockRebalancer) Rebalance(ctx context.Context) (ranks.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rebalance", ctx)
+	ret0, _ := ret[0].(ranks.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rebalance indicates an expected call of Rebalance.
+func (mr *MockRebalancerMockRecorder) Rebalance(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rebalance", reflect.TypeOf((*MockRebalancer)(nil).Rebalance), ctx)
+}
+
+// Run mocks base method.
+func (m *MockRebalancer) Run(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Run", ctx)
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockRebalancerMockRecorder) Run(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockRebalancer)(nil).Run), ctx)
+}
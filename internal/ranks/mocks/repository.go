@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/ranks/repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	ranks "github.com/SlavaShagalov/my-trello-backend/internal/ranks"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListCrowded mocks base method.
+func (m *MockRepository) ListCrowded(maxLen int) ([]ranks.Container, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCrowded", maxLen)
+	ret0, _ := ret[0].([]ranks.Container)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCrowded indicates an expected call of ListCrowded.
+func (mr *MockRepositoryMockRecorder) ListCrowded(maxLen interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrowded", reflect.TypeOf((*MockRepository)(nil).ListCrowded), maxLen)
+}
+
+// Rebalance mocks base method.
+func (m *MockRepository) Rebalance(container ranks.Container) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rebalance", container)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rebalance indicates an expected call of Rebalance.
+func (mr *MockRepositoryMockRecorder) Rebalance(container interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rebalance", reflect.TypeOf((*MockRepository)(nil).Rebalance), container)
+}
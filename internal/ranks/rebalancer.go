@@ -0,0 +1,17 @@
+package ranks
+
+import "context"
+
+// Report sums up a rebalancing run.
+type Report struct {
+	Boards int64
+	Lists  int64
+}
+
+type Rebalancer interface {
+	// Run rebalances ranks periodically until ctx is done.
+	Run(ctx context.Context)
+	// Rebalance spreads the ranks of the boards and lists where repeated moves
+	// to the same place made them too long.
+	Rebalance(ctx context.Context) (Report, error)
+}
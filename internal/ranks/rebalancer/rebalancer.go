@@ -0,0 +1,80 @@
+package rebalancer
+
+import (
+	"context"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	"github.com/SlavaShagalov/my-trello-backend/internal/ranks"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"time"
+)
+
+type rebalancer struct {
+	repo ranks.Repository
+	log  *zap.Logger
+}
+
+func New(repo ranks.Repository, log *zap.Logger) ranks.Rebalancer {
+	return &rebalancer{
+		repo: repo,
+		log:  log,
+	}
+}
+
+func (r *rebalancer) Run(ctx context.Context) {
+	interval := viper.GetDuration(config.RankRebalanceInterval)
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		// Failures are logged, the next run tries again.
+		_, _ = r.Rebalance(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Rebalance tries all crowded containers and returns the first error.
+func (r *rebalancer) Rebalance(ctx context.Context) (ranks.Report, error) {
+	if ctx.Err() != nil {
+		return ranks.Report{}, ctx.Err()
+	}
+
+	containers, err := r.repo.ListCrowded(viper.GetInt(config.MaxRankLen))
+	if err != nil {
+		return ranks.Report{}, err
+	}
+
+	var report ranks.Report
+	var firstErr error
+	for _, container := range containers {
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+
+		_, err = r.repo.Rebalance(container)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		switch container.Kind {
+		case ranks.KindLists:
+			report.Boards++
+		case ranks.KindCards:
+			report.Lists++
+		}
+	}
+
+	r.log.Info("Ranks rebalanced", zap.Int64("boards", report.Boards), zap.Int64("lists", report.Lists))
+	return report, firstErr
+}
@@ -0,0 +1,74 @@
+package rebalancer
+
+import (
+	"context"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pkgRanks "github.com/SlavaShagalov/my-trello-backend/internal/ranks"
+	"github.com/SlavaShagalov/my-trello-backend/internal/ranks/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+)
+
+func TestRebalancer_Rebalance(t *testing.T) {
+	viper.Set(config.MaxRankLen, 10)
+
+	board := pkgRanks.Container{Kind: pkgRanks.KindLists, ID: 3}
+	list := pkgRanks.Container{Kind: pkgRanks.KindCards, ID: 7}
+	otherList := pkgRanks.Container{Kind: pkgRanks.KindCards, ID: 8}
+
+	t.Run("rebalances crowded", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().ListCrowded(10).Return([]pkgRanks.Container{board, list, otherList}, nil)
+		repo.EXPECT().Rebalance(board).Return(int64(4), nil)
+		repo.EXPECT().Rebalance(list).Return(int64(120), nil)
+		repo.EXPECT().Rebalance(otherList).Return(int64(2), nil)
+
+		report, err := New(repo, zap.NewNop()).Rebalance(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, pkgRanks.Report{Boards: 1, Lists: 2}, report)
+	})
+
+	t.Run("continues after error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().ListCrowded(10).Return([]pkgRanks.Container{board, list}, nil)
+		repo.EXPECT().Rebalance(board).Return(int64(0), pkgErrors.ErrDb)
+		repo.EXPECT().Rebalance(list).Return(int64(120), nil)
+
+		report, err := New(repo, zap.NewNop()).Rebalance(context.Background())
+		assert.ErrorIs(t, err, pkgErrors.ErrDb)
+		assert.Equal(t, pkgRanks.Report{Lists: 1}, report)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().ListCrowded(10).Return(nil, pkgErrors.ErrDb)
+
+		_, err := New(repo, zap.NewNop()).Rebalance(context.Background())
+		assert.ErrorIs(t, err, pkgErrors.ErrDb)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := New(mocks.NewMockRepository(ctrl), zap.NewNop()).Rebalance(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
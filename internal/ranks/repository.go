@@ -0,0 +1,23 @@
+package ranks
+
+// Kinds of containers whose items are ranked together.
+const (
+	// KindLists are the lists of a board.
+	KindLists = "lists"
+	// KindCards are the cards of a list.
+	KindCards = "cards"
+)
+
+// Container is a board or a list whose lists or cards are ranked together.
+type Container struct {
+	Kind string
+	ID   int
+}
+
+type Repository interface {
+	// ListCrowded returns the containers with ranks longer than maxLen.
+	ListCrowded(maxLen int) ([]Container, error)
+	// Rebalance spreads the ranks of all items of the container evenly, archived
+	// ones too, keeping their order. It returns the number of items.
+	Rebalance(container Container) (int64, error)
+}
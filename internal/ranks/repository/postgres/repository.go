@@ -0,0 +1,156 @@
+package postgres
+
+import (
+	"database/sql"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/rank"
+	pkgRanks "github.com/SlavaShagalov/my-trello-backend/internal/ranks"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+type repository struct {
+	db  *sql.DB
+	log *zap.Logger
+}
+
+func New(db *sql.DB, log *zap.Logger) pkgRanks.Repository {
+	return &repository{db: db, log: log}
+}
+
+const listCrowdedCmd = `
+	SELECT 'lists', board_id
+	FROM lists
+	GROUP BY board_id
+	HAVING max(length(rank)) > $1
+	UNION ALL
+	SELECT 'cards', list_id
+	FROM cards
+	GROUP BY list_id
+	HAVING max(length(rank)) > $1;`
+
+func (repo *repository) ListCrowded(maxLen int) ([]pkgRanks.Container, error) {
+	rows, err := repo.db.Query(listCrowdedCmd, maxLen)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listCrowdedCmd),
+			zap.Int("max_len", maxLen))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	containers := []pkgRanks.Container{}
+	for rows.Next() {
+		var container pkgRanks.Container
+		err = rows.Scan(&container.Kind, &container.ID)
+		if err != nil {
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", listCrowdedCmd),
+				zap.Int("max_len", maxLen))
+			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+
+		containers = append(containers, container)
+	}
+
+	return containers, nil
+}
+
+// Items of the containers are locked in the order of their ranks, the new
+// ranks are written at once. The unique ranks are checked at the end of the
+// statement, so the new ranks may take the old ones of other items.
+var (
+	lockItemsCmds = map[string]string{
+		pkgRanks.KindLists: `
+	SELECT id
+	FROM lists
+	WHERE board_id = $1
+	ORDER BY rank
+	FOR UPDATE;`,
+		pkgRanks.KindCards: `
+	SELECT id
+	FROM cards
+	WHERE list_id = $1
+	ORDER BY rank
+	FOR UPDATE;`,
+	}
+
+	setRanksCmds = map[string]string{
+		pkgRanks.KindLists: `
+	UPDATE lists l
+	SET rank = r.rank
+	FROM unnest($2::int[], $3::varchar[]) AS r(id, rank)
+	WHERE l.id = r.id AND l.board_id = $1;`,
+		pkgRanks.KindCards: `
+	UPDATE cards c
+	SET rank = r.rank
+	FROM unnest($2::int[], $3::varchar[]) AS r(id, rank)
+	WHERE c.id = r.id AND c.list_id = $1;`,
+	}
+)
+
+func (repo *repository) Rebalance(container pkgRanks.Container) (int64, error) {
+	lockItemsCmd, ok := lockItemsCmds[container.Kind]
+	if !ok {
+		return 0, errors.Errorf("unknown container kind %q", container.Kind)
+	}
+	setRanksCmd := setRanksCmds[container.Kind]
+
+	tx, err := repo.db.Begin()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Any("container", container))
+		return 0, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	rows, err := tx.Query(lockItemsCmd, container.ID)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", lockItemsCmd),
+			zap.Any("container", container))
+		return 0, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		err = rows.Scan(&id)
+		if err != nil {
+			_ = rows.Close()
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", lockItemsCmd),
+				zap.Any("container", container))
+			return 0, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+		ids = append(ids, id)
+	}
+	_ = rows.Close()
+	if err = rows.Err(); err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", lockItemsCmd),
+			zap.Any("container", container))
+		return 0, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	result, err := tx.Exec(setRanksCmd, container.ID, pq.Array(ids), pq.Array(rank.Spread(len(ids))))
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", setRanksCmd),
+			zap.Any("container", container))
+		return 0, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", setRanksCmd),
+			zap.Any("container", container))
+		return 0, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Any("container", container))
+		return 0, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("Ranks rebalanced", zap.Any("container", container), zap.Int64("items", rowsAffected))
+	return rowsAffected, nil
+}
@@ -0,0 +1,147 @@
+package http
+
+import (
+	pAccess "github.com/SlavaShagalov/my-trello-backend/internal/access"
+	mw "github.com/SlavaShagalov/my-trello-backend/internal/middleware"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pHTTP "github.com/SlavaShagalov/my-trello-backend/internal/pkg/http"
+	pTrash "github.com/SlavaShagalov/my-trello-backend/internal/trash"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+type delivery struct {
+	uc       pTrash.Usecase
+	accessUC pAccess.Usecase
+	log      *zap.Logger
+}
+
+func RegisterHandlers(mux *mux.Router, uc pTrash.Usecase, accessUC pAccess.Usecase, log *zap.Logger,
+	checkAuth mw.Middleware, metrics mw.Middleware) {
+	del := delivery{
+		uc:       uc,
+		accessUC: accessUC,
+		log:      log,
+	}
+
+	const (
+		workspaceTrashPath = constants.ApiPrefix + "/workspaces/{id}/trash"
+		restorePath        = constants.ApiPrefix + "/trash/{type}/{id}/restore"
+	)
+
+	mux.HandleFunc(workspaceTrashPath, metrics(checkAuth(del.listByWorkspace))).Methods(http.MethodGet)
+	mux.HandleFunc(restorePath, metrics(checkAuth(del.restore))).Methods(http.MethodPost)
+}
+
+// listByWorkspace godoc
+//
+//	@Summary		Returns trash of workspace
+//	@Description	Returns archived boards, lists and cards of the workspace with who archived them and when,
+//	@Description	the last archived first. Items are purged permanently at purge_at.
+//	@Tags			workspaces
+//	@Produce		json
+//	@Param			id	path		int				true	"Workspace ID"
+//	@Success		200	{object}	listResponse	"Trash items"
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/workspaces/{id}/trash [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) listByWorkspace(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	workspaceID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckWorkspace(userID, workspaceID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	items, err := del.uc.ListByWorkspace(workspaceID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newListResponse(items)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// restore godoc
+//
+//	@Summary		Restore item from trash
+//	@Description	Restore archived board, list or card. Lists and cards go back between the items they were
+//	@Description	archived from. Restoring a card of an archived list restores the list too. Lists and cards
+//	@Description	of an archived board are restored after the board only.
+//	@Tags			trash
+//	@Produce		json
+//	@Param			type	path	string	true	"board, list or card"
+//	@Param			id		path	int		true	"Item ID"
+//	@Success		204		"Item restored successfully"
+//	@Failure		400		{object}	http.JSONError
+//	@Failure		401		{object}	http.JSONError
+//	@Failure		403		{object}	http.JSONError
+//	@Failure		404		{object}	http.JSONError
+//	@Failure		409		{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/trash/{type}/{id}/restore [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) restore(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	itemType := vars["type"]
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	// The same access as for unarchiving the item.
+	switch itemType {
+	case models.TrashItemBoard:
+		err = del.accessUC.CheckBoard(userID, id, pAccess.Manage)
+	case models.TrashItemList:
+		err = del.accessUC.CheckList(userID, id, pAccess.Write)
+	case models.TrashItemCard:
+		err = del.accessUC.CheckCard(userID, id, pAccess.Write)
+	default:
+		err = pErrors.ErrBadTrashItemType
+	}
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	err = del.uc.Restore(r.Context(), itemType, id)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
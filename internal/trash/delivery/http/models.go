@@ -0,0 +1,16 @@
+package http
+
+import "github.com/SlavaShagalov/my-trello-backend/internal/models"
+
+//go:generate easyjson -all -snake_case models.go
+
+// API responses
+type listResponse struct {
+	Items []models.TrashItem `json:"items"`
+}
+
+func newListResponse(items []models.TrashItem) *listResponse {
+	return &listResponse{
+		Items: items,
+	}
+}
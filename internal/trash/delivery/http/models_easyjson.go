@@ -0,0 +1,282 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package http
+
+import (
+	json "encoding/json"
+	models "github.com/SlavaShagalov/my-trello-backend/internal/models"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalTrashDeliveryHttp(in *jlexer.Lexer, out *listResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]models.TrashItem, 0, 0)
+					} else {
+						out.Items = []models.TrashItem{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 models.TrashItem
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels(in, &v1)
+					out.Items = append(out.Items, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalTrashDeliveryHttp(out *jwriter.Writer, in listResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix[1:])
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Items {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels(out, v3)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v listResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalTrashDeliveryHttp(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v listResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalTrashDeliveryHttp(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *listResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalTrashDeliveryHttp(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *listResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalTrashDeliveryHttp(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels(in *jlexer.Lexer, out *models.TrashItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "board_id":
+			out.BoardID = int(in.Int())
+		case "board_title":
+			out.BoardTitle = string(in.String())
+		case "list_id":
+			if in.IsNull() {
+				in.Skip()
+				out.ListID = nil
+			} else {
+				if out.ListID == nil {
+					out.ListID = new(int)
+				}
+				*out.ListID = int(in.Int())
+			}
+		case "list_title":
+			if in.IsNull() {
+				in.Skip()
+				out.ListTitle = nil
+			} else {
+				if out.ListTitle == nil {
+					out.ListTitle = new(string)
+				}
+				*out.ListTitle = string(in.String())
+			}
+		case "archived_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ArchivedAt).UnmarshalJSON(data))
+			}
+		case "archived_by":
+			if in.IsNull() {
+				in.Skip()
+				out.ArchivedBy = nil
+			} else {
+				if out.ArchivedBy == nil {
+					out.ArchivedBy = new(int)
+				}
+				*out.ArchivedBy = int(in.Int())
+			}
+		case "archived_by_username":
+			if in.IsNull() {
+				in.Skip()
+				out.ArchivedByUsername = nil
+			} else {
+				if out.ArchivedByUsername == nil {
+					out.ArchivedByUsername = new(string)
+				}
+				*out.ArchivedByUsername = string(in.String())
+			}
+		case "purge_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PurgeAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels(out *jwriter.Writer, in models.TrashItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.BoardID))
+	}
+	{
+		const prefix string = ",\"board_title\":"
+		out.RawString(prefix)
+		out.String(string(in.BoardTitle))
+	}
+	{
+		const prefix string = ",\"list_id\":"
+		out.RawString(prefix)
+		if in.ListID == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.ListID))
+		}
+	}
+	{
+		const prefix string = ",\"list_title\":"
+		out.RawString(prefix)
+		if in.ListTitle == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.ListTitle))
+		}
+	}
+	{
+		const prefix string = ",\"archived_at\":"
+		out.RawString(prefix)
+		out.Raw((in.ArchivedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"archived_by\":"
+		out.RawString(prefix)
+		if in.ArchivedBy == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.ArchivedBy))
+		}
+	}
+	{
+		const prefix string = ",\"archived_by_username\":"
+		out.RawString(prefix)
+		if in.ArchivedByUsername == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.ArchivedByUsername))
+		}
+	}
+	{
+		const prefix string = ",\"purge_at\":"
+		out.RawString(prefix)
+		out.Raw((in.PurgeAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
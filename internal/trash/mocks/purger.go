@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/trash/purger.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	trash "github.com/SlavaShagalov/my-trello-backend/internal/trash"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPurger is a mock of Purger interface.
+type MockPurger struct {
+	ctrl     *gomock.Controller
+	recorder *MockPurgerMockRecorder
+}
+
+// MockPurgerMockRecorder is the mock recorder for MockPurger.
+type MockPurgerMockRecorder struct {
+	mock *MockPurger
+}
+
+// NewMockPurger creates a new mock instance.
+func NewMockPurger(ctrl *gomock.Controller) *MockPurger {
+	mock := &MockPurger{ctrl: ctrl}
+	mock.recorder = &MockPurgerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPurger) EXPECT() *MockPurgerMockRecorder {
+	return m.recorder
+}
+
+// Purge mocks base method.
+func (m *MockPurger) Purge(ctx context.Context) (trash.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx)
+	ret0, _ := ret[0].(trash.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockPurgerMockRecorder) Purge(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockPurger)(nil).Purge), ctx)
+}
+
+// Run mocks base method.
+func (m *MockPurger) Run(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Run", ctx)
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockPurgerMockRecorder) Run(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockPurger)(nil).Run), ctx)
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/trash/repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	models "github.com/SlavaShagalov/my-trello-backend/internal/models"
+	trash "github.com/SlavaShagalov/my-trello-backend/internal/trash"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListByWorkspace mocks base method.
+func (m *MockRepository) ListByWorkspace(workspaceID int) ([]models.TrashItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByWorkspace", workspaceID)
+	ret0, _ := ret[0].([]models.TrashItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByWorkspace indicates an expected call of ListByWorkspace.
+func (mr *MockRepositoryMockRecorder) ListByWorkspace(workspaceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByWorkspace", reflect.TypeOf((*MockRepository)(nil).ListByWorkspace), workspaceID)
+}
+
+// Purge mocks base method.
+func (m *MockRepository) Purge(archivedBefore time.Time) (trash.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", archivedBefore)
+	ret0, _ := ret[0].(trash.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockRepositoryMockRecorder) Purge(archivedBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockRepository)(nil).Purge), archivedBefore)
+}
+
+// RestoreCard mocks base method.
+func (m *MockRepository) RestoreCard(id int) (trash.Restored, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCard", id)
+	ret0, _ := ret[0].(trash.Restored)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreCard indicates an expected call of RestoreCard.
+func (mr *MockRepositoryMockRecorder) RestoreCard(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCard", reflect.TypeOf((*MockRepository)(nil).RestoreCard), id)
+}
+
+// RestoreList mocks base method.
+func (m *MockRepository) RestoreList(id int) (trash.Restored, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreList", id)
+	ret0, _ := ret[0].(trash.Restored)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreList indicates an expected call of RestoreList.
+func (mr *MockRepositoryMockRecorder) RestoreList(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreList", reflect.TypeOf((*MockRepository)(nil).RestoreList), id)
+}
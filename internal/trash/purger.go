@@ -0,0 +1,23 @@
+package trash
+
+import "context"
+
+// Report sums up a purge of the trash. Cards and lists deleted with their list
+// or board are not counted.
+type Report struct {
+	Boards int64
+	Lists  int64
+	Cards  int64
+	// Backgrounds and Attachments are the keys of the files of the purged
+	// boards and cards, removed from the storage after the purge.
+	Backgrounds []string
+	Attachments []string
+}
+
+type Purger interface {
+	// Run purges the trash periodically until ctx is done.
+	Run(ctx context.Context)
+	// Purge deletes the items that have been archived for longer than the
+	// retention window, then their files.
+	Purge(ctx context.Context) (Report, error)
+}
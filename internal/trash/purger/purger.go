@@ -0,0 +1,83 @@
+package purger
+
+import (
+	"context"
+	"github.com/SlavaShagalov/my-trello-backend/internal/images"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	"github.com/SlavaShagalov/my-trello-backend/internal/trash"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"time"
+)
+
+type purger struct {
+	repo    trash.Repository
+	imgRepo images.Repository
+	log     *zap.Logger
+}
+
+func New(repo trash.Repository, imgRepo images.Repository, log *zap.Logger) trash.Purger {
+	return &purger{
+		repo:    repo,
+		imgRepo: imgRepo,
+		log:     log,
+	}
+}
+
+func (p *purger) Run(ctx context.Context) {
+	interval := viper.GetDuration(config.TrashPurgeInterval)
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		// Failures are logged, the next run tries again.
+		_, _ = p.Purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *purger) Purge(ctx context.Context) (trash.Report, error) {
+	if ctx.Err() != nil {
+		return trash.Report{}, ctx.Err()
+	}
+
+	archivedBefore := time.Now().UTC().Add(-viper.GetDuration(config.TrashRetention))
+	report, err := p.repo.Purge(archivedBefore)
+	if err != nil {
+		return report, err
+	}
+
+	failed := p.removeFiles(&report)
+
+	p.log.Info("Trash purged", zap.Time("archived_before", archivedBefore), zap.Int64("boards", report.Boards),
+		zap.Int64("lists", report.Lists), zap.Int64("cards", report.Cards),
+		zap.Int("backgrounds", len(report.Backgrounds)), zap.Int("attachments", len(report.Attachments)),
+		zap.Int("failed_files", failed))
+	return report, nil
+}
+
+// removeFiles removes the files of the purged items and returns the number of
+// failures. The items are already deleted, so the images collector removes the
+// files left later.
+func (p *purger) removeFiles(report *trash.Report) int {
+	failed := 0
+	for _, key := range report.Backgrounds {
+		if images.Remove(p.imgRepo, key, images.BackgroundThumbnails) != nil {
+			failed++
+		}
+	}
+	for _, key := range report.Attachments {
+		if p.imgRepo.Delete(key) != nil {
+			failed++
+		}
+	}
+	return failed
+}
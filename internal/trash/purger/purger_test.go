@@ -0,0 +1,86 @@
+package purger
+
+import (
+	"context"
+	"github.com/SlavaShagalov/my-trello-backend/internal/images"
+	imagesMocks "github.com/SlavaShagalov/my-trello-backend/internal/images/mocks"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pkgTrash "github.com/SlavaShagalov/my-trello-backend/internal/trash"
+	"github.com/SlavaShagalov/my-trello-backend/internal/trash/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+func TestPurger_Purge(t *testing.T) {
+	viper.Set(config.TrashRetention, 24*time.Hour)
+
+	t.Run("purges expired", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		want := pkgTrash.Report{Boards: 1, Lists: 2, Cards: 5}
+		repo.EXPECT().Purge(gomock.Any()).DoAndReturn(func(archivedBefore time.Time) (pkgTrash.Report, error) {
+			assert.WithinDuration(t, time.Now().Add(-24*time.Hour), archivedBefore, time.Minute)
+			return want, nil
+		})
+
+		report, err := New(repo, imagesMocks.NewMockRepository(ctrl), zap.NewNop()).Purge(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, want, report)
+	})
+
+	t.Run("removes files", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		want := pkgTrash.Report{
+			Boards:      1,
+			Cards:       1,
+			Backgrounds: []string{"backgrounds/1.jpg"},
+			Attachments: []string{"attachments/1/a", "attachments/2/b"},
+		}
+		repo.EXPECT().Purge(gomock.Any()).Return(want, nil)
+
+		imgRepo := imagesMocks.NewMockRepository(ctrl)
+		imgRepo.EXPECT().Delete("backgrounds/1.jpg").Return(nil)
+		for _, thumbnail := range images.BackgroundThumbnails {
+			imgRepo.EXPECT().Delete(images.ThumbnailKey("backgrounds/1.jpg", thumbnail.Name)).Return(nil)
+		}
+		imgRepo.EXPECT().Delete("attachments/1/a").Return(pkgErrors.ErrDb)
+		imgRepo.EXPECT().Delete("attachments/2/b").Return(nil)
+
+		report, err := New(repo, imgRepo, zap.NewNop()).Purge(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, want, report)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().Purge(gomock.Any()).Return(pkgTrash.Report{}, pkgErrors.ErrDb)
+
+		_, err := New(repo, imagesMocks.NewMockRepository(ctrl), zap.NewNop()).Purge(context.Background())
+		assert.ErrorIs(t, err, pkgErrors.ErrDb)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := New(mocks.NewMockRepository(ctrl), imagesMocks.NewMockRepository(ctrl), zap.NewNop()).Purge(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -0,0 +1,32 @@
+package trash
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"time"
+)
+
+// Restored tells what a restore brought back.
+type Restored struct {
+	BoardID int
+	ListID  int
+	// CardID is 0 when a list was restored.
+	CardID int
+	// ListRestored is set when the list was archived and is restored.
+	ListRestored bool
+}
+
+type Repository interface {
+	// ListByWorkspace returns the archived boards, lists and cards of the workspace
+	// with the last archived first.
+	ListByWorkspace(workspaceID int) ([]models.TrashItem, error)
+	// Purge deletes the boards, lists and cards archived before archivedBefore
+	// with everything they contain. The files are left to the caller.
+	Purge(archivedBefore time.Time) (Report, error)
+	// RestoreList restores the list. It fails with ErrBoardArchivedRestore when
+	// the board of the list is archived: the board has to be restored first.
+	RestoreList(id int) (Restored, error)
+	// RestoreCard restores the card together with its list, if that is archived
+	// as well, in one transaction. It fails with ErrBoardArchivedRestore when the
+	// board of the card is archived.
+	RestoreCard(id int) (Restored, error)
+}
@@ -0,0 +1,347 @@
+package postgres
+
+import (
+	"database/sql"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pkgTrash "github.com/SlavaShagalov/my-trello-backend/internal/trash"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"time"
+)
+
+type repository struct {
+	db  *sql.DB
+	log *zap.Logger
+}
+
+func New(db *sql.DB, log *zap.Logger) pkgTrash.Repository {
+	return &repository{db: db, log: log}
+}
+
+// listByWorkspaceCmd selects items of the types of models.TrashItem. Only items
+// archived themselves are selected, not the content of archived lists and boards.
+const listByWorkspaceCmd = `
+	SELECT 'board', b.id, b.title, b.id, b.title, NULL::int, NULL::varchar, b.archived_at, b.archived_by, u.username
+	FROM boards b
+	LEFT JOIN users u on u.id = b.archived_by
+	WHERE b.workspace_id = $1 AND b.archived_at IS NOT NULL
+	UNION ALL
+	SELECT 'list', l.id, l.title, b.id, b.title, NULL, NULL, l.archived_at, l.archived_by, u.username
+	FROM lists l
+	JOIN boards b on b.id = l.board_id
+	LEFT JOIN users u on u.id = l.archived_by
+	WHERE b.workspace_id = $1 AND l.archived_at IS NOT NULL
+	UNION ALL
+	SELECT 'card', c.id, c.title, b.id, b.title, l.id, l.title, c.archived_at, c.archived_by, u.username
+	FROM cards c
+	JOIN lists l on l.id = c.list_id
+	JOIN boards b on b.id = l.board_id
+	LEFT JOIN users u on u.id = c.archived_by
+	WHERE b.workspace_id = $1 AND c.archived_at IS NOT NULL
+	ORDER BY 8 DESC;`
+
+func (repo *repository) ListByWorkspace(workspaceID int) ([]models.TrashItem, error) {
+	rows, err := repo.db.Query(listByWorkspaceCmd, workspaceID)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listByWorkspaceCmd),
+			zap.Int("workspace_id", workspaceID))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	items := []models.TrashItem{}
+	for rows.Next() {
+		var item models.TrashItem
+		var listID, archivedBy sql.NullInt64
+		var listTitle, archivedByUsername sql.NullString
+		err = rows.Scan(
+			&item.Type,
+			&item.ID,
+			&item.Title,
+			&item.BoardID,
+			&item.BoardTitle,
+			&listID,
+			&listTitle,
+			&item.ArchivedAt,
+			&archivedBy,
+			&archivedByUsername,
+		)
+		if err != nil {
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", listByWorkspaceCmd),
+				zap.Int("workspace_id", workspaceID))
+			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+
+		if listID.Valid {
+			id := int(listID.Int64)
+			item.ListID = &id
+			item.ListTitle = &listTitle.String
+		}
+		if archivedBy.Valid {
+			id := int(archivedBy.Int64)
+			item.ArchivedBy = &id
+			item.ArchivedByUsername = &archivedByUsername.String
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// Attachments go first so that their keys are returned, cards before lists so
+// that the cards of purged lists are not counted, and lists before boards for
+// the same reason.
+const (
+	purgeAttachmentsCmd = `
+	DELETE FROM attachments a
+	USING cards c, lists l, boards b
+	WHERE c.id = a.card_id AND l.id = c.list_id AND b.id = l.board_id
+	  AND (c.archived_at < $1 OR l.archived_at < $1 OR b.archived_at < $1)
+	RETURNING a.object_key;`
+
+	purgeCardsCmd = `
+	DELETE FROM cards
+	WHERE archived_at < $1;`
+
+	purgeListsCmd = `
+	DELETE FROM lists
+	WHERE archived_at < $1;`
+
+	purgeBoardsCmd = `
+	DELETE FROM boards
+	WHERE archived_at < $1
+	RETURNING background;`
+)
+
+func (repo *repository) Purge(archivedBefore time.Time) (pkgTrash.Report, error) {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Time("archived_before", archivedBefore))
+		return pkgTrash.Report{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var report pkgTrash.Report
+	report.Attachments, _, err = deleteReturning(tx, purgeAttachmentsCmd, archivedBefore)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", purgeAttachmentsCmd),
+			zap.Time("archived_before", archivedBefore))
+		return pkgTrash.Report{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	for _, purge := range []struct {
+		cmd   string
+		count *int64
+	}{
+		{cmd: purgeCardsCmd, count: &report.Cards},
+		{cmd: purgeListsCmd, count: &report.Lists},
+	} {
+		result, err := tx.Exec(purge.cmd, archivedBefore)
+		if err == nil {
+			*purge.count, err = result.RowsAffected()
+		}
+		if err != nil {
+			repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", purge.cmd),
+				zap.Time("archived_before", archivedBefore))
+			return pkgTrash.Report{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+	}
+
+	report.Backgrounds, report.Boards, err = deleteReturning(tx, purgeBoardsCmd, archivedBefore)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", purgeBoardsCmd),
+			zap.Time("archived_before", archivedBefore))
+		return pkgTrash.Report{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Time("archived_before", archivedBefore))
+		return pkgTrash.Report{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	return report, nil
+}
+
+// Archived cards are never moved, so the list of a card to restore is read
+// before the list is locked. The list is locked against archiving and moves
+// until the restore is committed.
+const (
+	cardListCmd = `
+	SELECT list_id
+	FROM cards
+	WHERE id = $1;`
+
+	lockListCmd = `
+	SELECT l.board_id, l.archived_at IS NOT NULL, b.archived_at IS NOT NULL
+	FROM lists l
+	JOIN boards b on b.id = l.board_id
+	WHERE l.id = $1
+	FOR UPDATE OF l;`
+
+	restoreListCmd = `
+	UPDATE lists
+	SET archived_at = NULL,
+		archived_by = NULL
+	WHERE id = $1;`
+
+	restoreCardCmd = `
+	UPDATE cards
+	SET archived_at = NULL,
+		archived_by = NULL
+	WHERE id = $1 AND list_id = $2;`
+)
+
+func (repo *repository) RestoreList(id int) (pkgTrash.Restored, error) {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Int("list_id", id))
+		return pkgTrash.Restored{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	restored, err := repo.lockList(tx, id)
+	if err != nil {
+		return pkgTrash.Restored{}, err
+	}
+
+	if restored.ListRestored {
+		_, err = tx.Exec(restoreListCmd, id)
+		if err != nil {
+			repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", restoreListCmd),
+				zap.Int("list_id", id))
+			return pkgTrash.Restored{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Int("list_id", id))
+		return pkgTrash.Restored{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("List restored", zap.Any("restored", restored))
+	return restored, nil
+}
+
+func (repo *repository) RestoreCard(id int) (pkgTrash.Restored, error) {
+	var listID int
+	err := repo.db.QueryRow(cardListCmd, id).Scan(&listID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return pkgTrash.Restored{}, errors.Wrap(pkgErrors.ErrCardNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", cardListCmd),
+			zap.Int("card_id", id))
+		return pkgTrash.Restored{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	tx, err := repo.db.Begin()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Int("card_id", id))
+		return pkgTrash.Restored{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	restored, err := repo.lockList(tx, listID)
+	if err != nil {
+		if errors.Is(err, pkgErrors.ErrListNotFound) {
+			return pkgTrash.Restored{}, errors.Wrap(pkgErrors.ErrCardNotFound, err.Error())
+		}
+		return pkgTrash.Restored{}, err
+	}
+	restored.CardID = id
+
+	result, err := tx.Exec(restoreCardCmd, id, listID)
+	var rowsAffected int64
+	if err == nil {
+		rowsAffected, err = result.RowsAffected()
+	}
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", restoreCardCmd),
+			zap.Int("card_id", id))
+		return pkgTrash.Restored{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	if rowsAffected == 0 {
+		// Deleted, or restored and moved, since its list was read.
+		return pkgTrash.Restored{}, pkgErrors.ErrCardNotFound
+	}
+
+	if restored.ListRestored {
+		_, err = tx.Exec(restoreListCmd, listID)
+		if err != nil {
+			repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", restoreListCmd),
+				zap.Int("list_id", listID))
+			return pkgTrash.Restored{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.Int("card_id", id))
+		return pkgTrash.Restored{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("Card restored", zap.Any("restored", restored))
+	return restored, nil
+}
+
+// lockList locks the list and returns it as restored when it is archived. It
+// fails when the board of the list is archived.
+func (repo *repository) lockList(tx *sql.Tx, id int) (pkgTrash.Restored, error) {
+	restored := pkgTrash.Restored{ListID: id}
+	var boardArchived bool
+	err := tx.QueryRow(lockListCmd, id).Scan(&restored.BoardID, &restored.ListRestored, &boardArchived)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return pkgTrash.Restored{}, errors.Wrap(pkgErrors.ErrListNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", lockListCmd),
+			zap.Int("list_id", id))
+		return pkgTrash.Restored{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	if boardArchived {
+		return pkgTrash.Restored{}, pkgErrors.ErrBoardArchivedRestore
+	}
+	return restored, nil
+}
+
+// deleteReturning runs the delete and returns the keys it returns, NULL ones
+// skipped, and the number of deleted rows.
+func deleteReturning(tx *sql.Tx, cmd string, archivedBefore time.Time) ([]string, int64, error) {
+	rows, err := tx.Query(cmd, archivedBefore)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var keys []string
+	var count int64
+	for rows.Next() {
+		var key sql.NullString
+		err = rows.Scan(&key)
+		if err != nil {
+			return nil, 0, err
+		}
+		count++
+		if key.Valid {
+			keys = append(keys, key.String)
+		}
+	}
+	return keys, count, rows.Err()
+}
@@ -0,0 +1,15 @@
+package trash
+
+import (
+	"context"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+)
+
+type Usecase interface {
+	ListByWorkspace(workspaceID int) ([]models.TrashItem, error)
+	// Restore unarchives the item of itemType between the items it was archived
+	// from. An archived list of a restored card is restored as well, so that the
+	// card is back in sight. Lists and cards of an archived board are not
+	// restored: the board has to be restored first.
+	Restore(ctx context.Context, itemType string, id int) error
+}
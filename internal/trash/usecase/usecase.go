@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"github.com/SlavaShagalov/my-trello-backend/internal/boards"
+	"github.com/SlavaShagalov/my-trello-backend/internal/cards"
+	"github.com/SlavaShagalov/my-trello-backend/internal/events"
+	"github.com/SlavaShagalov/my-trello-backend/internal/lists"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/SlavaShagalov/my-trello-backend/internal/trash"
+	"github.com/spf13/viper"
+)
+
+type usecase struct {
+	repo     trash.Repository
+	boardsUC boards.Usecase
+	listsUC  lists.Usecase
+	cardsUC  cards.Usecase
+	bus      events.Bus
+}
+
+func New(repo trash.Repository, boardsUC boards.Usecase, listsUC lists.Usecase, cardsUC cards.Usecase,
+	bus events.Bus) trash.Usecase {
+	return &usecase{
+		repo:     repo,
+		boardsUC: boardsUC,
+		listsUC:  listsUC,
+		cardsUC:  cardsUC,
+		bus:      bus,
+	}
+}
+
+func (uc *usecase) ListByWorkspace(workspaceID int) ([]models.TrashItem, error) {
+	items, err := uc.repo.ListByWorkspace(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	retention := viper.GetDuration(config.TrashRetention)
+	for i := range items {
+		items[i].PurgeAt = items[i].ArchivedAt.Add(retention)
+	}
+	return items, nil
+}
+
+func (uc *usecase) Restore(ctx context.Context, itemType string, id int) error {
+	var restored trash.Restored
+	var err error
+	switch itemType {
+	case models.TrashItemBoard:
+		_, err = uc.boardsUC.Unarchive(ctx, id)
+		return err
+	case models.TrashItemList:
+		restored, err = uc.repo.RestoreList(id)
+	case models.TrashItemCard:
+		restored, err = uc.repo.RestoreCard(id)
+	default:
+		return pkgErrors.ErrBadTrashItemType
+	}
+	if err != nil {
+		return err
+	}
+
+	uc.publish(&restored)
+	return nil
+}
+
+// publish announces the restored list and card. The restore is done already,
+// so an item that can't be read back is not announced.
+func (uc *usecase) publish(restored *trash.Restored) {
+	if restored.ListRestored {
+		list, err := uc.listsUC.Get(restored.ListID)
+		if err == nil {
+			uc.bus.Publish(events.New(models.EventListUpdated, restored.BoardID, list))
+		}
+	}
+	if restored.CardID != 0 {
+		card, err := uc.cardsUC.Get(restored.CardID)
+		if err == nil {
+			uc.bus.Publish(events.New(models.EventCardUpdated, restored.BoardID, card))
+		}
+	}
+}
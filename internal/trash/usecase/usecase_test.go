@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	boardsMocks "github.com/SlavaShagalov/my-trello-backend/internal/boards/mocks"
+	cardsMocks "github.com/SlavaShagalov/my-trello-backend/internal/cards/mocks"
+	eventsMocks "github.com/SlavaShagalov/my-trello-backend/internal/events/mocks"
+	listsMocks "github.com/SlavaShagalov/my-trello-backend/internal/lists/mocks"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pkgTrash "github.com/SlavaShagalov/my-trello-backend/internal/trash"
+	"github.com/SlavaShagalov/my-trello-backend/internal/trash/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type fields struct {
+	repo     *mocks.MockRepository
+	boardsUC *boardsMocks.MockUsecase
+	listsUC  *listsMocks.MockUsecase
+	cardsUC  *cardsMocks.MockUsecase
+	bus      *eventsMocks.MockBus
+}
+
+func newFields(ctrl *gomock.Controller) *fields {
+	return &fields{
+		repo:     mocks.NewMockRepository(ctrl),
+		boardsUC: boardsMocks.NewMockUsecase(ctrl),
+		listsUC:  listsMocks.NewMockUsecase(ctrl),
+		cardsUC:  cardsMocks.NewMockUsecase(ctrl),
+		bus:      eventsMocks.NewMockBus(ctrl),
+	}
+}
+
+func TestUsecase_ListByWorkspace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	viper.Set(config.TrashRetention, 48*time.Hour)
+	archivedAt := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	f := newFields(ctrl)
+	f.repo.EXPECT().ListByWorkspace(2).Return([]models.TrashItem{
+		{Type: models.TrashItemCard, ID: 21, ArchivedAt: archivedAt},
+	}, nil)
+
+	uc := New(f.repo, f.boardsUC, f.listsUC, f.cardsUC, f.bus)
+	items, err := uc.ListByWorkspace(2)
+	if err != nil {
+		t.Fatalf("\nExpected: nil\nGot: %s", err)
+	}
+	expected := []models.TrashItem{
+		{Type: models.TrashItemCard, ID: 21, ArchivedAt: archivedAt, PurgeAt: archivedAt.Add(48 * time.Hour)},
+	}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("\nExpected: %v\nGot: %v", expected, items)
+	}
+}
+
+func TestUsecase_Restore(t *testing.T) {
+	type testCase struct {
+		prepare  func(f *fields)
+		itemType string
+		id       int
+		err      error
+	}
+
+	tests := map[string]testCase{
+		"board": {
+			itemType: models.TrashItemBoard,
+			id:       3,
+			prepare: func(f *fields) {
+				f.boardsUC.EXPECT().Unarchive(gomock.Any(), 3).Return(models.Board{ID: 3}, nil)
+			},
+		},
+		"list": {
+			itemType: models.TrashItemList,
+			id:       5,
+			prepare: func(f *fields) {
+				f.repo.EXPECT().RestoreList(5).Return(pkgTrash.Restored{BoardID: 3, ListID: 5, ListRestored: true}, nil)
+				f.listsUC.EXPECT().Get(5).Return(models.List{ID: 5, BoardID: 3}, nil)
+				f.bus.EXPECT().Publish(event{models.EventListUpdated, 3})
+			},
+		},
+		"list of archived board": {
+			itemType: models.TrashItemList,
+			id:       5,
+			prepare: func(f *fields) {
+				f.repo.EXPECT().RestoreList(5).Return(pkgTrash.Restored{}, pkgErrors.ErrBoardArchivedRestore)
+			},
+			err: pkgErrors.ErrBoardArchivedRestore,
+		},
+		"card of active list": {
+			itemType: models.TrashItemCard,
+			id:       21,
+			prepare: func(f *fields) {
+				f.repo.EXPECT().RestoreCard(21).Return(pkgTrash.Restored{BoardID: 3, ListID: 5, CardID: 21}, nil)
+				f.cardsUC.EXPECT().Get(21).Return(models.Card{ID: 21, ListID: 5}, nil)
+				f.bus.EXPECT().Publish(event{models.EventCardUpdated, 3})
+			},
+		},
+		"card of archived list": {
+			itemType: models.TrashItemCard,
+			id:       21,
+			prepare: func(f *fields) {
+				f.repo.EXPECT().RestoreCard(21).Return(pkgTrash.Restored{BoardID: 3, ListID: 5, CardID: 21,
+					ListRestored: true}, nil)
+				f.listsUC.EXPECT().Get(5).Return(models.List{ID: 5, BoardID: 3}, nil)
+				f.cardsUC.EXPECT().Get(21).Return(models.Card{ID: 21, ListID: 5}, nil)
+				gomock.InOrder(
+					f.bus.EXPECT().Publish(event{models.EventListUpdated, 3}),
+					f.bus.EXPECT().Publish(event{models.EventCardUpdated, 3}),
+				)
+			},
+		},
+		"card of archived board": {
+			itemType: models.TrashItemCard,
+			id:       21,
+			prepare: func(f *fields) {
+				f.repo.EXPECT().RestoreCard(21).Return(pkgTrash.Restored{}, pkgErrors.ErrBoardArchivedRestore)
+			},
+			err: pkgErrors.ErrBoardArchivedRestore,
+		},
+		"card not found": {
+			itemType: models.TrashItemCard,
+			id:       21,
+			prepare: func(f *fields) {
+				f.repo.EXPECT().RestoreCard(21).Return(pkgTrash.Restored{}, pkgErrors.ErrCardNotFound)
+			},
+			err: pkgErrors.ErrCardNotFound,
+		},
+		"bad type": {
+			itemType: "workspace",
+			id:       1,
+			err:      pkgErrors.ErrBadTrashItemType,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := newFields(ctrl)
+			if test.prepare != nil {
+				test.prepare(f)
+			}
+
+			uc := New(f.repo, f.boardsUC, f.listsUC, f.cardsUC, f.bus)
+			err := uc.Restore(context.Background(), test.itemType, test.id)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+		})
+	}
+}
+
+type event struct {
+	eventType string
+	boardID   int
+}
+
+func (e event) Matches(x interface{}) bool {
+	got, ok := x.(*models.Event)
+	return ok && got.Type == e.eventType && got.BoardID == e.boardID
+}
+
+func (e event) String() string {
+	return fmt.Sprintf("%s event of board %d", e.eventType, e.boardID)
+}
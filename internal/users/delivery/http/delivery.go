@@ -2,12 +2,16 @@ package http
 
 import (
 	"bytes"
+	pAccess "github.com/SlavaShagalov/my-trello-backend/internal/access"
 	mw "github.com/SlavaShagalov/my-trello-backend/internal/middleware"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
 	pErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
 	pHTTP "github.com/SlavaShagalov/my-trello-backend/internal/pkg/http"
 	pUsers "github.com/SlavaShagalov/my-trello-backend/internal/users"
 	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
 	"go.uber.org/zap"
 	"io"
 	"net/http"
@@ -15,14 +19,17 @@ import (
 )
 
 type delivery struct {
-	uc  pUsers.Usecase
-	log *zap.Logger
+	uc       pUsers.Usecase
+	accessUC pAccess.Usecase
+	log      *zap.Logger
 }
 
-func RegisterHandlers(mux *mux.Router, uc pUsers.Usecase, log *zap.Logger, checkAuth mw.Middleware, metrics mw.Middleware) {
+func RegisterHandlers(mux *mux.Router, uc pUsers.Usecase, accessUC pAccess.Usecase, log *zap.Logger,
+	checkAuth mw.Middleware, metrics mw.Middleware) {
 	del := delivery{
-		uc:  uc,
-		log: log,
+		uc:       uc,
+		accessUC: accessUC,
+		log:      log,
 	}
 
 	const (
@@ -98,6 +105,18 @@ func (del *delivery) partialUpdate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	authUserID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckUser(authUserID, userID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
 	body, err := pHTTP.ReadBody(r, del.log)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
@@ -149,6 +168,7 @@ func (del *delivery) partialUpdate(w http.ResponseWriter, r *http.Request) {
 //	@Failure		401		{object}	http.JSONError
 //	@Failure		403		{object}	http.JSONError
 //	@Failure		404		{object}	http.JSONError
+//	@Failure		413		{object}	http.JSONError
 //	@Failure		405
 //	@Failure		500
 //	@Router			/users/{id}/avatar [put]
@@ -162,11 +182,34 @@ func (del *delivery) updateAvatar(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	file, header, err := r.FormFile("avatar")
+	authUserID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckUser(authUserID, userID)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
 		return
+	}
 
+	maxBodySize := viper.GetInt64(config.MaxImageSize) + constants.MultipartOverhead
+	if r.ContentLength > maxBodySize {
+		pHTTP.HandleError(w, r, pErrors.ErrTooLargeImage)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodySize)
+
+	file, _, err := r.FormFile("avatar")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			pHTTP.HandleError(w, r, pErrors.ErrTooLargeImage)
+			return
+		}
+		pHTTP.HandleError(w, r, errors.Wrap(pErrors.ErrReadBody, err.Error()))
+		return
 	}
 
 	buf := bytes.NewBuffer(nil)
@@ -176,7 +219,7 @@ func (del *delivery) updateAvatar(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	user, err := del.uc.UpdateAvatar(userID, buf.Bytes(), header.Filename)
+	user, err := del.uc.UpdateAvatar(userID, buf.Bytes())
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
 		return
@@ -170,6 +170,22 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalUsersDel
 				}
 				*out.Avatar = string(in.String())
 			}
+		case "avatar_thumbnails":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.AvatarThumbnails = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v1 string
+					v1 = string(in.String())
+					(out.AvatarThumbnails)[key] = v1
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
@@ -221,6 +237,27 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalUsersDel
 			out.String(string(*in.Avatar))
 		}
 	}
+	{
+		const prefix string = ",\"avatar_thumbnails\":"
+		out.RawString(prefix)
+		if in.AvatarThumbnails == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v2First := true
+			for v2Name, v2Value := range in.AvatarThumbnails {
+				if v2First {
+					v2First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v2Name))
+				out.RawByte(':')
+				out.String(string(v2Value))
+			}
+			out.RawByte('}')
+		}
+	}
 	{
 		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
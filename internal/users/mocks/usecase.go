@@ -125,16 +125,16 @@ func (mr *MockUsecaseMockRecorder) PartialUpdate(params interface{}) *gomock.Cal
 }
 
 // UpdateAvatar mocks base method.
-func (m *MockUsecase) UpdateAvatar(id int, imgData []byte, filename string) (*models.User, error) {
+func (m *MockUsecase) UpdateAvatar(id int, imgData []byte) (*models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAvatar", id, imgData, filename)
+	ret := m.ctrl.Call(m, "UpdateAvatar", id, imgData)
 	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateAvatar indicates an expected call of UpdateAvatar.
-func (mr *MockUsecaseMockRecorder) UpdateAvatar(id, imgData, filename interface{}) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) UpdateAvatar(id, imgData interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAvatar", reflect.TypeOf((*MockUsecase)(nil).UpdateAvatar), id, imgData, filename)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAvatar", reflect.TypeOf((*MockUsecase)(nil).UpdateAvatar), id, imgData)
 }
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"github.com/SlavaShagalov/my-trello-backend/internal/images"
 	"github.com/SlavaShagalov/my-trello-backend/internal/models"
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
 	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
@@ -85,10 +86,13 @@ func (repo *repository) List() ([]models.User, error) {
 		}
 
 		if avatar.Valid {
-			user.Avatar = &avatar.String
+			key := avatar.String
+			user.AvatarKey = &key
 		} else {
-			user.Avatar = nil
+			user.AvatarKey = nil
 		}
+		user.Avatar = images.OptionalURL(user.AvatarKey)
+		user.AvatarThumbnails = images.ThumbnailURLs(user.AvatarKey, images.AvatarThumbnails)
 
 		users = append(users, user)
 	}
@@ -289,10 +293,12 @@ func scanUser(row *sql.Row, user *models.User) error {
 	}
 
 	if avatar.Valid {
-		user.Avatar = &avatar.String
+		user.AvatarKey = &avatar.String
 	} else {
-		user.Avatar = nil
+		user.AvatarKey = nil
 	}
+	user.Avatar = images.OptionalURL(user.AvatarKey)
+	user.AvatarThumbnails = images.ThumbnailURLs(user.AvatarKey, images.AvatarThumbnails)
 
 	return nil
 }
@@ -10,6 +10,6 @@ type Usecase interface {
 	GetByUsername(username string) (models.User, error)
 	FullUpdate(params *FullUpdateParams) (models.User, error)
 	PartialUpdate(params *PartialUpdateParams) (models.User, error)
-	UpdateAvatar(id int, imgData []byte, filename string) (*models.User, error)
+	UpdateAvatar(id int, imgData []byte) (*models.User, error)
 	Delete(id int) error
 }
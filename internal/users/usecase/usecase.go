@@ -11,7 +11,6 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
-	"path/filepath"
 )
 
 const (
@@ -82,30 +81,56 @@ func (uc *usecase) PartialUpdate(params *users.PartialUpdateParams) (models.User
 	return uc.usersRepo.PartialUpdate(params)
 }
 
-func (uc *usecase) UpdateAvatar(id int, imgData []byte, filename string) (*models.User, error) {
+func (uc *usecase) UpdateAvatar(id int, imgData []byte) (*models.User, error) {
+	img, err := images.Prepare(imgData, images.AvatarThumbnails)
+	if err != nil {
+		return nil, err
+	}
+
 	user, err := uc.usersRepo.Get(id)
 	if err != nil {
 		return nil, err
 	}
 
-	if user.Avatar == nil {
-		imgName := avatarsFolder + "/" + uuid.NewString() + filepath.Ext(filename)
-		imgPath, err := uc.imgRepo.Create(imgName, imgData)
-		if err == nil {
-			err = uc.usersRepo.UpdateAvatar(id, imgPath)
-			if err == nil {
-				user.Avatar = &imgPath
-			}
-		}
-	} else {
-		err = uc.imgRepo.Update(*user.Avatar, imgData)
+	// A new avatar always gets a new key, so caches never serve the old one.
+	key := avatarsFolder + "/" + uuid.NewString() + img.Ext
+	err = images.Store(uc.imgRepo, key, &img)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.usersRepo.UpdateAvatar(id, key)
+	if err != nil {
+		_ = images.Remove(uc.imgRepo, key, images.AvatarThumbnails)
+		return nil, err
 	}
 
-	return &user, err
+	// The user is updated already, a failure here only leaves unused files.
+	if user.AvatarKey != nil {
+		_ = images.Remove(uc.imgRepo, *user.AvatarKey, images.AvatarThumbnails)
+	}
+
+	user.AvatarKey = &key
+	user.Avatar = images.OptionalURL(user.AvatarKey)
+	user.AvatarThumbnails = images.ThumbnailURLs(user.AvatarKey, images.AvatarThumbnails)
+	return &user, nil
 }
 
 func (uc *usecase) Delete(id int) error {
-	return uc.usersRepo.Delete(id)
+	user, err := uc.usersRepo.Get(id)
+	if err != nil {
+		return err
+	}
+
+	err = uc.usersRepo.Delete(id)
+	if err != nil {
+		return err
+	}
+
+	if user.AvatarKey != nil {
+		_ = images.Remove(uc.imgRepo, *user.AvatarKey, images.AvatarThumbnails)
+	}
+	return nil
 }
 
 func validateUsername(username string) error {
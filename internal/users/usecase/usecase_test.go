@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"bytes"
 	imgMocks "github.com/SlavaShagalov/my-trello-backend/internal/images/mocks"
 	"github.com/SlavaShagalov/my-trello-backend/internal/models"
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
@@ -9,7 +10,10 @@ import (
 	"github.com/SlavaShagalov/my-trello-backend/internal/users/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
+	"image"
+	"image/png"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -159,7 +163,7 @@ func TestUsecase_Get(t *testing.T) {
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if user != test.user {
+			if !reflect.DeepEqual(user, test.user) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.user, user)
 			}
 		})
@@ -224,7 +228,7 @@ func TestUsecase_GetByUsername(t *testing.T) {
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if user != test.user {
+			if !reflect.DeepEqual(user, test.user) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.user, user)
 			}
 		})
@@ -283,7 +287,7 @@ func TestUsecase_FullUpdate(t *testing.T) {
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if user != test.user {
+			if !reflect.DeepEqual(user, test.user) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.user, user)
 			}
 		})
@@ -345,13 +349,116 @@ func TestUsecase_PartialUpdate(t *testing.T) {
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if user != test.user {
+			if !reflect.DeepEqual(user, test.user) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.user, user)
 			}
 		})
 	}
 }
 
+func TestUsecase_UpdateAvatar(t *testing.T) {
+	config.SetDefaultValidationConfig()
+
+	type fields struct {
+		repo    *mocks.MockRepository
+		imgRepo *imgMocks.MockRepository
+	}
+
+	type testCase struct {
+		prepare func(f *fields)
+		data    []byte
+		err     error
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, 8, 8))); err != nil {
+		t.Fatal(err)
+	}
+	avatar := buf.Bytes()
+
+	oldKey := "avatars/old.png"
+	newKey := func(key string) bool {
+		return strings.HasPrefix(key, "avatars/") && strings.HasSuffix(key, ".png") && key != oldKey
+	}
+
+	tests := map[string]testCase{
+		"replaces old avatar": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(21).Return(models.User{ID: 21, AvatarKey: &oldKey}, nil)
+				f.imgRepo.EXPECT().Create(gomock.Any(), gomock.Any(), "image/png").Return(nil).Times(3)
+				f.repo.EXPECT().UpdateAvatar(21, gomock.Any()).DoAndReturn(func(id int, avatar string) error {
+					if !newKey(avatar) {
+						t.Errorf("Unexpected avatar key %s", avatar)
+					}
+					return nil
+				})
+				f.imgRepo.EXPECT().Delete(oldKey).Return(nil)
+				f.imgRepo.EXPECT().Delete("avatars/old_small.png").Return(nil)
+				f.imgRepo.EXPECT().Delete("avatars/old_medium.png").Return(nil)
+			},
+			data: avatar,
+			err:  nil,
+		},
+		"first avatar": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(21).Return(models.User{ID: 21}, nil)
+				f.imgRepo.EXPECT().Create(gomock.Any(), gomock.Any(), "image/png").Return(nil).Times(3)
+				f.repo.EXPECT().UpdateAvatar(21, gomock.Any()).Return(nil)
+			},
+			data: avatar,
+			err:  nil,
+		},
+		"user not updated": {
+			prepare: func(f *fields) {
+				var keys []string
+				f.repo.EXPECT().Get(21).Return(models.User{ID: 21, AvatarKey: &oldKey}, nil)
+				f.imgRepo.EXPECT().Create(gomock.Any(), gomock.Any(), "image/png").
+					DoAndReturn(func(key string, data []byte, contentType string) error {
+						keys = append(keys, key)
+						return nil
+					}).Times(3)
+				f.repo.EXPECT().UpdateAvatar(21, gomock.Any()).Return(pkgErrors.ErrUserNotFound)
+				f.imgRepo.EXPECT().Delete(gomock.Any()).DoAndReturn(func(key string) error {
+					if !newKey(key) {
+						t.Errorf("Deleted %s instead of the new avatar", key)
+					}
+					return nil
+				}).Times(3)
+			},
+			data: avatar,
+			err:  pkgErrors.ErrUserNotFound,
+		},
+		"not an image": {
+			data: []byte("<html></html>"),
+			err:  pkgErrors.ErrBadImage,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), imgRepo: imgMocks.NewMockRepository(ctrl)}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo, f.imgRepo)
+			user, err := uc.UpdateAvatar(21, test.data)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			if err == nil && (user.AvatarKey == nil || !newKey(*user.AvatarKey) || len(user.AvatarThumbnails) != 2) {
+				t.Errorf("Unexpected avatar %v", user.Avatar)
+			}
+		})
+	}
+}
+
 func TestUsecase_Delete(t *testing.T) {
 	type fields struct {
 		repo    *mocks.MockRepository
@@ -368,6 +475,19 @@ func TestUsecase_Delete(t *testing.T) {
 	tests := map[string]testCase{
 		"normal": {
 			prepare: func(f *fields) {
+				key := "avatars/old.png"
+				f.repo.EXPECT().Get(f.userID).Return(models.User{ID: f.userID, AvatarKey: &key}, nil)
+				f.repo.EXPECT().Delete(f.userID).Return(nil)
+				f.imgRepo.EXPECT().Delete(key).Return(nil)
+				f.imgRepo.EXPECT().Delete("avatars/old_small.png").Return(nil)
+				f.imgRepo.EXPECT().Delete("avatars/old_medium.png").Return(nil)
+			},
+			userID: 21,
+			err:    nil,
+		},
+		"without avatar": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(f.userID).Return(models.User{ID: f.userID}, nil)
 				f.repo.EXPECT().Delete(f.userID).Return(nil)
 			},
 			userID: 21,
@@ -375,7 +495,7 @@ func TestUsecase_Delete(t *testing.T) {
 		},
 		"user not found": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().Delete(f.userID).Return(pkgErrors.ErrUserNotFound)
+				f.repo.EXPECT().Get(f.userID).Return(models.User{}, pkgErrors.ErrUserNotFound)
 			},
 			userID: 21,
 			err:    pkgErrors.ErrUserNotFound,
@@ -390,7 +510,8 @@ func TestUsecase_Delete(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			f := fields{repo: mocks.NewMockRepository(ctrl), userID: test.userID}
+			f := fields{repo: mocks.NewMockRepository(ctrl), imgRepo: imgMocks.NewMockRepository(ctrl),
+				userID: test.userID}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
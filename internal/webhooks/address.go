@@ -0,0 +1,66 @@
+package webhooks
+
+import (
+	"context"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/pkg/errors"
+	"net"
+	"syscall"
+)
+
+// Resolver looks up the addresses of a webhook host; *net.Resolver
+// implements it.
+type Resolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), which
+// net.IP.IsPrivate does not cover.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// IsPublicIP reports whether ip may receive webhooks: loopback, private,
+// link-local (including the cloud metadata address), unspecified and
+// multicast addresses are internal to the deployment and refused.
+func IsPublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || sharedAddressSpace.Contains(ip))
+}
+
+// CheckHost resolves host and fails with ErrWebhookURLNotAllowed when any of
+// its addresses is not public.
+func CheckHost(ctx context.Context, resolver Resolver, host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		if !IsPublicIP(ip) {
+			return errors.Wrap(pkgErrors.ErrWebhookURLNotAllowed, host)
+		}
+		return nil
+	}
+
+	addrs, err := resolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return errors.Wrap(pkgErrors.ErrInvalidWebhookURL, err.Error())
+	}
+	for _, addr := range addrs {
+		if !IsPublicIP(addr.IP) {
+			return errors.Wrap(pkgErrors.ErrWebhookURLNotAllowed, host)
+		}
+	}
+	return nil
+}
+
+// CheckDial is a net.Dialer Control function refusing connections to
+// addresses that are not public. It runs after DNS resolution, so a host
+// that resolved to a public address at creation cannot be rebound to an
+// internal one later.
+func CheckDial(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !IsPublicIP(ip) {
+		return errors.Wrap(pkgErrors.ErrWebhookURLNotAllowed, address)
+	}
+	return nil
+}
@@ -0,0 +1,399 @@
+package http
+
+import (
+	pAccess "github.com/SlavaShagalov/my-trello-backend/internal/access"
+	mw "github.com/SlavaShagalov/my-trello-backend/internal/middleware"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pHTTP "github.com/SlavaShagalov/my-trello-backend/internal/pkg/http"
+	pWebhooks "github.com/SlavaShagalov/my-trello-backend/internal/webhooks"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+)
+
+type delivery struct {
+	uc       pWebhooks.Usecase
+	accessUC pAccess.Usecase
+	log      *zap.Logger
+}
+
+func RegisterHandlers(mux *mux.Router, uc pWebhooks.Usecase, accessUC pAccess.Usecase, log *zap.Logger,
+	checkAuth mw.Middleware, metrics mw.Middleware) {
+	del := delivery{
+		uc:       uc,
+		accessUC: accessUC,
+		log:      log,
+	}
+
+	const (
+		workspaceWebhooksPath = constants.ApiPrefix + "/workspaces/{id}/webhooks"
+		boardWebhooksPath     = constants.ApiPrefix + "/boards/{id}/webhooks"
+
+		webhooksPrefix = "/webhooks"
+		webhooksPath   = constants.ApiPrefix + webhooksPrefix
+		webhookPath    = webhooksPath + "/{id}"
+		deliveriesPath = webhookPath + "/deliveries"
+	)
+
+	mux.HandleFunc(workspaceWebhooksPath, metrics(checkAuth(del.createForWorkspace))).Methods(http.MethodPost)
+	mux.HandleFunc(workspaceWebhooksPath, metrics(checkAuth(del.listByWorkspace))).Methods(http.MethodGet)
+
+	mux.HandleFunc(boardWebhooksPath, metrics(checkAuth(del.createForBoard))).Methods(http.MethodPost)
+	mux.HandleFunc(boardWebhooksPath, metrics(checkAuth(del.listByBoard))).Methods(http.MethodGet)
+
+	mux.HandleFunc(webhookPath, metrics(checkAuth(del.get))).Methods(http.MethodGet)
+	mux.HandleFunc(webhookPath, metrics(checkAuth(del.delete))).Methods(http.MethodDelete)
+	mux.HandleFunc(deliveriesPath, metrics(checkAuth(del.listDeliveries))).Methods(http.MethodGet)
+}
+
+// createForWorkspace godoc
+//
+//	@Summary		Subscribe webhook to workspace
+//	@Description	Subscribe URL to events of every board in the workspace. Payloads are signed with
+//	@Description	HMAC-SHA256 of the body in X-Webhook-Signature. The secret is returned only here.
+//	@Tags			webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		int				true	"Workspace ID"
+//	@Param			WebhookData	body		createRequest	true	"Webhook data"
+//	@Success		200			{object}	createResponse	"Created webhook data."
+//	@Failure		400			{object}	http.JSONError
+//	@Failure		401			{object}	http.JSONError
+//	@Failure		403			{object}	http.JSONError
+//	@Failure		404			{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/workspaces/{id}/webhooks [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) createForWorkspace(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	workspaceID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckWorkspace(userID, workspaceID, pAccess.Manage)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	del.create(w, r, &pWebhooks.SubscribeParams{WorkspaceID: &workspaceID})
+}
+
+// createForBoard godoc
+//
+//	@Summary		Subscribe webhook to board
+//	@Description	Subscribe URL to events of the board. Payloads are signed with HMAC-SHA256 of the body
+//	@Description	in X-Webhook-Signature. The secret is returned only here.
+//	@Tags			webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		int				true	"Board ID"
+//	@Param			WebhookData	body		createRequest	true	"Webhook data"
+//	@Success		200			{object}	createResponse	"Created webhook data."
+//	@Failure		400			{object}	http.JSONError
+//	@Failure		401			{object}	http.JSONError
+//	@Failure		403			{object}	http.JSONError
+//	@Failure		404			{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/boards/{id}/webhooks [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) createForBoard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, boardID, pAccess.Manage)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	del.create(w, r, &pWebhooks.SubscribeParams{BoardID: &boardID})
+}
+
+// create reads the webhook from the body into params, which already has its
+// scope set.
+func (del *delivery) create(w http.ResponseWriter, r *http.Request, params *pWebhooks.SubscribeParams) {
+	body, err := pHTTP.ReadBody(r, del.log)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	var request createRequest
+	err = request.UnmarshalJSON(body)
+	if err != nil {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	params.URL = request.URL
+	params.Secret = request.Secret
+	params.Events = request.Events
+
+	webhook, err := del.uc.Create(params)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newCreateResponse(&webhook)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// listByWorkspace godoc
+//
+//	@Summary		Returns webhooks of workspace
+//	@Description	Returns webhooks subscribed to the whole workspace
+//	@Tags			webhooks
+//	@Produce		json
+//	@Param			id	path		int				true	"Workspace ID"
+//	@Success		200	{object}	listResponse	"Webhooks data"
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/workspaces/{id}/webhooks [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) listByWorkspace(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	workspaceID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckWorkspace(userID, workspaceID, pAccess.Manage)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	webhooks, err := del.uc.ListByWorkspace(workspaceID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newListResponse(webhooks)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// listByBoard godoc
+//
+//	@Summary		Returns webhooks of board
+//	@Description	Returns webhooks subscribed to the board
+//	@Tags			webhooks
+//	@Produce		json
+//	@Param			id	path		int				true	"Board ID"
+//	@Success		200	{object}	listResponse	"Webhooks data"
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/boards/{id}/webhooks [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) listByBoard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckBoard(userID, boardID, pAccess.Manage)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	webhooks, err := del.uc.ListByBoard(boardID)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newListResponse(webhooks)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// get godoc
+//
+//	@Summary		Returns webhook by id
+//	@Description	Returns webhook by id
+//	@Tags			webhooks
+//	@Produce		json
+//	@Param			id	path		int			true	"Webhook ID"
+//	@Success		200	{object}	getResponse	"Webhook data"
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/webhooks/{id} [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) get(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckWebhook(userID, id, pAccess.Manage)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	webhook, err := del.uc.Get(id)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newGetResponse(&webhook)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
+
+// delete godoc
+//
+//	@Summary		Delete webhook by id
+//	@Description	Delete webhook by id together with its pending deliveries and delivery log
+//	@Tags			webhooks
+//	@Produce		json
+//	@Param			id	path	int	true	"Webhook ID"
+//	@Success		204	"Webhook deleted successfully"
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/webhooks/{id} [delete]
+//
+//	@Security		cookieAuth
+func (del *delivery) delete(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckWebhook(userID, id, pAccess.Manage)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	err = del.uc.Delete(id)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listDeliveries godoc
+//
+//	@Summary		Returns webhook delivery log
+//	@Description	Returns the latest deliveries of the webhook, newest first
+//	@Tags			webhooks
+//	@Produce		json
+//	@Param			id	path		int					true	"Webhook ID"
+//	@Success		200	{object}	deliveriesResponse	"Deliveries data"
+//	@Failure		400	{object}	http.JSONError
+//	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
+//	@Failure		404	{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/webhooks/{id}/deliveries [get]
+//
+//	@Security		cookieAuth
+func (del *delivery) listDeliveries(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckWebhook(userID, id, pAccess.Manage)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	deliveries, err := del.uc.ListDeliveries(id)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newDeliveriesResponse(deliveries)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
@@ -0,0 +1,83 @@
+package http
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"time"
+)
+
+//go:generate easyjson -all -snake_case models.go
+
+// API requests
+type createRequest struct {
+	URL    string   `json:"url"`
+	Secret string   `json:"secret"`
+	Events []string `json:"events"`
+}
+
+// API responses
+type listResponse struct {
+	Webhooks []models.Webhook `json:"webhooks"`
+}
+
+func newListResponse(webhooks []models.Webhook) *listResponse {
+	return &listResponse{
+		Webhooks: webhooks,
+	}
+}
+
+type getResponse struct {
+	ID          int       `json:"id"`
+	WorkspaceID *int      `json:"workspace_id"`
+	BoardID     *int      `json:"board_id"`
+	URL         string    `json:"url"`
+	Events      []string  `json:"events"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func newGetResponse(webhook *models.Webhook) *getResponse {
+	return &getResponse{
+		ID:          webhook.ID,
+		WorkspaceID: webhook.WorkspaceID,
+		BoardID:     webhook.BoardID,
+		URL:         webhook.URL,
+		Events:      webhook.Events,
+		CreatedAt:   webhook.CreatedAt,
+		UpdatedAt:   webhook.UpdatedAt,
+	}
+}
+
+// createResponse is the only response carrying the secret.
+type createResponse struct {
+	ID          int       `json:"id"`
+	WorkspaceID *int      `json:"workspace_id"`
+	BoardID     *int      `json:"board_id"`
+	URL         string    `json:"url"`
+	Secret      string    `json:"secret"`
+	Events      []string  `json:"events"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func newCreateResponse(webhook *models.Webhook) *createResponse {
+	return &createResponse{
+		ID:          webhook.ID,
+		WorkspaceID: webhook.WorkspaceID,
+		BoardID:     webhook.BoardID,
+		URL:         webhook.URL,
+		Secret:      webhook.Secret,
+		Events:      webhook.Events,
+		CreatedAt:   webhook.CreatedAt,
+		UpdatedAt:   webhook.UpdatedAt,
+	}
+}
+
+type deliveriesResponse struct {
+	Deliveries []models.WebhookDelivery `json:"deliveries"`
+}
+
+func newDeliveriesResponse(deliveries []models.WebhookDelivery) *deliveriesResponse {
+	return &deliveriesResponse{
+		Deliveries: deliveries,
+	}
+}
@@ -0,0 +1,10 @@
+package webhooks
+
+import "context"
+
+type Dispatcher interface {
+	// Run dispatches due deliveries periodically until ctx is done.
+	Run(ctx context.Context)
+	// Dispatch sends one batch of due deliveries and returns its size.
+	Dispatch(ctx context.Context) (int, error)
+}
@@ -0,0 +1,160 @@
+package dispatcher
+
+import (
+	"bytes"
+	"context"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	"github.com/SlavaShagalov/my-trello-backend/internal/webhooks"
+	"go.uber.org/zap"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// maxDrainedBody caps how much of a response is read to let the connection
+// be reused.
+const maxDrainedBody = 4 << 10
+
+type dispatcher struct {
+	repo   webhooks.Repository
+	client *http.Client
+	log    *zap.Logger
+}
+
+func New(repo webhooks.Repository, client *http.Client, log *zap.Logger) webhooks.Dispatcher {
+	return &dispatcher{
+		repo:   repo,
+		client: client,
+		log:    log,
+	}
+}
+
+// NewClient returns the client deliveries are sent with. It connects only
+// to public addresses, checked after DNS resolution, and never through a
+// proxy, which would hide the address actually reached.
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: webhooks.CheckDial,
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        constants.WebhookBatchSize,
+			IdleConnTimeout:     constants.WebhookLease,
+		},
+	}
+}
+
+func (d *dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(constants.WebhookPollPeriod)
+	defer ticker.Stop()
+
+	for {
+		// Keep going while batches come back full: the queue has a backlog.
+		for {
+			n, err := d.Dispatch(ctx)
+			if err != nil || n < constants.WebhookBatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *dispatcher) Dispatch(ctx context.Context) (int, error) {
+	jobs, err := d.repo.Claim(constants.WebhookBatchSize, constants.WebhookLease)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range jobs {
+		if ctx.Err() != nil {
+			// Unsent jobs are picked up again once their lease expires.
+			return i, ctx.Err()
+		}
+		d.deliver(ctx, &jobs[i])
+	}
+	return len(jobs), nil
+}
+
+func (d *dispatcher) deliver(ctx context.Context, job *webhooks.Job) {
+	delivery := &job.Delivery
+	params := webhooks.AttemptParams{DeliveryID: delivery.ID}
+
+	code, err := d.send(ctx, job)
+	if code != 0 {
+		params.ResponseCode = &code
+	}
+	if err == nil && code >= 200 && code < 300 {
+		params.Status = models.DeliveryDelivered
+	} else {
+		var reason string
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = "unexpected response status " + strconv.Itoa(code)
+		}
+		params.Error = &reason
+
+		if attempts := delivery.Attempts + 1; attempts < constants.WebhookMaxAttempts {
+			params.Status = models.DeliveryPending
+			params.RetryAfter = backoff(attempts)
+		} else {
+			params.Status = models.DeliveryFailed
+		}
+	}
+
+	d.log.Debug("Webhook delivery attempted", zap.Int("delivery_id", delivery.ID),
+		zap.Int("webhook_id", delivery.WebhookID), zap.String("status", params.Status), zap.Int("code", code))
+
+	// A lost result only means another attempt once the lease expires.
+	_ = d.repo.SaveAttempt(&params)
+}
+
+func (d *dispatcher) send(ctx context.Context, job *webhooks.Job) (int, error) {
+	payload := []byte(job.Delivery.Payload)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, job.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhooks.EventHeader, job.Delivery.EventType)
+	req.Header.Set(webhooks.DeliveryHeader, strconv.Itoa(job.Delivery.ID))
+	req.Header.Set(webhooks.SignatureHeader, webhooks.Sign(job.Secret, payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainedBody))
+		_ = resp.Body.Close()
+	}()
+
+	return resp.StatusCode, nil
+}
+
+// backoff returns the delay before the attempt following the given number
+// of failed ones: WebhookMinBackoff doubled each time, up to WebhookMaxBackoff.
+func backoff(attempts int) time.Duration {
+	delay := constants.WebhookMinBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= constants.WebhookMaxBackoff {
+			return constants.WebhookMaxBackoff
+		}
+	}
+	return delay
+}
@@ -0,0 +1,162 @@
+package dispatcher
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pkgWebhooks "github.com/SlavaShagalov/my-trello-backend/internal/webhooks"
+	"github.com/SlavaShagalov/my-trello-backend/internal/webhooks/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDispatcher_Dispatch(t *testing.T) {
+	const secret = "s3cr3t"
+	payload := json.RawMessage(`{"type":"card.created","board_id":1}`)
+
+	type testCase struct {
+		status   int
+		attempts int
+		expected pkgWebhooks.AttemptParams
+	}
+
+	tests := map[string]testCase{
+		"delivered": {
+			status:   http.StatusNoContent,
+			attempts: 0,
+			expected: pkgWebhooks.AttemptParams{DeliveryID: 7, Status: models.DeliveryDelivered},
+		},
+		"retried": {
+			status:   http.StatusInternalServerError,
+			attempts: 2,
+			expected: pkgWebhooks.AttemptParams{DeliveryID: 7, Status: models.DeliveryPending,
+				RetryAfter: 4 * constants.WebhookMinBackoff},
+		},
+		"gave up": {
+			status:   http.StatusBadGateway,
+			attempts: constants.WebhookMaxAttempts - 1,
+			expected: pkgWebhooks.AttemptParams{DeliveryID: 7, Status: models.DeliveryFailed},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, string(payload), string(body))
+				assert.True(t, pkgWebhooks.Verify(secret, body, r.Header.Get(pkgWebhooks.SignatureHeader)))
+				assert.Equal(t, models.EventCardCreated, r.Header.Get(pkgWebhooks.EventHeader))
+				assert.Equal(t, "7", r.Header.Get(pkgWebhooks.DeliveryHeader))
+				w.WriteHeader(test.status)
+			}))
+			defer receiver.Close()
+
+			repo := mocks.NewMockRepository(ctrl)
+			repo.EXPECT().Claim(constants.WebhookBatchSize, constants.WebhookLease).Return([]pkgWebhooks.Job{{
+				Delivery: models.WebhookDelivery{ID: 7, WebhookID: 3, EventType: models.EventCardCreated,
+					Payload: payload, Attempts: test.attempts},
+				URL:    receiver.URL,
+				Secret: secret,
+			}}, nil)
+			repo.EXPECT().SaveAttempt(gomock.Any()).DoAndReturn(func(params *pkgWebhooks.AttemptParams) error {
+				assert.Equal(t, test.expected.DeliveryID, params.DeliveryID)
+				assert.Equal(t, test.expected.Status, params.Status)
+				assert.Equal(t, test.expected.RetryAfter, params.RetryAfter)
+				if assert.NotNil(t, params.ResponseCode) {
+					assert.Equal(t, test.status, *params.ResponseCode)
+				}
+				assert.Equal(t, test.expected.Status == models.DeliveryDelivered, params.Error == nil)
+				return nil
+			})
+
+			d := New(repo, receiver.Client(), zap.NewNop())
+			n, err := d.Dispatch(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, 1, n)
+		})
+	}
+}
+
+func TestDispatcher_DispatchUnreachable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	receiver := httptest.NewServer(http.NotFoundHandler())
+	url := receiver.URL
+	receiver.Close()
+
+	repo := mocks.NewMockRepository(ctrl)
+	repo.EXPECT().Claim(gomock.Any(), gomock.Any()).Return([]pkgWebhooks.Job{{
+		Delivery: models.WebhookDelivery{ID: 7, Payload: json.RawMessage(`{}`)},
+		URL:      url,
+	}}, nil)
+	repo.EXPECT().SaveAttempt(gomock.Any()).DoAndReturn(func(params *pkgWebhooks.AttemptParams) error {
+		assert.Equal(t, models.DeliveryPending, params.Status)
+		assert.Nil(t, params.ResponseCode)
+		assert.NotNil(t, params.Error)
+		assert.Equal(t, constants.WebhookMinBackoff, params.RetryAfter)
+		return nil
+	})
+
+	d := New(repo, &http.Client{Timeout: time.Second}, zap.NewNop())
+	_, err := d.Dispatch(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestDispatcher_DispatchInternal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// The receiver listens on loopback, as an internal service would.
+	received := false
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = true
+	}))
+	defer receiver.Close()
+
+	repo := mocks.NewMockRepository(ctrl)
+	repo.EXPECT().Claim(gomock.Any(), gomock.Any()).Return([]pkgWebhooks.Job{{
+		Delivery: models.WebhookDelivery{ID: 7, Payload: json.RawMessage(`{}`)},
+		URL:      receiver.URL,
+	}}, nil)
+	repo.EXPECT().SaveAttempt(gomock.Any()).DoAndReturn(func(params *pkgWebhooks.AttemptParams) error {
+		assert.Equal(t, models.DeliveryPending, params.Status)
+		assert.Nil(t, params.ResponseCode)
+		if assert.NotNil(t, params.Error) {
+			assert.Contains(t, *params.Error, pkgErrors.ErrWebhookURLNotAllowed.Error())
+		}
+		return nil
+	})
+
+	d := New(repo, NewClient(time.Second), zap.NewNop())
+	_, err := d.Dispatch(context.Background())
+	assert.NoError(t, err)
+	assert.False(t, received)
+}
+
+func TestBackoff(t *testing.T) {
+	tests := map[int]time.Duration{
+		1:  constants.WebhookMinBackoff,
+		2:  2 * constants.WebhookMinBackoff,
+		5:  16 * constants.WebhookMinBackoff,
+		20: constants.WebhookMaxBackoff,
+	}
+
+	for attempts, expected := range tests {
+		assert.Equal(t, expected, backoff(attempts), "attempts: %d", attempts)
+	}
+}
@@ -0,0 +1,48 @@
+package webhooks
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"time"
+)
+
+type CreateParams struct {
+	WorkspaceID *int
+	BoardID     *int
+	URL         string
+	Secret      string
+	Events      []string
+}
+
+// Job is a claimed delivery together with its destination.
+type Job struct {
+	Delivery models.WebhookDelivery
+	URL      string
+	Secret   string
+}
+
+type AttemptParams struct {
+	DeliveryID   int
+	Status       string
+	ResponseCode *int
+	Error        *string
+	// RetryAfter delays the next attempt while Status stays pending.
+	RetryAfter time.Duration
+}
+
+type Repository interface {
+	Create(params *CreateParams) (models.Webhook, error)
+	ListByWorkspace(workspaceID int) ([]models.Webhook, error)
+	ListByBoard(boardID int) ([]models.Webhook, error)
+	Get(id int) (models.Webhook, error)
+	Delete(id int) error
+
+	// Enqueue queues a delivery of event for every webhook subscribed to it.
+	Enqueue(event *models.Event) error
+	// Claim leases up to limit due deliveries for lease, so that concurrent
+	// workers never send the same delivery twice in a row.
+	Claim(limit int, lease time.Duration) ([]Job, error)
+	// SaveAttempt records the outcome of a delivery attempt.
+	SaveAttempt(params *AttemptParams) error
+	// ListDeliveries returns the latest deliveries of the webhook, newest first.
+	ListDeliveries(webhookID int, limit int) ([]models.WebhookDelivery, error)
+}
@@ -0,0 +1,329 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pkgWebhooks "github.com/SlavaShagalov/my-trello-backend/internal/webhooks"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"time"
+)
+
+type repository struct {
+	db  *sql.DB
+	log *zap.Logger
+}
+
+func New(db *sql.DB, log *zap.Logger) pkgWebhooks.Repository {
+	return &repository{db: db, log: log}
+}
+
+const createCmd = `
+	INSERT INTO webhooks (workspace_id, board_id, url, secret, events)
+	VALUES ($1, $2, $3, $4, $5)
+	RETURNING id, workspace_id, board_id, url, secret, events, created_at, updated_at;`
+
+func (repo *repository) Create(params *pkgWebhooks.CreateParams) (models.Webhook, error) {
+	row := repo.db.QueryRow(createCmd, params.WorkspaceID, params.BoardID, params.URL, params.Secret,
+		pq.Array(params.Events))
+
+	var webhook models.Webhook
+	err := row.Scan(
+		&webhook.ID,
+		&webhook.WorkspaceID,
+		&webhook.BoardID,
+		&webhook.URL,
+		&webhook.Secret,
+		pq.Array(&webhook.Events),
+		&webhook.CreatedAt,
+		&webhook.UpdatedAt,
+	)
+	if err != nil {
+		pgErr, ok := err.(*pq.Error)
+		if !ok {
+			repo.log.Error("Cannot convert err to pq.Error", zap.Error(err))
+			return models.Webhook{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+		switch pgErr.Constraint {
+		case "webhooks_workspace_id_fkey":
+			return models.Webhook{}, errors.Wrap(pkgErrors.ErrWorkspaceNotFound, err.Error())
+		case "webhooks_board_id_fkey":
+			return models.Webhook{}, errors.Wrap(pkgErrors.ErrBoardNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", createCmd),
+			zap.String("url", params.URL))
+		return models.Webhook{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	repo.log.Debug("New webhook created", zap.Int("id", webhook.ID), zap.String("url", webhook.URL))
+	return webhook, nil
+}
+
+const listByWorkspaceCmd = `
+	SELECT id, workspace_id, board_id, url, events, created_at, updated_at
+	FROM webhooks
+	WHERE workspace_id = $1
+	ORDER BY id;`
+
+func (repo *repository) ListByWorkspace(workspaceID int) ([]models.Webhook, error) {
+	return repo.list(listByWorkspaceCmd, workspaceID)
+}
+
+const listByBoardCmd = `
+	SELECT id, workspace_id, board_id, url, events, created_at, updated_at
+	FROM webhooks
+	WHERE board_id = $1
+	ORDER BY id;`
+
+func (repo *repository) ListByBoard(boardID int) ([]models.Webhook, error) {
+	return repo.list(listByBoardCmd, boardID)
+}
+
+func (repo *repository) list(query string, id int) ([]models.Webhook, error) {
+	rows, err := repo.db.Query(query, id)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", query), zap.Int("id", id))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	webhooks := []models.Webhook{}
+	for rows.Next() {
+		var webhook models.Webhook
+		err = rows.Scan(
+			&webhook.ID,
+			&webhook.WorkspaceID,
+			&webhook.BoardID,
+			&webhook.URL,
+			pq.Array(&webhook.Events),
+			&webhook.CreatedAt,
+			&webhook.UpdatedAt,
+		)
+		if err != nil {
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", query), zap.Int("id", id))
+			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, nil
+}
+
+const getCmd = `
+	SELECT id, workspace_id, board_id, url, events, created_at, updated_at
+	FROM webhooks
+	WHERE id = $1;`
+
+func (repo *repository) Get(id int) (models.Webhook, error) {
+	var webhook models.Webhook
+	err := repo.db.QueryRow(getCmd, id).Scan(
+		&webhook.ID,
+		&webhook.WorkspaceID,
+		&webhook.BoardID,
+		&webhook.URL,
+		pq.Array(&webhook.Events),
+		&webhook.CreatedAt,
+		&webhook.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Webhook{}, errors.Wrap(pkgErrors.ErrWebhookNotFound, err.Error())
+		}
+
+		repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", getCmd), zap.Int("id", id))
+		return models.Webhook{}, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	return webhook, nil
+}
+
+const deleteCmd = `
+	DELETE FROM webhooks
+	WHERE id = $1;`
+
+func (repo *repository) Delete(id int) error {
+	result, err := repo.db.Exec(deleteCmd, id)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", deleteCmd), zap.Int("id", id))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", deleteCmd), zap.Int("id", id))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	if rowsAffected == 0 {
+		return pkgErrors.ErrWebhookNotFound
+	}
+
+	repo.log.Debug("Webhook deleted", zap.Int("id", id))
+	return nil
+}
+
+// enqueueCmd finds workspace subscribers through the board row. A deleted
+// board takes its board webhooks along and can no longer be resolved to a
+// workspace, so board.deleted is not delivered.
+const enqueueCmd = `
+	INSERT INTO webhook_deliveries (webhook_id, event_type, payload)
+	SELECT h.id, $2::varchar, $3::jsonb
+	FROM webhooks h
+	WHERE (h.board_id = $1 OR h.workspace_id = (SELECT b.workspace_id FROM boards b WHERE b.id = $1))
+	  AND (cardinality(h.events) = 0 OR $2::varchar = ANY (h.events));`
+
+func (repo *repository) Enqueue(event *models.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	result, err := repo.db.Exec(enqueueCmd, event.BoardID, event.Type, string(payload))
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", enqueueCmd),
+			zap.String("type", event.Type), zap.Int("board_id", event.BoardID))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	queued, _ := result.RowsAffected()
+	repo.log.Debug("Webhook deliveries queued", zap.String("type", event.Type), zap.Int("board_id", event.BoardID),
+		zap.Int64("count", queued))
+	return nil
+}
+
+const claimCmd = `
+	WITH due AS (
+		SELECT id
+		FROM webhook_deliveries
+		WHERE status = 'pending' AND next_attempt_at <= now()
+		ORDER BY next_attempt_at, id
+		LIMIT $1 FOR UPDATE SKIP LOCKED
+	), claimed AS (
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = now() + make_interval(secs => $2)
+		FROM due
+		WHERE d.id = due.id
+		RETURNING d.id, d.webhook_id, d.event_type, d.payload, d.status, d.attempts, d.response_code,
+			d.last_error, d.next_attempt_at, d.delivered_at, d.created_at, d.updated_at
+	)
+	SELECT c.id, c.webhook_id, c.event_type, c.payload, c.status, c.attempts, c.response_code,
+		c.last_error, c.next_attempt_at, c.delivered_at, c.created_at, c.updated_at, h.url, h.secret
+	FROM claimed c
+	JOIN webhooks h on h.id = c.webhook_id
+	ORDER BY c.id;`
+
+func (repo *repository) Claim(limit int, lease time.Duration) ([]pkgWebhooks.Job, error) {
+	rows, err := repo.db.Query(claimCmd, limit, lease.Seconds())
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", claimCmd))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	jobs := []pkgWebhooks.Job{}
+	for rows.Next() {
+		var job pkgWebhooks.Job
+		d := &job.Delivery
+		err = rows.Scan(
+			&d.ID,
+			&d.WebhookID,
+			&d.EventType,
+			&d.Payload,
+			&d.Status,
+			&d.Attempts,
+			&d.ResponseCode,
+			&d.LastError,
+			&d.NextAttemptAt,
+			&d.DeliveredAt,
+			&d.CreatedAt,
+			&d.UpdatedAt,
+			&job.URL,
+			&job.Secret,
+		)
+		if err != nil {
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", claimCmd))
+			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
+
+const saveAttemptCmd = `
+	UPDATE webhook_deliveries
+	SET status          = $2,
+		attempts        = attempts + 1,
+		response_code   = $3,
+		last_error      = $4,
+		next_attempt_at = CASE WHEN $2 = 'pending' THEN now() + make_interval(secs => $5) ELSE next_attempt_at END,
+		delivered_at    = CASE WHEN $2 = 'delivered' THEN now() END,
+		updated_at      = now()
+	WHERE id = $1;`
+
+func (repo *repository) SaveAttempt(params *pkgWebhooks.AttemptParams) error {
+	_, err := repo.db.Exec(saveAttemptCmd, params.DeliveryID, params.Status, params.ResponseCode, params.Error,
+		params.RetryAfter.Seconds())
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", saveAttemptCmd),
+			zap.Int("delivery_id", params.DeliveryID))
+		return errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+
+	return nil
+}
+
+const listDeliveriesCmd = `
+	SELECT id, webhook_id, event_type, payload, status, attempts, response_code, last_error, next_attempt_at,
+		delivered_at, created_at, updated_at
+	FROM webhook_deliveries
+	WHERE webhook_id = $1
+	ORDER BY id DESC
+	LIMIT $2;`
+
+func (repo *repository) ListDeliveries(webhookID int, limit int) ([]models.WebhookDelivery, error) {
+	rows, err := repo.db.Query(listDeliveriesCmd, webhookID, limit)
+	if err != nil {
+		repo.log.Error(constants.DBError, zap.Error(err), zap.String("sql_query", listDeliveriesCmd),
+			zap.Int("webhook_id", webhookID))
+		return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	deliveries := []models.WebhookDelivery{}
+	for rows.Next() {
+		var d models.WebhookDelivery
+		err = rows.Scan(
+			&d.ID,
+			&d.WebhookID,
+			&d.EventType,
+			&d.Payload,
+			&d.Status,
+			&d.Attempts,
+			&d.ResponseCode,
+			&d.LastError,
+			&d.NextAttemptAt,
+			&d.DeliveredAt,
+			&d.CreatedAt,
+			&d.UpdatedAt,
+		)
+		if err != nil {
+			repo.log.Error(constants.DBScanError, zap.Error(err), zap.String("sql_query", listDeliveriesCmd),
+				zap.Int("webhook_id", webhookID))
+			return nil, errors.Wrap(pkgErrors.ErrDb, err.Error())
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, nil
+}
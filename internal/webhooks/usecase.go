@@ -0,0 +1,22 @@
+package webhooks
+
+import "github.com/SlavaShagalov/my-trello-backend/internal/models"
+
+type SubscribeParams struct {
+	WorkspaceID *int
+	BoardID     *int
+	URL         string
+	// Secret signs payloads; a random one is generated when empty.
+	Secret string
+	Events []string
+}
+
+type Usecase interface {
+	// Create returns the webhook with its Secret, which is not exposed later.
+	Create(params *SubscribeParams) (models.Webhook, error)
+	ListByWorkspace(workspaceID int) ([]models.Webhook, error)
+	ListByBoard(boardID int) ([]models.Webhook, error)
+	Get(id int) (models.Webhook, error)
+	Delete(id int) error
+	ListDeliveries(webhookID int) ([]models.WebhookDelivery, error)
+}
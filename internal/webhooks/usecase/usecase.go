@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	"github.com/SlavaShagalov/my-trello-backend/internal/webhooks"
+	"github.com/pkg/errors"
+	"net/url"
+)
+
+type usecase struct {
+	repo     webhooks.Repository
+	resolver webhooks.Resolver
+}
+
+func New(repo webhooks.Repository, resolver webhooks.Resolver) webhooks.Usecase {
+	return &usecase{repo: repo, resolver: resolver}
+}
+
+func (uc *usecase) Create(params *webhooks.SubscribeParams) (models.Webhook, error) {
+	u, err := url.Parse(params.URL)
+	if err != nil || !isValidURL(u) {
+		return models.Webhook{}, pkgErrors.ErrInvalidWebhookURL
+	}
+
+	// The dispatcher checks the address again on every connection.
+	ctx, cancel := context.WithTimeout(context.Background(), constants.WebhookTimeout)
+	defer cancel()
+	if err = webhooks.CheckHost(ctx, uc.resolver, u.Hostname()); err != nil {
+		return models.Webhook{}, err
+	}
+
+	events := []string{}
+	for _, eventType := range params.Events {
+		if !models.IsEventType(eventType) {
+			return models.Webhook{}, errors.Wrap(pkgErrors.ErrInvalidWebhookEvent, eventType)
+		}
+		events = append(events, eventType)
+	}
+
+	secret := params.Secret
+	if secret == "" {
+		secret, err = newSecret()
+		if err != nil {
+			return models.Webhook{}, errors.Wrap(pkgErrors.ErrWebhookSecretFailure, err.Error())
+		}
+	}
+
+	return uc.repo.Create(&webhooks.CreateParams{
+		WorkspaceID: params.WorkspaceID,
+		BoardID:     params.BoardID,
+		URL:         params.URL,
+		Secret:      secret,
+		Events:      events,
+	})
+}
+
+func (uc *usecase) ListByWorkspace(workspaceID int) ([]models.Webhook, error) {
+	return uc.repo.ListByWorkspace(workspaceID)
+}
+
+func (uc *usecase) ListByBoard(boardID int) ([]models.Webhook, error) {
+	return uc.repo.ListByBoard(boardID)
+}
+
+func (uc *usecase) Get(id int) (models.Webhook, error) {
+	return uc.repo.Get(id)
+}
+
+func (uc *usecase) Delete(id int) error {
+	return uc.repo.Delete(id)
+}
+
+func (uc *usecase) ListDeliveries(webhookID int) ([]models.WebhookDelivery, error) {
+	return uc.repo.ListDeliveries(webhookID, constants.WebhookLogSize)
+}
+
+func isValidURL(u *url.URL) bool {
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func newSecret() (string, error) {
+	buf := make([]byte, constants.WebhookSecretLen)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
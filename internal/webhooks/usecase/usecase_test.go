@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pkgWebhooks "github.com/SlavaShagalov/my-trello-backend/internal/webhooks"
+	"github.com/SlavaShagalov/my-trello-backend/internal/webhooks/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"net"
+	"testing"
+)
+
+func TestUsecase_Create(t *testing.T) {
+	boardID := 1
+
+	type fields struct {
+		repo     *mocks.MockRepository
+		resolver *mocks.MockResolver
+		webhook  *models.Webhook
+	}
+
+	resolves := func(f *fields, host string, ip string) {
+		f.resolver.EXPECT().LookupIPAddr(gomock.Any(), host).Return([]net.IPAddr{{IP: net.ParseIP(ip)}}, nil)
+	}
+
+	type testCase struct {
+		prepare func(f *fields)
+		params  *pkgWebhooks.SubscribeParams
+		webhook models.Webhook
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"normal": {
+			prepare: func(f *fields) {
+				resolves(f, "ci.example.com", "93.184.216.34")
+				f.repo.EXPECT().Create(&pkgWebhooks.CreateParams{
+					BoardID: &boardID,
+					URL:     "https://ci.example.com/hook",
+					Secret:  "s3cr3t",
+					Events:  []string{models.EventCardCreated},
+				}).Return(*f.webhook, nil)
+			},
+			params: &pkgWebhooks.SubscribeParams{
+				BoardID: &boardID,
+				URL:     "https://ci.example.com/hook",
+				Secret:  "s3cr3t",
+				Events:  []string{models.EventCardCreated},
+			},
+			webhook: models.Webhook{ID: 1, BoardID: &boardID, URL: "https://ci.example.com/hook", Secret: "s3cr3t",
+				Events: []string{models.EventCardCreated}},
+			err: nil,
+		},
+		"generated secret": {
+			prepare: func(f *fields) {
+				f.repo.EXPECT().Create(gomock.Any()).DoAndReturn(
+					func(params *pkgWebhooks.CreateParams) (models.Webhook, error) {
+						assert.Len(t, params.Secret, 64)
+						assert.Equal(t, []string{}, params.Events)
+						return *f.webhook, nil
+					})
+			},
+			params:  &pkgWebhooks.SubscribeParams{BoardID: &boardID, URL: "http://93.184.216.34:8080"},
+			webhook: models.Webhook{ID: 1, BoardID: &boardID, URL: "http://93.184.216.34:8080"},
+			err:     nil,
+		},
+		"loopback host": {
+			prepare: func(f *fields) {
+				resolves(f, "localhost", "127.0.0.1")
+			},
+			params:  &pkgWebhooks.SubscribeParams{BoardID: &boardID, URL: "http://localhost:8080"},
+			webhook: models.Webhook{},
+			err:     pkgErrors.ErrWebhookURLNotAllowed,
+		},
+		"internal service": {
+			prepare: func(f *fields) {
+				resolves(f, "postgres", "172.18.0.3")
+			},
+			params:  &pkgWebhooks.SubscribeParams{BoardID: &boardID, URL: "http://postgres:5432"},
+			webhook: models.Webhook{},
+			err:     pkgErrors.ErrWebhookURLNotAllowed,
+		},
+		"metadata address": {
+			params:  &pkgWebhooks.SubscribeParams{BoardID: &boardID, URL: "http://169.254.169.254/latest/meta-data"},
+			webhook: models.Webhook{},
+			err:     pkgErrors.ErrWebhookURLNotAllowed,
+		},
+		"ipv6 loopback": {
+			params:  &pkgWebhooks.SubscribeParams{BoardID: &boardID, URL: "http://[::1]:8080/hook"},
+			webhook: models.Webhook{},
+			err:     pkgErrors.ErrWebhookURLNotAllowed,
+		},
+		"unresolved host": {
+			prepare: func(f *fields) {
+				f.resolver.EXPECT().LookupIPAddr(gomock.Any(), "nowhere.invalid").
+					Return(nil, &net.DNSError{Err: "no such host", Name: "nowhere.invalid", IsNotFound: true})
+			},
+			params:  &pkgWebhooks.SubscribeParams{BoardID: &boardID, URL: "https://nowhere.invalid/hook"},
+			webhook: models.Webhook{},
+			err:     pkgErrors.ErrInvalidWebhookURL,
+		},
+		"relative url": {
+			params:  &pkgWebhooks.SubscribeParams{BoardID: &boardID, URL: "/hook"},
+			webhook: models.Webhook{},
+			err:     pkgErrors.ErrInvalidWebhookURL,
+		},
+		"unsupported scheme": {
+			params:  &pkgWebhooks.SubscribeParams{BoardID: &boardID, URL: "ftp://ci.example.com/hook"},
+			webhook: models.Webhook{},
+			err:     pkgErrors.ErrInvalidWebhookURL,
+		},
+		"unknown event": {
+			prepare: func(f *fields) {
+				resolves(f, "ci.example.com", "93.184.216.34")
+			},
+			params: &pkgWebhooks.SubscribeParams{BoardID: &boardID, URL: "https://ci.example.com/hook",
+				Events: []string{models.EventCardCreated, "card.starred"}},
+			webhook: models.Webhook{},
+			err:     pkgErrors.ErrInvalidWebhookEvent,
+		},
+		"board not found": {
+			prepare: func(f *fields) {
+				resolves(f, "ci.example.com", "93.184.216.34")
+				f.repo.EXPECT().Create(gomock.Any()).Return(models.Webhook{}, pkgErrors.ErrBoardNotFound)
+			},
+			params:  &pkgWebhooks.SubscribeParams{BoardID: &boardID, URL: "https://ci.example.com/hook"},
+			webhook: models.Webhook{},
+			err:     pkgErrors.ErrBoardNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{repo: mocks.NewMockRepository(ctrl), resolver: mocks.NewMockResolver(ctrl),
+				webhook: &test.webhook}
+			if test.prepare != nil {
+				test.prepare(&f)
+			}
+
+			uc := New(f.repo, f.resolver)
+			webhook, err := uc.Create(test.params)
+			if !errors.Is(err, test.err) {
+				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
+			}
+			assert.Equal(t, test.webhook, webhook)
+		})
+	}
+}
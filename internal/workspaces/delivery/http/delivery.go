@@ -1,8 +1,10 @@
 package http
 
 import (
+	pAccess "github.com/SlavaShagalov/my-trello-backend/internal/access"
 	pBoards "github.com/SlavaShagalov/my-trello-backend/internal/boards"
 	mw "github.com/SlavaShagalov/my-trello-backend/internal/middleware"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/constants"
 	pErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
 	pHTTP "github.com/SlavaShagalov/my-trello-backend/internal/pkg/http"
@@ -16,14 +18,16 @@ import (
 type delivery struct {
 	uc       pWorkspaces.Usecase
 	boardsUC pBoards.Usecase
+	accessUC pAccess.Usecase
 	log      *zap.Logger
 }
 
-func RegisterHandlers(mux *mux.Router, uc pWorkspaces.Usecase, boardsUC pBoards.Usecase, log *zap.Logger,
-	checkAuth mw.Middleware, metrics mw.Middleware) {
+func RegisterHandlers(mux *mux.Router, uc pWorkspaces.Usecase, boardsUC pBoards.Usecase, accessUC pAccess.Usecase,
+	log *zap.Logger, checkAuth mw.Middleware, metrics mw.Middleware) {
 	del := delivery{
 		uc:       uc,
 		boardsUC: boardsUC,
+		accessUC: accessUC,
 		log:      log,
 	}
 
@@ -31,6 +35,7 @@ func RegisterHandlers(mux *mux.Router, uc pWorkspaces.Usecase, boardsUC pBoards.
 		workspacesPrefix = "/workspaces"
 		workspacesPath   = constants.ApiPrefix + workspacesPrefix
 		workspacePath    = workspacesPath + "/{id}"
+		importPath       = workspacePath + "/import"
 	)
 
 	mux.HandleFunc(workspacesPath, metrics(checkAuth(del.create))).Methods(http.MethodPost)
@@ -39,6 +44,8 @@ func RegisterHandlers(mux *mux.Router, uc pWorkspaces.Usecase, boardsUC pBoards.
 	mux.HandleFunc(workspacePath, metrics(checkAuth(del.get))).Methods(http.MethodGet)
 	mux.HandleFunc(workspacePath, metrics(checkAuth(del.partialUpdate))).Methods(http.MethodPatch)
 	mux.HandleFunc(workspacePath, metrics(checkAuth(del.delete))).Methods(http.MethodDelete)
+
+	mux.HandleFunc(importPath, metrics(checkAuth(del.importBoard))).Methods(http.MethodPost)
 }
 
 // create godoc
@@ -129,7 +136,7 @@ func (del *delivery) list(w http.ResponseWriter, r *http.Request) {
 		response.Workspaces[i].CreatedAt = workspaces[i].CreatedAt
 		response.Workspaces[i].UpdatedAt = workspaces[i].UpdatedAt
 
-		boards, err := del.boardsUC.ListByWorkspace(r.Context(), workspaces[i].ID)
+		boards, err := del.boardsUC.ListByWorkspace(r.Context(), workspaces[i].ID, false)
 		if err != nil {
 			pHTTP.HandleError(w, r, err)
 			return
@@ -151,6 +158,7 @@ func (del *delivery) list(w http.ResponseWriter, r *http.Request) {
 //	@Success		200	{object}	getResponse	"Workspace data"
 //	@Failure		400	{object}	http.JSONError
 //	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
 //	@Failure		404	{object}	http.JSONError
 //	@Failure		405
 //	@Failure		500
@@ -165,6 +173,18 @@ func (del *delivery) get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckWorkspace(userID, workspaceID, pAccess.Read)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
 	workspace, err := del.uc.Get(workspaceID)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
@@ -187,6 +207,7 @@ func (del *delivery) get(w http.ResponseWriter, r *http.Request) {
 //	@Success		200					{object}	getResponse				"Updated workspace data."
 //	@Failure		400					{object}	http.JSONError
 //	@Failure		401					{object}	http.JSONError
+//	@Failure		403					{object}	http.JSONError
 //	@Failure		405
 //	@Failure		500
 //	@Router			/workspaces/{id}  [patch]
@@ -200,6 +221,18 @@ func (del *delivery) partialUpdate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckWorkspace(userID, workspaceID, pAccess.Manage)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
 	body, err := pHTTP.ReadBody(r, del.log)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
@@ -243,6 +276,7 @@ func (del *delivery) partialUpdate(w http.ResponseWriter, r *http.Request) {
 //	@Success		204	"Workspace deleted successfully"
 //	@Failure		400	{object}	http.JSONError
 //	@Failure		401	{object}	http.JSONError
+//	@Failure		403	{object}	http.JSONError
 //	@Failure		404	{object}	http.JSONError
 //	@Failure		405
 //	@Failure		500
@@ -257,6 +291,18 @@ func (del *delivery) delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckWorkspace(userID, workspaceID, pAccess.Own)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
 	err = del.uc.Delete(workspaceID)
 	if err != nil {
 		pHTTP.HandleError(w, r, err)
@@ -265,3 +311,79 @@ func (del *delivery) delete(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// importBoard godoc
+//
+//	@Summary		Import board into workspace
+//	@Description	Creates a new board in the workspace from a board export of the current version, with new ids for
+//	@Description	the board and everything on it. Nothing is created unless all of it is.
+//	@Tags			workspaces
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		int				true	"Workspace ID"
+//	@Param			BoardExport	body		importRequest	true	"Board export"
+//	@Success		200			{object}	importResponse	"Imported board data."
+//	@Failure		400			{object}	http.JSONError
+//	@Failure		401			{object}	http.JSONError
+//	@Failure		403			{object}	http.JSONError
+//	@Failure		404			{object}	http.JSONError
+//	@Failure		405
+//	@Failure		500
+//	@Router			/workspaces/{id}/import [post]
+//
+//	@Security		cookieAuth
+func (del *delivery) importBoard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	workspaceID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	userID, ok := r.Context().Value(mw.ContextUserID).(int)
+	if !ok {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	err = del.accessUC.CheckWorkspace(userID, workspaceID, pAccess.Write)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	body, err := pHTTP.ReadBody(r, del.log)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	var request importRequest
+	err = request.UnmarshalJSON(body)
+	if err != nil {
+		pHTTP.HandleError(w, r, pErrors.ErrReadBody)
+		return
+	}
+
+	export := models.BoardExport{
+		Version:            request.Version,
+		ExportedAt:         request.ExportedAt,
+		Title:              request.Title,
+		Description:        request.Description,
+		IsTemplate:         request.IsTemplate,
+		BackgroundType:     request.BackgroundType,
+		BackgroundColor:    request.BackgroundColor,
+		BackgroundGradient: request.BackgroundGradient,
+		Labels:             request.Labels,
+		Lists:              request.Lists,
+	}
+
+	board, err := del.boardsUC.Import(r.Context(), workspaceID, &export)
+	if err != nil {
+		pHTTP.HandleError(w, r, err)
+		return
+	}
+
+	response := newImportResponse(&board)
+	pHTTP.SendJSON(w, r, http.StatusOK, response)
+}
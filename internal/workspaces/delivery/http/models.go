@@ -18,6 +18,19 @@ type partialUpdateRequest struct {
 	Description *string `json:"description"`
 }
 
+type importRequest struct {
+	Version            int                  `json:"version"`
+	ExportedAt         time.Time            `json:"exported_at"`
+	Title              string               `json:"title"`
+	Description        string               `json:"description"`
+	IsTemplate         bool                 `json:"is_template"`
+	BackgroundType     string               `json:"background_type"`
+	BackgroundColor    *string              `json:"background_color"`
+	BackgroundGradient *string              `json:"background_gradient"`
+	Labels             []models.ExportLabel `json:"labels"`
+	Lists              []models.ExportList  `json:"lists"`
+}
+
 type workspaceResponse struct {
 	ID          int            `json:"id"`
 	Title       string         `json:"title"`
@@ -73,3 +86,31 @@ func newGetResponse(workspace *models.Workspace) *getResponse {
 		UpdatedAt:   workspace.UpdatedAt,
 	}
 }
+
+type importResponse struct {
+	ID                 int       `json:"id"`
+	WorkspaceID        int       `json:"workspace_id"`
+	Title              string    `json:"title"`
+	Description        string    `json:"description"`
+	BackgroundType     string    `json:"background_type"`
+	BackgroundColor    *string   `json:"background_color"`
+	BackgroundGradient *string   `json:"background_gradient"`
+	IsTemplate         bool      `json:"is_template"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+func newImportResponse(board *models.Board) *importResponse {
+	return &importResponse{
+		ID:                 board.ID,
+		WorkspaceID:        board.WorkspaceID,
+		Title:              board.Title,
+		Description:        board.Description,
+		BackgroundType:     board.BackgroundType,
+		BackgroundColor:    board.BackgroundColor,
+		BackgroundGradient: board.BackgroundGradient,
+		IsTemplate:         board.IsTemplate,
+		CreatedAt:          board.CreatedAt,
+		UpdatedAt:          board.UpdatedAt,
+	}
+}
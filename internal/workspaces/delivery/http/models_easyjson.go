@@ -8,6 +8,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -182,6 +183,30 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels(i
 			out.Title = string(in.String())
 		case "description":
 			out.Description = string(in.String())
+		case "is_template":
+			out.IsTemplate = bool(in.Bool())
+		case "background_type":
+			out.BackgroundType = string(in.String())
+		case "background_color":
+			if in.IsNull() {
+				in.Skip()
+				out.BackgroundColor = nil
+			} else {
+				if out.BackgroundColor == nil {
+					out.BackgroundColor = new(string)
+				}
+				*out.BackgroundColor = string(in.String())
+			}
+		case "background_gradient":
+			if in.IsNull() {
+				in.Skip()
+				out.BackgroundGradient = nil
+			} else {
+				if out.BackgroundGradient == nil {
+					out.BackgroundGradient = new(string)
+				}
+				*out.BackgroundGradient = string(in.String())
+			}
 		case "background":
 			if in.IsNull() {
 				in.Skip()
@@ -192,6 +217,34 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels(i
 				}
 				*out.Background = string(in.String())
 			}
+		case "background_thumbnails":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.BackgroundThumbnails = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v4 string
+					v4 = string(in.String())
+					(out.BackgroundThumbnails)[key] = v4
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "archived_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ArchivedAt = nil
+			} else {
+				if out.ArchivedAt == nil {
+					out.ArchivedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ArchivedAt).UnmarshalJSON(data))
+				}
+			}
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
@@ -234,6 +287,34 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels(o
 		out.RawString(prefix)
 		out.String(string(in.Description))
 	}
+	{
+		const prefix string = ",\"is_template\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsTemplate))
+	}
+	{
+		const prefix string = ",\"background_type\":"
+		out.RawString(prefix)
+		out.String(string(in.BackgroundType))
+	}
+	{
+		const prefix string = ",\"background_color\":"
+		out.RawString(prefix)
+		if in.BackgroundColor == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.BackgroundColor))
+		}
+	}
+	{
+		const prefix string = ",\"background_gradient\":"
+		out.RawString(prefix)
+		if in.BackgroundGradient == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.BackgroundGradient))
+		}
+	}
 	{
 		const prefix string = ",\"background\":"
 		out.RawString(prefix)
@@ -243,6 +324,36 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels(o
 			out.String(string(*in.Background))
 		}
 	}
+	{
+		const prefix string = ",\"background_thumbnails\":"
+		out.RawString(prefix)
+		if in.BackgroundThumbnails == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v5First := true
+			for v5Name, v5Value := range in.BackgroundThumbnails {
+				if v5First {
+					v5First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v5Name))
+				out.RawByte(':')
+				out.String(string(v5Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"archived_at\":"
+		out.RawString(prefix)
+		if in.ArchivedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ArchivedAt).MarshalJSON())
+		}
+	}
 	{
 		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
@@ -387,9 +498,9 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspac
 					out.Workspaces = (out.Workspaces)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 workspaceResponse
-					(v4).UnmarshalEasyJSON(in)
-					out.Workspaces = append(out.Workspaces, v4)
+					var v6 workspaceResponse
+					(v6).UnmarshalEasyJSON(in)
+					out.Workspaces = append(out.Workspaces, v6)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -415,11 +526,11 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspac
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v5, v6 := range in.Workspaces {
-				if v5 > 0 {
+			for v7, v8 := range in.Workspaces {
+				if v7 > 0 {
 					out.RawByte(',')
 				}
-				(v6).MarshalEasyJSON(out)
+				(v8).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -450,7 +561,7 @@ func (v *listResponse) UnmarshalJSON(data []byte) error {
 func (v *listResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp2(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp3(in *jlexer.Lexer, out *getResponse) {
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp3(in *jlexer.Lexer, out *importResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -471,10 +582,36 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspac
 		switch key {
 		case "id":
 			out.ID = int(in.Int())
+		case "workspace_id":
+			out.WorkspaceID = int(in.Int())
 		case "title":
 			out.Title = string(in.String())
 		case "description":
 			out.Description = string(in.String())
+		case "background_type":
+			out.BackgroundType = string(in.String())
+		case "background_color":
+			if in.IsNull() {
+				in.Skip()
+				out.BackgroundColor = nil
+			} else {
+				if out.BackgroundColor == nil {
+					out.BackgroundColor = new(string)
+				}
+				*out.BackgroundColor = string(in.String())
+			}
+		case "background_gradient":
+			if in.IsNull() {
+				in.Skip()
+				out.BackgroundGradient = nil
+			} else {
+				if out.BackgroundGradient == nil {
+					out.BackgroundGradient = new(string)
+				}
+				*out.BackgroundGradient = string(in.String())
+			}
+		case "is_template":
+			out.IsTemplate = bool(in.Bool())
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
@@ -493,7 +630,7 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspac
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp3(out *jwriter.Writer, in getResponse) {
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp3(out *jwriter.Writer, in importResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -502,6 +639,11 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspac
 		out.RawString(prefix[1:])
 		out.Int(int(in.ID))
 	}
+	{
+		const prefix string = ",\"workspace_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.WorkspaceID))
+	}
 	{
 		const prefix string = ",\"title\":"
 		out.RawString(prefix)
@@ -512,6 +654,34 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspac
 		out.RawString(prefix)
 		out.String(string(in.Description))
 	}
+	{
+		const prefix string = ",\"background_type\":"
+		out.RawString(prefix)
+		out.String(string(in.BackgroundType))
+	}
+	{
+		const prefix string = ",\"background_color\":"
+		out.RawString(prefix)
+		if in.BackgroundColor == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.BackgroundColor))
+		}
+	}
+	{
+		const prefix string = ",\"background_gradient\":"
+		out.RawString(prefix)
+		if in.BackgroundGradient == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.BackgroundGradient))
+		}
+	}
+	{
+		const prefix string = ",\"is_template\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsTemplate))
+	}
 	{
 		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
@@ -526,29 +696,29 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspac
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v getResponse) MarshalJSON() ([]byte, error) {
+func (v importResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v getResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v importResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *getResponse) UnmarshalJSON(data []byte) error {
+func (v *importResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *getResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *importResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp3(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp4(in *jlexer.Lexer, out *createResponse) {
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp4(in *jlexer.Lexer, out *importRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -567,19 +737,85 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspac
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = int(in.Int())
+		case "version":
+			out.Version = int(in.Int())
+		case "exported_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExportedAt).UnmarshalJSON(data))
+			}
 		case "title":
 			out.Title = string(in.String())
 		case "description":
 			out.Description = string(in.String())
-		case "created_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+		case "is_template":
+			out.IsTemplate = bool(in.Bool())
+		case "background_type":
+			out.BackgroundType = string(in.String())
+		case "background_color":
+			if in.IsNull() {
+				in.Skip()
+				out.BackgroundColor = nil
+			} else {
+				if out.BackgroundColor == nil {
+					out.BackgroundColor = new(string)
+				}
+				*out.BackgroundColor = string(in.String())
 			}
-		case "updated_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+		case "background_gradient":
+			if in.IsNull() {
+				in.Skip()
+				out.BackgroundGradient = nil
+			} else {
+				if out.BackgroundGradient == nil {
+					out.BackgroundGradient = new(string)
+				}
+				*out.BackgroundGradient = string(in.String())
+			}
+		case "labels":
+			if in.IsNull() {
+				in.Skip()
+				out.Labels = nil
+			} else {
+				in.Delim('[')
+				if out.Labels == nil {
+					if !in.IsDelim(']') {
+						out.Labels = make([]models.ExportLabel, 0, 2)
+					} else {
+						out.Labels = []models.ExportLabel{}
+					}
+				} else {
+					out.Labels = (out.Labels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v9 models.ExportLabel
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels1(in, &v9)
+					out.Labels = append(out.Labels, v9)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "lists":
+			if in.IsNull() {
+				in.Skip()
+				out.Lists = nil
+			} else {
+				in.Delim('[')
+				if out.Lists == nil {
+					if !in.IsDelim(']') {
+						out.Lists = make([]models.ExportList, 0, 1)
+					} else {
+						out.Lists = []models.ExportList{}
+					}
+				} else {
+					out.Lists = (out.Lists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 models.ExportList
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels2(in, &v10)
+					out.Lists = append(out.Lists, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -591,14 +827,19 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspac
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp4(out *jwriter.Writer, in createResponse) {
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp4(out *jwriter.Writer, in importRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"version\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ID))
+		out.Int(int(in.Version))
+	}
+	{
+		const prefix string = ",\"exported_at\":"
+		out.RawString(prefix)
+		out.Raw((in.ExportedAt).MarshalJSON())
 	}
 	{
 		const prefix string = ",\"title\":"
@@ -611,42 +852,92 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspac
 		out.String(string(in.Description))
 	}
 	{
-		const prefix string = ",\"created_at\":"
+		const prefix string = ",\"is_template\":"
 		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+		out.Bool(bool(in.IsTemplate))
 	}
 	{
-		const prefix string = ",\"updated_at\":"
+		const prefix string = ",\"background_type\":"
 		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+		out.String(string(in.BackgroundType))
+	}
+	{
+		const prefix string = ",\"background_color\":"
+		out.RawString(prefix)
+		if in.BackgroundColor == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.BackgroundColor))
+		}
+	}
+	{
+		const prefix string = ",\"background_gradient\":"
+		out.RawString(prefix)
+		if in.BackgroundGradient == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.BackgroundGradient))
+		}
+	}
+	{
+		const prefix string = ",\"labels\":"
+		out.RawString(prefix)
+		if in.Labels == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Labels {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels1(out, v12)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"lists\":"
+		out.RawString(prefix)
+		if in.Lists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v13, v14 := range in.Lists {
+				if v13 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels2(out, v14)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v createResponse) MarshalJSON() ([]byte, error) {
+func (v importRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v createResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v importRequest) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *createResponse) UnmarshalJSON(data []byte) error {
+func (v *importRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *createResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *importRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp4(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp5(in *jlexer.Lexer, out *createRequest) {
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels2(in *jlexer.Lexer, out *models.ExportList) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -667,8 +958,29 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspac
 		switch key {
 		case "title":
 			out.Title = string(in.String())
-		case "description":
-			out.Description = string(in.String())
+		case "cards":
+			if in.IsNull() {
+				in.Skip()
+				out.Cards = nil
+			} else {
+				in.Delim('[')
+				if out.Cards == nil {
+					if !in.IsDelim(']') {
+						out.Cards = make([]models.ExportCard, 0, 0)
+					} else {
+						out.Cards = []models.ExportCard{}
+					}
+				} else {
+					out.Cards = (out.Cards)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v15 models.ExportCard
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels3(in, &v15)
+					out.Cards = append(out.Cards, v15)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -679,7 +991,7 @@ func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspac
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp5(out *jwriter.Writer, in createRequest) {
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels2(out *jwriter.Writer, in models.ExportList) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -689,33 +1001,658 @@ func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspac
 		out.String(string(in.Title))
 	}
 	{
-		const prefix string = ",\"description\":"
+		const prefix string = ",\"cards\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		if in.Cards == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v16, v17 := range in.Cards {
+				if v16 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels3(out, v17)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v createRequest) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp5(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v createRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp5(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *createRequest) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp5(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *createRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp5(l, v)
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels3(in *jlexer.Lexer, out *models.ExportCard) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "content":
+			out.Content = string(in.String())
+		case "start_at":
+			if in.IsNull() {
+				in.Skip()
+				out.StartAt = nil
+			} else {
+				if out.StartAt == nil {
+					out.StartAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.StartAt).UnmarshalJSON(data))
+				}
+			}
+		case "due_at":
+			if in.IsNull() {
+				in.Skip()
+				out.DueAt = nil
+			} else {
+				if out.DueAt == nil {
+					out.DueAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DueAt).UnmarshalJSON(data))
+				}
+			}
+		case "completed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.CompletedAt = nil
+			} else {
+				if out.CompletedAt == nil {
+					out.CompletedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CompletedAt).UnmarshalJSON(data))
+				}
+			}
+		case "labels":
+			if in.IsNull() {
+				in.Skip()
+				out.Labels = nil
+			} else {
+				in.Delim('[')
+				if out.Labels == nil {
+					if !in.IsDelim(']') {
+						out.Labels = make([]int, 0, 8)
+					} else {
+						out.Labels = []int{}
+					}
+				} else {
+					out.Labels = (out.Labels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v18 int
+					v18 = int(in.Int())
+					out.Labels = append(out.Labels, v18)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "checklists":
+			if in.IsNull() {
+				in.Skip()
+				out.Checklists = nil
+			} else {
+				in.Delim('[')
+				if out.Checklists == nil {
+					if !in.IsDelim(']') {
+						out.Checklists = make([]models.ExportChecklist, 0, 1)
+					} else {
+						out.Checklists = []models.ExportChecklist{}
+					}
+				} else {
+					out.Checklists = (out.Checklists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 models.ExportChecklist
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels4(in, &v19)
+					out.Checklists = append(out.Checklists, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels3(out *jwriter.Writer, in models.ExportCard) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix)
+		out.String(string(in.Content))
+	}
+	{
+		const prefix string = ",\"start_at\":"
+		out.RawString(prefix)
+		if in.StartAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.StartAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"due_at\":"
+		out.RawString(prefix)
+		if in.DueAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.DueAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"completed_at\":"
+		out.RawString(prefix)
+		if in.CompletedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.CompletedAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"labels\":"
+		out.RawString(prefix)
+		if in.Labels == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.Labels {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v21))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"checklists\":"
+		out.RawString(prefix)
+		if in.Checklists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v22, v23 := range in.Checklists {
+				if v22 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels4(out, v23)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels4(in *jlexer.Lexer, out *models.ExportChecklist) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]models.ExportChecklistItem, 0, 2)
+					} else {
+						out.Items = []models.ExportChecklistItem{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v24 models.ExportChecklistItem
+					easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels5(in, &v24)
+					out.Items = append(out.Items, v24)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels4(out *jwriter.Writer, in models.ExportChecklist) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix)
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v25, v26 := range in.Items {
+				if v25 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels5(out, v26)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels5(in *jlexer.Lexer, out *models.ExportChecklistItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "done":
+			out.Done = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels5(out *jwriter.Writer, in models.ExportChecklistItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"done\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Done))
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalModels1(in *jlexer.Lexer, out *models.ExportLabel) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "color":
+			out.Color = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalModels1(out *jwriter.Writer, in models.ExportLabel) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"color\":"
+		out.RawString(prefix)
+		out.String(string(in.Color))
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp5(in *jlexer.Lexer, out *getResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp5(out *jwriter.Writer, in getResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v getResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v getResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *getResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *getResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp5(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp6(in *jlexer.Lexer, out *createResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp6(out *jwriter.Writer, in createResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v createResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v createResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *createResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *createResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp6(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp7(in *jlexer.Lexer, out *createRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp7(out *jwriter.Writer, in createRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v createRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v createRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *createRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *createRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComSlavaShagalovMyTrelloBackendInternalWorkspacesDeliveryHttp7(l, v)
 }
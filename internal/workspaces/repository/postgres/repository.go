@@ -50,9 +50,11 @@ func (repo *repository) Create(params *pkgWorkspaces.CreateParams) (models.Works
 }
 
 const listCmd = `
-	SELECT id, user_id, title, description, created_at, updated_at
-	FROM workspaces
-	WHERE user_id = $1;`
+	SELECT w.id, w.user_id, w.title, w.description, w.created_at, w.updated_at
+	FROM workspaces w
+	JOIN workspace_members m on m.workspace_id = w.id
+	WHERE m.user_id = $1
+	ORDER BY w.id;`
 
 func (repo *repository) List(userID int) ([]models.Workspace, error) {
 	rows, err := repo.db.Query(listCmd, userID)
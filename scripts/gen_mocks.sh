@@ -13,6 +13,13 @@ interface_files=(
   internal/workspaces/usecase.go
   internal/workspaces/repository.go
 
+  internal/members/usecase.go
+  internal/members/repository.go
+
+  internal/invitations/usecase.go
+  internal/invitations/repository.go
+  internal/invitations/sender.go
+
   internal/boards/usecase.go
   internal/boards/repository.go
 
@@ -22,7 +29,39 @@ interface_files=(
   internal/cards/usecase.go
   internal/cards/repository.go
 
+  internal/labels/usecase.go
+  internal/labels/repository.go
+
+  internal/assignees/usecase.go
+  internal/assignees/repository.go
+
+  internal/checklists/usecase.go
+  internal/checklists/repository.go
+
+  internal/comments/usecase.go
+  internal/comments/repository.go
+
+  internal/attachments/usecase.go
+  internal/attachments/repository.go
+
   internal/images/repository.go
+  internal/images/collector.go
+
+  internal/events/bus.go
+
+  internal/webhooks/usecase.go
+  internal/webhooks/repository.go
+  internal/webhooks/dispatcher.go
+
+  internal/access/usecase.go
+  internal/access/repository.go
+
+  internal/trash/usecase.go
+  internal/trash/repository.go
+  internal/trash/purger.go
+
+  internal/ranks/repository.go
+  internal/ranks/rebalancer.go
 )
 
 echo "Generating mocks..."
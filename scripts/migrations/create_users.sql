@@ -5,6 +5,18 @@ ALTER ROLE reader LOGIN;
 
 GRANT SELECT ON users TO reader;
 GRANT SELECT ON workspaces TO reader;
+GRANT SELECT ON workspace_members TO reader;
+GRANT SELECT ON invitations TO reader;
 GRANT SELECT ON boards TO reader;
 GRANT SELECT ON lists TO reader;
 GRANT SELECT ON cards TO reader;
+GRANT SELECT ON labels TO reader;
+GRANT SELECT ON card_labels TO reader;
+GRANT SELECT ON card_assignees TO reader;
+GRANT SELECT ON checklists TO reader;
+GRANT SELECT ON checklist_items TO reader;
+GRANT SELECT ON comments TO reader;
+GRANT SELECT ON comment_versions TO reader;
+GRANT SELECT ON attachments TO reader;
+GRANT SELECT ON webhooks TO reader;
+GRANT SELECT ON webhook_deliveries TO reader;
@@ -20,129 +20,259 @@ CREATE TABLE IF NOT EXISTS workspaces
     updated_at  timestamp NOT NULL DEFAULT now()
 );
 
-CREATE TABLE IF NOT EXISTS boards
+CREATE TABLE IF NOT EXISTS workspace_members
+(
+    workspace_id int       NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    user_id      int       NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    role         varchar   NOT NULL CHECK (role IN ('owner', 'admin', 'member', 'observer')),
+    created_at   timestamp NOT NULL DEFAULT now(),
+    updated_at   timestamp NOT NULL DEFAULT now(),
+    PRIMARY KEY (workspace_id, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS invitations
 (
     id           serial    NOT NULL PRIMARY KEY,
     workspace_id int       NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
-    title        varchar   NOT NULL DEFAULT '',
-    description  varchar   NULL,
-    background   varchar   NULL,
+    inviter_id   int       NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    invitee      varchar   NOT NULL,
+    role         varchar   NOT NULL CHECK (role IN ('admin', 'member', 'observer')),
+    token        varchar   NOT NULL UNIQUE,
+    status       varchar   NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'accepted', 'declined', 'revoked')),
+    expires_at   timestamp NOT NULL,
     created_at   timestamp NOT NULL DEFAULT now(),
     updated_at   timestamp NOT NULL DEFAULT now()
 );
 
+CREATE TABLE IF NOT EXISTS boards
+(
+    id                  serial    NOT NULL PRIMARY KEY,
+    workspace_id        int       NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    title               varchar   NOT NULL DEFAULT '',
+    description         varchar   NULL,
+    is_template         boolean   NOT NULL DEFAULT false,
+    background_type     varchar   NOT NULL DEFAULT 'color' CHECK (background_type IN ('color', 'gradient', 'image')),
+    background_color    varchar   NULL DEFAULT '#0079bf',
+    background_gradient varchar   NULL,
+    background          varchar   NULL,
+    archived_at         timestamp NULL,
+    archived_by         int       NULL REFERENCES users (id) ON DELETE SET NULL,
+    created_at          timestamp NOT NULL DEFAULT now(),
+    updated_at          timestamp NOT NULL DEFAULT now(),
+    -- Only the value of the background type is set
+    CONSTRAINT boards_background_check CHECK (
+        (background_type = 'color') = (background_color IS NOT NULL) AND
+        (background_type = 'gradient') = (background_gradient IS NOT NULL) AND
+        (background_type = 'image') = (background IS NOT NULL)),
+    -- Only built-in templates belong to no workspace
+    CONSTRAINT boards_workspace_check CHECK (workspace_id IS NOT NULL OR is_template)
+);
+
 CREATE TABLE IF NOT EXISTS lists
+(
+    id          serial    NOT NULL PRIMARY KEY,
+    board_id    int       NOT NULL REFERENCES boards (id) ON DELETE CASCADE,
+    title       varchar   NOT NULL DEFAULT '',
+    rank        varchar   COLLATE "C" NOT NULL,
+    archived_at timestamp NULL,
+    archived_by int       NULL REFERENCES users (id) ON DELETE SET NULL,
+    created_at  timestamp NOT NULL DEFAULT now(),
+    updated_at  timestamp NOT NULL DEFAULT now(),
+    -- Checked at the end of statements, so that rebalancing can rewrite ranks
+    CONSTRAINT lists_rank_key UNIQUE (board_id, rank) DEFERRABLE INITIALLY IMMEDIATE
+);
+
+CREATE TABLE IF NOT EXISTS cards
+(
+    id           serial    NOT NULL PRIMARY KEY,
+    list_id      int       NOT NULL REFERENCES lists (id) ON DELETE CASCADE,
+    title        varchar   NOT NULL DEFAULT '',
+    content      varchar   NULL,
+    rank         varchar   COLLATE "C" NOT NULL,
+    start_at     timestamp NULL,
+    due_at       timestamp NULL,
+    completed_at timestamp NULL,
+    archived_at  timestamp NULL,
+    archived_by  int       NULL REFERENCES users (id) ON DELETE SET NULL,
+    created_at   timestamp NOT NULL DEFAULT now(),
+    updated_at   timestamp NOT NULL DEFAULT now(),
+    CONSTRAINT cards_dates_check CHECK (start_at <= due_at),
+    CONSTRAINT cards_rank_key UNIQUE (list_id, rank) DEFERRABLE INITIALLY IMMEDIATE
+);
+
+CREATE INDEX IF NOT EXISTS cards_due_at_idx ON cards (due_at) WHERE due_at IS NOT NULL;
+
+-- Archived items are in the trash of their workspace until they are purged
+CREATE INDEX IF NOT EXISTS boards_archived_at_idx ON boards (archived_at) WHERE archived_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS lists_archived_at_idx ON lists (archived_at) WHERE archived_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS cards_archived_at_idx ON cards (archived_at) WHERE archived_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS labels
 (
     id         serial    NOT NULL PRIMARY KEY,
     board_id   int       NOT NULL REFERENCES boards (id) ON DELETE CASCADE,
-    title      varchar   NOT NULL DEFAULT '',
-    position   int       NOT NULL,
+    name       varchar   NOT NULL DEFAULT '',
+    color      varchar   NOT NULL,
     created_at timestamp NOT NULL DEFAULT now(),
     updated_at timestamp NOT NULL DEFAULT now()
 );
 
-CREATE TABLE IF NOT EXISTS cards
+CREATE INDEX IF NOT EXISTS labels_board_id_idx ON labels (board_id);
+
+CREATE TABLE IF NOT EXISTS card_labels
+(
+    card_id  int NOT NULL REFERENCES cards (id) ON DELETE CASCADE,
+    label_id int NOT NULL REFERENCES labels (id) ON DELETE CASCADE,
+    PRIMARY KEY (card_id, label_id)
+);
+
+CREATE INDEX IF NOT EXISTS card_labels_label_id_idx ON card_labels (label_id);
+
+CREATE TABLE IF NOT EXISTS card_assignees
+(
+    card_id    int       NOT NULL REFERENCES cards (id) ON DELETE CASCADE,
+    user_id    int       NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at timestamp NOT NULL DEFAULT now(),
+    PRIMARY KEY (card_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS card_assignees_user_id_idx ON card_assignees (user_id);
+
+CREATE TABLE IF NOT EXISTS checklists
 (
     id         serial    NOT NULL PRIMARY KEY,
-    list_id    int       NOT NULL REFERENCES lists (id) ON DELETE CASCADE,
-    title      varchar   NOT NULL DEFAULT '',
-    content    varchar   NULL,
+    card_id    int       NOT NULL REFERENCES cards (id) ON DELETE CASCADE,
+    title      varchar   NOT NULL,
     position   int       NOT NULL,
     created_at timestamp NOT NULL DEFAULT now(),
     updated_at timestamp NOT NULL DEFAULT now()
 );
 
--- Update positions after list was deleted
-CREATE OR REPLACE FUNCTION on_list_delete() RETURNS TRIGGER AS
+CREATE INDEX IF NOT EXISTS checklists_card_id_idx ON checklists (card_id);
+
+CREATE TABLE IF NOT EXISTS checklist_items
+(
+    id           serial    NOT NULL PRIMARY KEY,
+    checklist_id int       NOT NULL REFERENCES checklists (id) ON DELETE CASCADE,
+    title        varchar   NOT NULL,
+    done         boolean   NOT NULL DEFAULT false,
+    position     int       NOT NULL,
+    created_at   timestamp NOT NULL DEFAULT now(),
+    updated_at   timestamp NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS checklist_items_checklist_id_idx ON checklist_items (checklist_id);
+
+CREATE TABLE IF NOT EXISTS comments
+(
+    id         serial    NOT NULL PRIMARY KEY,
+    card_id    int       NOT NULL REFERENCES cards (id) ON DELETE CASCADE,
+    author_id  int       NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    content    varchar   NOT NULL,
+    created_at timestamp NOT NULL DEFAULT now(),
+    updated_at timestamp NOT NULL DEFAULT now(),
+    edited_at  timestamp NULL
+);
+
+CREATE INDEX IF NOT EXISTS comments_card_id_idx ON comments (card_id, id DESC);
+
+CREATE TABLE IF NOT EXISTS attachments
+(
+    id           serial    NOT NULL PRIMARY KEY,
+    card_id      int       NOT NULL REFERENCES cards (id) ON DELETE CASCADE,
+    uploader_id  int       NULL REFERENCES users (id) ON DELETE SET NULL,
+    filename     varchar   NOT NULL,
+    size         bigint    NOT NULL,
+    content_type varchar   NOT NULL,
+    object_key   varchar   NOT NULL,
+    created_at   timestamp NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS attachments_card_id_idx ON attachments (card_id);
+
+-- Previous contents of edited comments
+CREATE TABLE IF NOT EXISTS comment_versions
+(
+    id         serial    NOT NULL PRIMARY KEY,
+    comment_id int       NOT NULL REFERENCES comments (id) ON DELETE CASCADE,
+    content    varchar   NOT NULL,
+    created_at timestamp NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS comment_versions_comment_id_idx ON comment_versions (comment_id);
+
+CREATE TABLE IF NOT EXISTS webhooks
+(
+    id           serial    NOT NULL PRIMARY KEY,
+    workspace_id int       NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    board_id     int       NULL REFERENCES boards (id) ON DELETE CASCADE,
+    url          varchar   NOT NULL,
+    secret       varchar   NOT NULL,
+    events       varchar[] NOT NULL DEFAULT '{}',
+    created_at   timestamp NOT NULL DEFAULT now(),
+    updated_at   timestamp NOT NULL DEFAULT now(),
+    CONSTRAINT webhooks_scope_check CHECK ((workspace_id IS NULL) <> (board_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS webhooks_workspace_id_idx ON webhooks (workspace_id);
+CREATE INDEX IF NOT EXISTS webhooks_board_id_idx ON webhooks (board_id);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    id              serial    NOT NULL PRIMARY KEY,
+    webhook_id      int       NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    event_type      varchar   NOT NULL,
+    payload         jsonb     NOT NULL,
+    status          varchar   NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'delivered', 'failed')),
+    attempts        int       NOT NULL DEFAULT 0,
+    response_code   int       NULL,
+    last_error      varchar   NULL,
+    next_attempt_at timestamp NOT NULL DEFAULT now(),
+    delivered_at    timestamp NULL,
+    created_at      timestamp NOT NULL DEFAULT now(),
+    updated_at      timestamp NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_due_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_id_idx ON webhook_deliveries (webhook_id, id);
+
+-- Make workspace creator its owner
+CREATE OR REPLACE FUNCTION on_workspace_create() RETURNS TRIGGER AS
 $$
 BEGIN
-    UPDATE lists
-    SET position = position - 1
-    WHERE board_id = old.board_id
-      AND position > old.position;
+    INSERT INTO workspace_members (workspace_id, user_id, role)
+    VALUES (new.id, new.user_id, 'owner');
 
     RETURN NULL;
 END
 $$ LANGUAGE plpgsql;
 
-CREATE OR REPLACE TRIGGER list_delete
-    AFTER DELETE
-    ON lists
+CREATE OR REPLACE TRIGGER workspace_create
+    AFTER INSERT
+    ON workspaces
     FOR EACH ROW
-EXECUTE PROCEDURE on_list_delete();
+EXECUTE PROCEDURE on_workspace_create();
 
-CREATE OR REPLACE PROCEDURE update_list_positions(new_position int, list_id int) AS
+-- Unassign removed workspace member from cards of the workspace
+CREATE OR REPLACE FUNCTION on_member_delete() RETURNS TRIGGER AS
 $$
-DECLARE
-    old_position int;
-    boardID      int;
 BEGIN
-    SELECT l.position, l.board_id
-    INTO old_position, boardID
-    FROM lists l
-    WHERE id = list_id;
-
-    IF new_position > old_position THEN
-        UPDATE lists l
-        SET position = position - 1
-        WHERE l.board_id = boardID
-          AND position > old_position
-          AND position <= new_position;
-        --         RAISE NOTICE 'old_position: %', old_position;
---         RAISE NOTICE 'new_position: %', new_position;
-    ELSIF new_position < old_position THEN
-        UPDATE lists l
-        SET position = position + 1
-        WHERE l.board_id = boardID
-          AND position >= new_position
-          AND position < old_position;
-    END IF;
-END
-$$ LANGUAGE plpgsql;
-
--- Update positions after card was deleted
-CREATE OR REPLACE FUNCTION on_card_delete() RETURNS TRIGGER AS
-$$
-BEGIN
-    UPDATE cards
-    SET position = position - 1
-    WHERE list_id = old.list_id
-      AND position > old.position;
+    DELETE
+    FROM card_assignees a
+        USING cards c, lists l, boards b
+    WHERE a.card_id = c.id
+      AND c.list_id = l.id
+      AND l.board_id = b.id
+      AND b.workspace_id = old.workspace_id
+      AND a.user_id = old.user_id;
 
     RETURN NULL;
 END
 $$ LANGUAGE plpgsql;
 
-CREATE OR REPLACE TRIGGER card_delete
+CREATE OR REPLACE TRIGGER member_delete
     AFTER DELETE
-    ON cards
+    ON workspace_members
     FOR EACH ROW
-EXECUTE PROCEDURE on_card_delete();
-
--- Update positions after card position was updated
-CREATE OR REPLACE PROCEDURE update_cards_positions(new_position int, card_id int) AS
-$$
-DECLARE
-    old_position int;
-    listID       int;
-BEGIN
-    SELECT c.position, c.list_id
-    INTO old_position, listID
-    FROM cards c
-    WHERE id = card_id;
-
-    IF new_position > old_position THEN
-        UPDATE cards c
-        SET position = position - 1
-        WHERE c.list_id = listID
-          AND position > old_position
-          AND position <= new_position;
-    ELSIF new_position < old_position THEN
-        UPDATE cards c
-        SET position = position + 1
-        WHERE c.list_id = listID
-          AND position >= new_position
-          AND position < old_position;
-    END IF;
-END
-$$ LANGUAGE plpgsql;
+EXECUTE PROCEDURE on_member_delete();
@@ -0,0 +1,73 @@
+-- Built-in board templates. They belong to no workspace, everyone can instantiate them.
+-- Applied after the data, so that the templates don't take the ids the data expects.
+
+WITH board AS (
+    INSERT INTO boards (title, description, is_template, background_type, background_color)
+        VALUES ('Kanban', 'Work moves from the backlog to done, one column at a time', true, 'color', '#0079bf')
+        RETURNING id),
+     list AS (
+         INSERT INTO lists (board_id, title, rank)
+             SELECT board.id, l.title, l.rank
+             FROM board,
+                  (VALUES ('Backlog', 'i'),
+                          ('In progress', 'i01'),
+                          ('Review', 'i02'),
+                          ('Done', 'i03')) l(title, rank)
+             RETURNING id, rank)
+INSERT
+INTO cards (list_id, title, content, rank)
+SELECT list.id, c.title, c.content, c.rank
+FROM list
+JOIN (VALUES ('i', 'Add the work of the team here', 'Keep the most important cards on top.', 'i'),
+             ('i01', 'Limit the work in progress', 'Finish a card before starting the next one.', 'i')) c(list_rank, title, content, rank)
+     on c.list_rank = list.rank;
+
+WITH board AS (
+    INSERT INTO boards (title, description, is_template, background_type, background_color, background_gradient)
+        VALUES ('Sprint', 'Plan a sprint, track it and review it', true, 'gradient', NULL, 'ocean')
+        RETURNING id),
+     list AS (
+         INSERT INTO lists (board_id, title, rank)
+             SELECT board.id, l.title, l.rank
+             FROM board,
+                  (VALUES ('Product backlog', 'i'),
+                          ('Sprint backlog', 'i01'),
+                          ('In progress', 'i02'),
+                          ('Review', 'i03'),
+                          ('Done', 'i04')) l(title, rank)
+             RETURNING id, rank),
+     label AS (
+         INSERT INTO labels (board_id, name, color)
+             SELECT board.id, l.name, l.color
+             FROM board,
+                  (VALUES ('Feature', '#61bd4f'),
+                          ('Bug', '#eb5a46'),
+                          ('Tech debt', '#c377e0')) l(name, color))
+INSERT
+INTO cards (list_id, title, content, rank)
+SELECT list.id, c.title, c.content, c.rank
+FROM list
+JOIN (VALUES ('i01', 'Sprint goal', 'Write down what the sprint should achieve.', 'i')) c(list_rank, title, content, rank)
+     on c.list_rank = list.rank;
+
+WITH board AS (
+    INSERT INTO boards (title, description, is_template, background_type, background_color, background_gradient)
+        VALUES ('Bug tracking', 'Triage reported bugs and follow them until they are fixed', true, 'gradient', NULL,
+                'sunset')
+        RETURNING id),
+     list AS (
+         INSERT INTO lists (board_id, title, rank)
+             SELECT board.id, l.title, l.rank
+             FROM board,
+                  (VALUES ('Reported', 'i'),
+                          ('Triaged', 'i01'),
+                          ('Fixing', 'i02'),
+                          ('Verifying', 'i03'),
+                          ('Closed', 'i04')) l(title, rank))
+INSERT
+INTO labels (board_id, name, color)
+SELECT board.id, l.name, l.color
+FROM board,
+     (VALUES ('Critical', '#eb5a46'),
+             ('Major', '#ff9f1a'),
+             ('Minor', '#f2d600')) l(name, color);
@@ -0,0 +1,175 @@
+package integration
+
+import (
+	"database/sql"
+	pkgAccess "github.com/SlavaShagalov/my-trello-backend/internal/access"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pkgZap "github.com/SlavaShagalov/my-trello-backend/internal/pkg/log/zap"
+	pkgDb "github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"log"
+	"os"
+	"testing"
+
+	accessRepo "github.com/SlavaShagalov/my-trello-backend/internal/access/repository/postgres"
+	accessUC "github.com/SlavaShagalov/my-trello-backend/internal/access/usecase"
+)
+
+type AccessSuite struct {
+	suite.Suite
+	db      *sql.DB
+	logger  *zap.Logger
+	logfile *os.File
+	uc      pkgAccess.Usecase
+}
+
+func (s *AccessSuite) SetupSuite() {
+	var err error
+	s.logger, s.logfile, err = pkgZap.NewTestLogger("/logs/access.log")
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+
+	config.SetTestPostgresConfig()
+	s.db, err = pkgDb.NewStd(s.logger)
+	s.Require().NoError(err)
+
+	repo := accessRepo.New(s.db, s.logger)
+	s.uc = accessUC.New(repo)
+}
+
+func (s *AccessSuite) TearDownSuite() {
+	err := s.db.Close()
+	s.Require().NoError(err)
+
+	err = s.logger.Sync()
+	if err != nil {
+		log.Println(err)
+	}
+	err = s.logfile.Close()
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// Test data: workspace 1 (boards 1-3, lists 1-9, cards 1-27) is owned by user 1,
+// user 3 is its observer and user 4 its member; workspace 2 (boards 4-6,
+// lists 10-18, cards 28-54) is owned by user 2.
+
+func (s *AccessSuite) TestCheckWorkspace() {
+	type testCase struct {
+		userID      int
+		workspaceID int
+		perm        pkgAccess.Permission
+		err         error
+	}
+
+	tests := map[string]testCase{
+		"owner":               {userID: 1, workspaceID: 1, perm: pkgAccess.Own, err: nil},
+		"member deletes":      {userID: 4, workspaceID: 1, perm: pkgAccess.Own, err: pkgErrors.ErrAccessDenied},
+		"observer reads":      {userID: 3, workspaceID: 1, perm: pkgAccess.Read, err: nil},
+		"foreign workspace":   {userID: 1, workspaceID: 2, perm: pkgAccess.Read, err: pkgErrors.ErrAccessDenied},
+		"workspace not found": {userID: 1, workspaceID: 999, perm: pkgAccess.Read, err: pkgErrors.ErrWorkspaceNotFound},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			err := s.uc.CheckWorkspace(test.userID, test.workspaceID, test.perm)
+			assert.ErrorIs(s.T(), err, test.err, "unexpected error")
+		})
+	}
+}
+
+func (s *AccessSuite) TestCheckBoard() {
+	type testCase struct {
+		userID  int
+		boardID int
+		perm    pkgAccess.Permission
+		err     error
+	}
+
+	tests := map[string]testCase{
+		"owner":           {userID: 1, boardID: 1, perm: pkgAccess.Manage, err: nil},
+		"member writes":   {userID: 4, boardID: 1, perm: pkgAccess.Write, err: nil},
+		"observer writes": {userID: 3, boardID: 1, perm: pkgAccess.Write, err: pkgErrors.ErrAccessDenied},
+		"foreign board":   {userID: 1, boardID: 4, perm: pkgAccess.Read, err: pkgErrors.ErrAccessDenied},
+		"board not found": {userID: 1, boardID: 999, perm: pkgAccess.Read, err: pkgErrors.ErrBoardNotFound},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			err := s.uc.CheckBoard(test.userID, test.boardID, test.perm)
+			assert.ErrorIs(s.T(), err, test.err, "unexpected error")
+		})
+	}
+}
+
+func (s *AccessSuite) TestCheckList() {
+	type testCase struct {
+		userID int
+		listID int
+		perm   pkgAccess.Permission
+		err    error
+	}
+
+	tests := map[string]testCase{
+		"owner":          {userID: 2, listID: 10, perm: pkgAccess.Write, err: nil},
+		"observer reads": {userID: 3, listID: 1, perm: pkgAccess.Read, err: nil},
+		"foreign list":   {userID: 1, listID: 10, perm: pkgAccess.Read, err: pkgErrors.ErrAccessDenied},
+		"list not found": {userID: 1, listID: 999, perm: pkgAccess.Read, err: pkgErrors.ErrListNotFound},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			err := s.uc.CheckList(test.userID, test.listID, test.perm)
+			assert.ErrorIs(s.T(), err, test.err, "unexpected error")
+		})
+	}
+}
+
+func (s *AccessSuite) TestCheckCard() {
+	type testCase struct {
+		userID int
+		cardID int
+		perm   pkgAccess.Permission
+		err    error
+	}
+
+	tests := map[string]testCase{
+		"owner":           {userID: 1, cardID: 8, perm: pkgAccess.Write, err: nil},
+		"observer writes": {userID: 3, cardID: 8, perm: pkgAccess.Write, err: pkgErrors.ErrAccessDenied},
+		"foreign card":    {userID: 2, cardID: 8, perm: pkgAccess.Read, err: pkgErrors.ErrAccessDenied},
+		"card not found":  {userID: 1, cardID: 999, perm: pkgAccess.Read, err: pkgErrors.ErrCardNotFound},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			err := s.uc.CheckCard(test.userID, test.cardID, test.perm)
+			assert.ErrorIs(s.T(), err, test.err, "unexpected error")
+		})
+	}
+}
+
+func (s *AccessSuite) TestCheckBuiltInTemplate() {
+	var boardID, listID int
+	err := s.db.QueryRow(`
+		SELECT b.id, l.id
+		FROM boards b
+		JOIN lists l on l.board_id = b.id
+		WHERE b.workspace_id IS NULL
+		LIMIT 1;`).Scan(&boardID, &listID)
+	s.Require().NoError(err)
+
+	assert.NoError(s.T(), s.uc.CheckBoard(2, boardID, pkgAccess.Read))
+	assert.NoError(s.T(), s.uc.CheckList(2, listID, pkgAccess.Read))
+	assert.ErrorIs(s.T(), s.uc.CheckBoard(2, boardID, pkgAccess.Write), pkgErrors.ErrAccessDenied)
+	assert.ErrorIs(s.T(), s.uc.CheckList(2, listID, pkgAccess.Write), pkgErrors.ErrAccessDenied)
+}
+
+func TestAccessSuite(t *testing.T) {
+	suite.Run(t, new(AccessSuite))
+}
@@ -0,0 +1,126 @@
+package integration
+
+import (
+	"database/sql"
+	pkgAssignees "github.com/SlavaShagalov/my-trello-backend/internal/assignees"
+	pkgCards "github.com/SlavaShagalov/my-trello-backend/internal/cards"
+	"github.com/SlavaShagalov/my-trello-backend/internal/events/mocks"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pkgZap "github.com/SlavaShagalov/my-trello-backend/internal/pkg/log/zap"
+	pkgDb "github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages/postgres"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	assigneesRepo "github.com/SlavaShagalov/my-trello-backend/internal/assignees/repository/postgres"
+	assigneesUC "github.com/SlavaShagalov/my-trello-backend/internal/assignees/usecase"
+	cardsRepo "github.com/SlavaShagalov/my-trello-backend/internal/cards/repository/postgres"
+	cardsUC "github.com/SlavaShagalov/my-trello-backend/internal/cards/usecase"
+	listsRepo "github.com/SlavaShagalov/my-trello-backend/internal/lists/repository/postgres"
+)
+
+type AssigneesSuite struct {
+	suite.Suite
+	db      *sql.DB
+	logger  *zap.Logger
+	logfile *os.File
+	ctrl    *gomock.Controller
+	uc      pkgAssignees.Usecase
+	cardsUC pkgCards.Usecase
+}
+
+func (s *AssigneesSuite) SetupSuite() {
+	var err error
+	s.logger, s.logfile, err = pkgZap.NewTestLogger("/logs/assignees.log")
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+
+	config.SetTestPostgresConfig()
+	s.db, err = pkgDb.NewStd(s.logger)
+	s.Require().NoError(err)
+
+	s.ctrl = gomock.NewController(s.T())
+	bus := mocks.NewMockBus(s.ctrl)
+	bus.EXPECT().Publish(gomock.Any()).AnyTimes()
+
+	s.uc = assigneesUC.New(assigneesRepo.New(s.db, s.logger))
+	s.cardsUC = cardsUC.New(cardsRepo.New(s.db, s.logger), listsRepo.New(s.db, s.logger), bus)
+}
+
+func (s *AssigneesSuite) TearDownSuite() {
+	s.ctrl.Finish()
+
+	err := s.db.Close()
+	s.Require().NoError(err)
+
+	err = s.logger.Sync()
+	if err != nil {
+		log.Println(err)
+	}
+	err = s.logfile.Close()
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+func (s *AssigneesSuite) TestMyCards() {
+	for _, cardID := range []int{10, 1, 2} {
+		_, err := s.uc.Assign(cardID, 4)
+		s.Require().NoError(err)
+		defer func(cardID int) {
+			_, err := s.uc.Unassign(cardID, 4)
+			assert.NoError(s.T(), err)
+		}(cardID)
+	}
+
+	// Assigning twice changes nothing.
+	assignees, err := s.uc.Assign(1, 4)
+	s.Require().NoError(err)
+	s.Require().Len(assignees, 1)
+	assert.Equal(s.T(), "evgenii", assignees[0].Username)
+
+	_, err = s.uc.Assign(1, 2)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrAssigneeNotInWorkspace)
+
+	cards, err := s.cardsUC.ListByList(1, nil)
+	s.Require().NoError(err)
+	s.Require().Len(cards[0].Assignees, 1)
+	assert.Equal(s.T(), 4, cards[0].Assignees[0].UserID)
+	assert.Empty(s.T(), cards[2].Assignees)
+
+	boards, err := s.cardsUC.ListByAssignee(4, "")
+	s.Require().NoError(err)
+	s.Require().Len(boards, 2)
+	assert.Equal(s.T(), 1, boards[0].BoardID)
+	s.Require().Len(boards[0].Cards, 2)
+	assert.Equal(s.T(), 1, boards[0].Cards[0].ID)
+	assert.Equal(s.T(), 2, boards[0].Cards[1].ID)
+	assert.NotEmpty(s.T(), boards[0].Cards[0].ListTitle)
+	assert.Equal(s.T(), 2, boards[1].BoardID)
+
+	dueAt := time.Now().UTC().Add(24 * time.Hour)
+	_, err = s.cardsUC.PartialUpdate(&pkgCards.PartialUpdateParams{ID: 10, DueAt: &dueAt, UpdateDueAt: true})
+	s.Require().NoError(err)
+	defer func() {
+		_, err := s.cardsUC.PartialUpdate(&pkgCards.PartialUpdateParams{ID: 10, UpdateDueAt: true})
+		assert.NoError(s.T(), err)
+	}()
+
+	boards, err = s.cardsUC.ListByAssignee(4, pkgCards.SortByDue)
+	s.Require().NoError(err)
+	s.Require().Len(boards, 2)
+	assert.Equal(s.T(), 2, boards[0].BoardID)
+	assert.Equal(s.T(), 10, boards[0].Cards[0].ID)
+}
+
+func TestAssigneesSuite(t *testing.T) {
+	suite.Run(t, new(AssigneesSuite))
+}
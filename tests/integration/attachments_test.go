@@ -0,0 +1,108 @@
+package integration
+
+import (
+	"database/sql"
+	pkgAttachments "github.com/SlavaShagalov/my-trello-backend/internal/attachments"
+	imgMocks "github.com/SlavaShagalov/my-trello-backend/internal/images/mocks"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pkgZap "github.com/SlavaShagalov/my-trello-backend/internal/pkg/log/zap"
+	pkgDb "github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages/postgres"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"log"
+	"os"
+	"testing"
+
+	attachmentsRepo "github.com/SlavaShagalov/my-trello-backend/internal/attachments/repository/postgres"
+	attachmentsUC "github.com/SlavaShagalov/my-trello-backend/internal/attachments/usecase"
+)
+
+type AttachmentsSuite struct {
+	suite.Suite
+	db      *sql.DB
+	logger  *zap.Logger
+	logfile *os.File
+	ctrl    *gomock.Controller
+	imgRepo *imgMocks.MockRepository
+	uc      pkgAttachments.Usecase
+}
+
+func (s *AttachmentsSuite) SetupSuite() {
+	var err error
+	s.logger, s.logfile, err = pkgZap.NewTestLogger("/logs/attachments.log")
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+
+	config.SetTestPostgresConfig()
+	config.SetDefaultValidationConfig()
+	s.db, err = pkgDb.NewStd(s.logger)
+	s.Require().NoError(err)
+
+	s.ctrl = gomock.NewController(s.T())
+	s.imgRepo = imgMocks.NewMockRepository(s.ctrl)
+
+	s.uc = attachmentsUC.New(attachmentsRepo.New(s.db, s.logger), s.imgRepo)
+}
+
+func (s *AttachmentsSuite) TearDownSuite() {
+	s.ctrl.Finish()
+
+	err := s.db.Close()
+	s.Require().NoError(err)
+
+	err = s.logger.Sync()
+	if err != nil {
+		log.Println(err)
+	}
+	err = s.logfile.Close()
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+func (s *AttachmentsSuite) TestAttachments() {
+	var key string
+	s.imgRepo.EXPECT().Create(gomock.Any(), []byte("plan"), "application/octet-stream").
+		DoAndReturn(func(name string, data []byte, contentType string) error {
+			key = name
+			return nil
+		})
+	attachment, err := s.uc.Upload(&pkgAttachments.UploadParams{CardID: 6, UploaderID: 1, Filename: "plan.txt",
+		ContentType: "text/plain", Data: []byte("plan")})
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "plan.txt", attachment.Filename)
+	assert.Equal(s.T(), int64(4), attachment.Size)
+	s.Require().NotNil(attachment.UploaderID)
+	assert.Equal(s.T(), 1, *attachment.UploaderID)
+
+	attachments, err := s.uc.ListByCard(6)
+	s.Require().NoError(err)
+	s.Require().Len(attachments, 1)
+	assert.Equal(s.T(), key, attachments[0].Key)
+
+	s.imgRepo.EXPECT().Get(key).Return([]byte("plan"), nil)
+	downloaded, data, err := s.uc.Download(6, attachment.ID)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "text/plain", downloaded.ContentType)
+	assert.Equal(s.T(), []byte("plan"), data)
+
+	_, err = s.uc.Get(7, attachment.ID)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrAttachmentNotFound)
+
+	s.imgRepo.EXPECT().Delete(key).Return(nil)
+	err = s.uc.Delete(6, attachment.ID)
+	s.Require().NoError(err)
+
+	attachments, err = s.uc.ListByCard(6)
+	s.Require().NoError(err)
+	assert.Empty(s.T(), attachments)
+}
+
+func TestAttachmentsSuite(t *testing.T) {
+	suite.Run(t, new(AttachmentsSuite))
+}
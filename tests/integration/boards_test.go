@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	pkgBoards "github.com/SlavaShagalov/my-trello-backend/internal/boards"
+	pkgCards "github.com/SlavaShagalov/my-trello-backend/internal/cards"
+	pkgLists "github.com/SlavaShagalov/my-trello-backend/internal/lists"
 	"github.com/SlavaShagalov/my-trello-backend/internal/models"
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
 	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
@@ -20,10 +22,14 @@ import (
 	"log"
 	"os"
 	"testing"
+	"time"
 
 	boardsRepo "github.com/SlavaShagalov/my-trello-backend/internal/boards/repository/std"
 	boardsUC "github.com/SlavaShagalov/my-trello-backend/internal/boards/usecase"
+	cardsRepo "github.com/SlavaShagalov/my-trello-backend/internal/cards/repository/postgres"
+	eventsMocks "github.com/SlavaShagalov/my-trello-backend/internal/events/mocks"
 	imgMocks "github.com/SlavaShagalov/my-trello-backend/internal/images/mocks"
+	listsRepo "github.com/SlavaShagalov/my-trello-backend/internal/lists/repository/postgres"
 )
 
 type BoardsSuite struct {
@@ -64,7 +70,9 @@ func (s *BoardsSuite) SetupSuite() {
 
 	repo := boardsRepo.New(s.db, s.log)
 	imgRepo := imgMocks.NewMockRepository(ctrl)
-	s.uc = boardsUC.New(repo, imgRepo)
+	bus := eventsMocks.NewMockBus(ctrl)
+	bus.EXPECT().Publish(gomock.Any()).AnyTimes()
+	s.uc = boardsUC.New(repo, imgRepo, bus)
 }
 
 func (s *BoardsSuite) TearDownSuite() {
@@ -182,7 +190,7 @@ func (s *BoardsSuite) TestList() {
 			ctx, span := opentel.Tracer.Start(context.Background(), "TestList "+name)
 			defer span.End()
 
-			boards, err := s.uc.ListByWorkspace(ctx, test.userID)
+			boards, err := s.uc.ListByWorkspace(ctx, test.userID, false)
 
 			assert.ErrorIs(s.T(), err, test.err, "unexpected error")
 
@@ -383,6 +391,80 @@ func (s *BoardsSuite) TestPartialUpdate() {
 	}
 }
 
+func (s *BoardsSuite) TestSwitchBackground() {
+	ctx := context.Background()
+
+	board, err := s.uc.Create(ctx, &pkgBoards.CreateParams{Title: "Temp Board", WorkspaceID: 2})
+	s.Require().NoError(err)
+	defer func() {
+		assert.NoError(s.T(), s.uc.Delete(ctx, board.ID))
+	}()
+	assert.Equal(s.T(), models.BackgroundTypeColor, board.BackgroundType)
+	s.Require().NotNil(board.BackgroundColor)
+	assert.Equal(s.T(), models.BackgroundColors[0], *board.BackgroundColor)
+
+	gradient := models.BackgroundGradients[0].Name
+	board, err = s.uc.PartialUpdate(ctx, &pkgBoards.PartialUpdateParams{
+		ID:                 board.ID,
+		BackgroundType:     models.BackgroundTypeGradient,
+		BackgroundGradient: &gradient,
+		UpdateBackground:   true,
+	})
+	s.Require().NoError(err)
+	assert.Equal(s.T(), models.BackgroundTypeGradient, board.BackgroundType)
+	assert.Nil(s.T(), board.BackgroundColor)
+	assert.Equal(s.T(), &gradient, board.BackgroundGradient)
+
+	color := " #B04632"
+	board, err = s.uc.PartialUpdate(ctx, &pkgBoards.PartialUpdateParams{
+		ID:                 board.ID,
+		BackgroundType:     models.BackgroundTypeColor,
+		BackgroundColor:    &color,
+		BackgroundGradient: &gradient,
+		UpdateBackground:   true,
+	})
+	s.Require().NoError(err)
+	getBoard, err := s.uc.Get(ctx, board.ID)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), models.BackgroundTypeColor, getBoard.BackgroundType)
+	s.Require().NotNil(getBoard.BackgroundColor)
+	assert.Equal(s.T(), "#b04632", *getBoard.BackgroundColor)
+	assert.Nil(s.T(), getBoard.BackgroundGradient)
+	assert.Nil(s.T(), getBoard.Background)
+
+	unknown := "rainbow"
+	tests := map[string]struct {
+		params *pkgBoards.PartialUpdateParams
+		err    error
+	}{
+		"image": {
+			params: &pkgBoards.PartialUpdateParams{BackgroundType: models.BackgroundTypeImage},
+			err:    pkgErrors.ErrInvalidBackgroundType,
+		},
+		"no color": {
+			params: &pkgBoards.PartialUpdateParams{BackgroundType: models.BackgroundTypeColor},
+			err:    pkgErrors.ErrInvalidBackgroundColor,
+		},
+		"bad color": {
+			params: &pkgBoards.PartialUpdateParams{BackgroundType: models.BackgroundTypeColor, BackgroundColor: &unknown},
+			err:    pkgErrors.ErrInvalidBackgroundColor,
+		},
+		"unknown gradient": {
+			params: &pkgBoards.PartialUpdateParams{BackgroundType: models.BackgroundTypeGradient,
+				BackgroundGradient: &unknown},
+			err: pkgErrors.ErrInvalidBackgroundGradient,
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			test.params.ID = board.ID
+			test.params.UpdateBackground = true
+			_, err := s.uc.PartialUpdate(ctx, test.params)
+			assert.ErrorIs(s.T(), err, test.err)
+		})
+	}
+}
+
 func (s *BoardsSuite) TestDelete() {
 	type testCase struct {
 		setupBoard func() (models.Board, error)
@@ -427,6 +509,179 @@ func (s *BoardsSuite) TestDelete() {
 	}
 }
 
+func (s *BoardsSuite) TestCopy() {
+	const countCmd = `
+		SELECT count(DISTINCT l.id), count(c.id)
+		FROM lists l
+		LEFT JOIN cards c on c.list_id = l.id AND c.archived_at IS NULL
+		WHERE l.board_id = $1 AND l.archived_at IS NULL;`
+	count := func(boardID int) (lists, cards int) {
+		err := s.db.QueryRow(countCmd, boardID).Scan(&lists, &cards)
+		s.Require().NoError(err)
+		return lists, cards
+	}
+
+	// Board 1 is in workspace 1 of user 1.
+	copied, err := s.uc.Copy(s.ctx, &pkgBoards.CopyParams{ID: 1, WorkspaceID: 1, Title: "Copy"})
+	s.Require().NoError(err)
+	defer func() { _ = s.uc.Delete(s.ctx, copied.ID) }()
+	assert.Equal(s.T(), "Copy", copied.Title)
+	assert.Equal(s.T(), 1, copied.WorkspaceID)
+	assert.False(s.T(), copied.IsTemplate)
+
+	lists, cards := count(1)
+	copiedLists, copiedCards := count(copied.ID)
+	assert.Equal(s.T(), lists, copiedLists)
+	assert.Equal(s.T(), cards, copiedCards)
+
+	template, err := s.uc.Copy(s.ctx, &pkgBoards.CopyParams{ID: 1, WorkspaceID: 1, IsTemplate: true})
+	s.Require().NoError(err)
+	defer func() { _ = s.uc.Delete(s.ctx, template.ID) }()
+	assert.True(s.T(), template.IsTemplate)
+
+	templates, err := s.uc.ListTemplates(s.ctx, 1)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(templates)
+	builtIn := templates[0]
+	assert.Zero(s.T(), builtIn.WorkspaceID)
+	assert.Contains(s.T(), templates, template)
+
+	board, err := s.uc.Instantiate(s.ctx, &pkgBoards.CopyParams{ID: builtIn.ID, WorkspaceID: 1, Title: "Sprint 1"})
+	s.Require().NoError(err)
+	defer func() { _ = s.uc.Delete(s.ctx, board.ID) }()
+	assert.Equal(s.T(), "Sprint 1", board.Title)
+	assert.Equal(s.T(), 1, board.WorkspaceID)
+	assert.False(s.T(), board.IsTemplate)
+	lists, _ = count(builtIn.ID)
+	copiedLists, _ = count(board.ID)
+	assert.Equal(s.T(), lists, copiedLists)
+
+	_, err = s.uc.Instantiate(s.ctx, &pkgBoards.CopyParams{ID: 1, WorkspaceID: 1})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrTemplateNotFound)
+
+	_, err = s.uc.Copy(s.ctx, &pkgBoards.CopyParams{ID: 1, WorkspaceID: 999})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrWorkspaceNotFound)
+
+	_, err = s.uc.Copy(s.ctx, &pkgBoards.CopyParams{ID: 999, WorkspaceID: 1})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrBoardNotFound)
+}
+
+func (s *BoardsSuite) TestExportImport() {
+	// Board 1 is in workspace 1 of user 1.
+	export, err := s.uc.Export(s.ctx, 1)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), models.BoardExportVersion, export.Version)
+	s.Require().NotEmpty(export.Lists)
+
+	board, err := s.uc.Import(s.ctx, 1, &export)
+	s.Require().NoError(err)
+	defer func() { _ = s.uc.Delete(s.ctx, board.ID) }()
+	assert.NotEqual(s.T(), 1, board.ID)
+	assert.Equal(s.T(), 1, board.WorkspaceID)
+	assert.Equal(s.T(), export.Title, board.Title)
+
+	imported, err := s.uc.Export(s.ctx, board.ID)
+	s.Require().NoError(err)
+	imported.ExportedAt = export.ExportedAt
+	assert.Equal(s.T(), export, imported)
+
+	_, err = s.uc.Export(s.ctx, 999)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrBoardNotFound)
+
+	_, err = s.uc.Import(s.ctx, 999, &export)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrWorkspaceNotFound)
+
+	old := export
+	old.Version = models.BoardExportVersion + 1
+	_, err = s.uc.Import(s.ctx, 1, &old)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrBadExportVersion)
+
+	broken := models.BoardExport{
+		Version:         models.BoardExportVersion,
+		BackgroundType:  models.BackgroundTypeColor,
+		BackgroundColor: &models.BackgroundColors[0],
+		Lists: []models.ExportList{
+			{Title: "List", Cards: []models.ExportCard{{Title: "Card", Labels: []int{0}}}},
+		},
+	}
+	_, err = s.uc.Import(s.ctx, 1, &broken)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrBadExportLabel)
+
+	// Repeated labels would break the primary key of card_labels.
+	broken.Labels = []models.ExportLabel{{Name: "Bug", Color: "#eb5a46"}}
+	broken.Lists[0].Cards[0].Labels = []int{0, 0}
+	_, err = s.uc.Import(s.ctx, 1, &broken)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrBadExportLabel)
+}
+
+func (s *BoardsSuite) TestGetFull() {
+	lists := listsRepo.New(s.db, s.log)
+	cards := cardsRepo.New(s.db, s.log)
+
+	color := models.BackgroundColors[0]
+	board, err := s.uc.Import(s.ctx, 1, &models.BoardExport{
+		Version:         models.BoardExportVersion,
+		Title:           "Full",
+		BackgroundType:  models.BackgroundTypeColor,
+		BackgroundColor: &color,
+		Labels:          []models.ExportLabel{{Name: "Red", Color: "#eb5a46"}},
+		Lists: []models.ExportList{
+			{Title: "Todo", Cards: []models.ExportCard{{Title: "A", Labels: []int{0}}, {Title: "B"}, {Title: "C"}}},
+			{Title: "Done", Cards: []models.ExportCard{{Title: "D"}}},
+			{Title: "Old", Cards: []models.ExportCard{{Title: "E"}}},
+		},
+	})
+	s.Require().NoError(err)
+	defer func() { _ = s.uc.Delete(s.ctx, board.ID) }()
+
+	full, err := s.uc.GetFull(s.ctx, board.ID)
+	s.Require().NoError(err)
+	s.Require().Len(full.Lists, 3)
+	todo, done, old := full.Lists[0], full.Lists[1], full.Lists[2]
+	s.Require().Len(todo.Cards, 3)
+	a, b, c := todo.Cards[0], todo.Cards[1], todo.Cards[2]
+
+	now := time.Now()
+	_, err = lists.SetArchived(old.ID, &now, 1)
+	s.Require().NoError(err)
+	_, err = cards.SetArchived(b.ID, &now, 1)
+	s.Require().NoError(err)
+	_, err = lists.Move(&pkgLists.MoveParams{ID: done.ID, BoardID: board.ID, Position: 1})
+	s.Require().NoError(err)
+	_, err = cards.Move(&pkgCards.MoveParams{ID: c.ID, ListID: todo.ID, Position: 1})
+	s.Require().NoError(err)
+
+	full, err = s.uc.GetFull(s.ctx, board.ID)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), board.ID, full.ID)
+	assert.Equal(s.T(), "Full", full.Title)
+	s.Require().Len(full.Labels, 1)
+	assert.Equal(s.T(), "Red", full.Labels[0].Name)
+
+	// Lists and cards in the order of their ranks, archived ones left out.
+	s.Require().Len(full.Lists, 2, "archived list included")
+	assert.Equal(s.T(), done.ID, full.Lists[0].ID)
+	assert.Equal(s.T(), todo.ID, full.Lists[1].ID)
+	for i, list := range full.Lists {
+		assert.Equal(s.T(), i+1, list.Position)
+		for j, card := range list.Cards {
+			assert.Equal(s.T(), list.ID, card.ListID, "card in another list")
+			assert.Equal(s.T(), j+1, card.Position)
+		}
+	}
+	s.Require().Len(full.Lists[0].Cards, 1)
+	assert.Equal(s.T(), "D", full.Lists[0].Cards[0].Title)
+	s.Require().Len(full.Lists[1].Cards, 2, "archived card included")
+	assert.Equal(s.T(), c.ID, full.Lists[1].Cards[0].ID)
+	assert.Equal(s.T(), a.ID, full.Lists[1].Cards[1].ID)
+	s.Require().Len(full.Lists[1].Cards[1].Labels, 1)
+	assert.Equal(s.T(), full.Labels[0].ID, full.Lists[1].Cards[1].Labels[0].ID)
+	assert.Empty(s.T(), full.Lists[1].Cards[0].Labels)
+
+	_, err = s.uc.GetFull(s.ctx, 999)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrBoardNotFound)
+}
+
 func TestBoardSuite(t *testing.T) {
 	suite.Run(t, new(BoardsSuite))
 }
@@ -1,28 +1,39 @@
 package integration
 
 import (
+	"context"
 	"database/sql"
 	pkgCards "github.com/SlavaShagalov/my-trello-backend/internal/cards"
+	pkgLabels "github.com/SlavaShagalov/my-trello-backend/internal/labels"
 	"github.com/SlavaShagalov/my-trello-backend/internal/models"
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
 	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
 	pkgZap "github.com/SlavaShagalov/my-trello-backend/internal/pkg/log/zap"
+	pkgStorages "github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages"
 	pkgDb "github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages/postgres"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
 	"log"
 	"os"
+	"sort"
+	"sync"
 	"testing"
+	"time"
 
 	cardsRepo "github.com/SlavaShagalov/my-trello-backend/internal/cards/repository/postgres"
 	cardsUC "github.com/SlavaShagalov/my-trello-backend/internal/cards/usecase"
+	eventsBus "github.com/SlavaShagalov/my-trello-backend/internal/events/bus/redis"
+	labelsRepo "github.com/SlavaShagalov/my-trello-backend/internal/labels/repository/postgres"
+	listsRepo "github.com/SlavaShagalov/my-trello-backend/internal/lists/repository/postgres"
 )
 
 type CardsSuite struct {
 	suite.Suite
 	db      *sql.DB
+	rdb     *redis.Client
 	logger  *zap.Logger
 	logfile *os.File
 	uc      pkgCards.Usecase
@@ -40,14 +51,22 @@ func (s *CardsSuite) SetupSuite() {
 	s.db, err = pkgDb.NewStd(s.logger)
 	s.Require().NoError(err)
 
+	config.SetTestRedisConfig()
+	s.rdb, err = pkgStorages.NewRedis(s.logger, context.Background())
+	s.Require().NoError(err)
+
 	repo := cardsRepo.New(s.db, s.logger)
-	s.uc = cardsUC.New(repo)
+	bus := eventsBus.New(s.rdb, context.Background(), s.logger)
+	s.uc = cardsUC.New(repo, listsRepo.New(s.db, s.logger), bus)
 }
 
 func (s *CardsSuite) TearDownSuite() {
 	err := s.db.Close()
 	s.Require().NoError(err)
 
+	err = s.rdb.Close()
+	s.Require().NoError(err)
+
 	err = s.logger.Sync()
 	if err != nil {
 		log.Println(err)
@@ -151,7 +170,7 @@ func (s *CardsSuite) TestList() {
 
 	for name, test := range tests {
 		s.Run(name, func() {
-			cards, err := s.uc.ListByList(test.userID)
+			cards, err := s.uc.ListByList(test.userID, nil)
 
 			assert.ErrorIs(s.T(), err, test.err, "unexpected error")
 
@@ -221,7 +240,6 @@ func (s *CardsSuite) TestFullUpdate() {
 			params: &pkgCards.FullUpdateParams{
 				Title:   "Lab 1",
 				Content: "Надо сделать",
-				ListID:  3,
 			},
 			err: nil,
 		},
@@ -245,7 +263,7 @@ func (s *CardsSuite) TestFullUpdate() {
 				assert.Equal(s.T(), test.params.ID, card.ID, "incorrect ID")
 				assert.Equal(s.T(), test.params.Title, card.Title, "incorrect Title")
 				assert.Equal(s.T(), test.params.Content, card.Content, "incorrect Content")
-				assert.Equal(s.T(), test.params.ListID, card.ListID, "incorrect ListID")
+				assert.Equal(s.T(), tempCard.ListID, card.ListID, "incorrect ListID")
 
 				// check card in storages
 				getCard, err := s.uc.Get(card.ID)
@@ -385,6 +403,375 @@ func (s *CardsSuite) TestDelete() {
 	}
 }
 
+func (s *CardsSuite) TestDates() {
+	now := time.Now().UTC().Truncate(time.Second)
+	yesterday, tomorrow := now.Add(-24*time.Hour), now.Add(24*time.Hour)
+
+	late, err := s.uc.Create(&pkgCards.CreateParams{Title: "Late", ListID: 18, StartAt: &yesterday, DueAt: &yesterday})
+	s.Require().NoError(err)
+	defer func() { _ = s.uc.Delete(late.ID) }()
+	assert.True(s.T(), yesterday.Equal(*late.DueAt), "incorrect DueAt")
+
+	soon, err := s.uc.Create(&pkgCards.CreateParams{Title: "Soon", ListID: 18, DueAt: &tomorrow})
+	s.Require().NoError(err)
+	defer func() { _ = s.uc.Delete(soon.ID) }()
+	assert.Nil(s.T(), soon.StartAt, "incorrect StartAt")
+
+	_, err = s.uc.PartialUpdate(&pkgCards.PartialUpdateParams{ID: soon.ID, StartAt: &tomorrow, UpdateStartAt: true,
+		DueAt: &yesterday, UpdateDueAt: true})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrInvalidCardDates)
+
+	ids := func(filter *pkgCards.Filter) []int {
+		cards, err := s.uc.ListByBoard(6, filter)
+		s.Require().NoError(err)
+		var ids []int
+		for _, card := range cards {
+			ids = append(ids, card.ID)
+		}
+		return ids
+	}
+	weekAhead := now.Add(7 * 24 * time.Hour)
+	assert.Equal(s.T(), []int{late.ID, soon.ID}, ids(&pkgCards.Filter{DueBefore: &weekAhead}))
+	assert.Equal(s.T(), []int{late.ID}, ids(&pkgCards.Filter{Overdue: true}))
+
+	completed, err := s.uc.Complete(late.ID)
+	s.Require().NoError(err)
+	s.Require().NotNil(completed.CompletedAt)
+	assert.Nil(s.T(), ids(&pkgCards.Filter{Overdue: true}))
+
+	done := true
+	assert.Equal(s.T(), []int{late.ID}, ids(&pkgCards.Filter{Completed: &done}))
+	cards, err := s.uc.ListByList(18, &pkgCards.Filter{Completed: &done})
+	s.Require().NoError(err)
+	assert.Len(s.T(), cards, 1)
+
+	again, err := s.uc.Complete(late.ID)
+	s.Require().NoError(err)
+	assert.True(s.T(), completed.CompletedAt.Equal(*again.CompletedAt), "completion time changed")
+
+	incomplete, err := s.uc.Incomplete(late.ID)
+	s.Require().NoError(err)
+	assert.Nil(s.T(), incomplete.CompletedAt)
+
+	cleared, err := s.uc.PartialUpdate(&pkgCards.PartialUpdateParams{ID: late.ID, UpdateDueAt: true})
+	s.Require().NoError(err)
+	assert.Nil(s.T(), cleared.DueAt)
+	assert.NotNil(s.T(), cleared.StartAt)
+}
+
+func (s *CardsSuite) TestArchive() {
+	var cards []models.Card
+	for _, title := range []string{"First", "Second", "Third"} {
+		card, err := s.uc.Create(&pkgCards.CreateParams{Title: title, ListID: 18})
+		s.Require().NoError(err)
+		defer func() { _ = s.uc.Delete(card.ID) }()
+		cards = append(cards, card)
+	}
+	first, second, third := cards[0], cards[1], cards[2]
+
+	positions := func(filter *pkgCards.Filter) map[int]int {
+		cards, err := s.uc.ListByList(18, filter)
+		s.Require().NoError(err)
+		positions := map[int]int{}
+		for _, card := range cards {
+			positions[card.ID] = card.Position
+		}
+		return positions
+	}
+
+	archived, err := s.uc.Archive(second.ID, 1)
+	s.Require().NoError(err)
+	s.Require().NotNil(archived.ArchivedAt)
+	active := positions(nil)
+	assert.NotContains(s.T(), active, second.ID, "archived card listed")
+	assert.Equal(s.T(), second.Position, active[third.ID], "following card not moved up")
+	assert.Contains(s.T(), positions(&pkgCards.Filter{Archived: true}), second.ID)
+
+	again, err := s.uc.Archive(second.ID, 1)
+	s.Require().NoError(err)
+	assert.True(s.T(), archived.ArchivedAt.Equal(*again.ArchivedAt), "archiving time changed")
+
+	fourth, err := s.uc.Create(&pkgCards.CreateParams{Title: "Fourth", ListID: 18})
+	s.Require().NoError(err)
+	defer func() { _ = s.uc.Delete(fourth.ID) }()
+	assert.Equal(s.T(), third.Position, fourth.Position, "new card not placed after active ones")
+
+	restored, err := s.uc.Unarchive(second.ID)
+	s.Require().NoError(err)
+	assert.Nil(s.T(), restored.ArchivedAt)
+	assert.Equal(s.T(), second.Position, restored.Position, "card not restored at its position")
+	active = positions(nil)
+	assert.Equal(s.T(), first.Position, active[first.ID])
+	assert.Equal(s.T(), third.Position, active[third.ID])
+	assert.Equal(s.T(), third.Position+1, active[fourth.ID])
+
+	_, err = s.uc.Archive(fourth.ID, 1)
+	s.Require().NoError(err)
+	s.Require().NoError(s.uc.Delete(fourth.ID))
+	assert.Equal(s.T(), third.Position, positions(nil)[third.ID], "deleting archived card moved others")
+
+	_, err = s.uc.Archive(999, 1)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrCardNotFound)
+}
+
+// requireContiguous checks that active cards of the list are at positions from 1 without gaps and duplicates.
+func (s *CardsSuite) requireContiguous(listID int) []models.Card {
+	cards, err := s.uc.ListByList(listID, nil)
+	s.Require().NoError(err)
+	positions := make([]int, 0, len(cards))
+	for _, card := range cards {
+		positions = append(positions, card.Position)
+	}
+	sort.Ints(positions)
+	for i, position := range positions {
+		s.Require().Equal(i+1, position, "positions of list %d: %v", listID, positions)
+	}
+	return cards
+}
+
+func (s *CardsSuite) TestMove() {
+	// Lists 17 (board 6) and 13 (board 5) are in workspace 2, list 1 is in workspace 1.
+	var cards []models.Card
+	for _, title := range []string{"First", "Second", "Third"} {
+		card, err := s.uc.Create(&pkgCards.CreateParams{Title: title, ListID: 17})
+		s.Require().NoError(err)
+		defer func() { _ = s.uc.Delete(card.ID) }()
+		cards = append(cards, card)
+	}
+	first, third := cards[0], cards[2]
+
+	moved, err := s.uc.Move(&pkgCards.MoveParams{ID: third.ID, ListID: 17, Position: first.Position})
+	s.Require().NoError(err)
+	assert.Equal(s.T(), first.Position, moved.Position)
+	s.requireContiguous(17)
+
+	moved, err = s.uc.Move(&pkgCards.MoveParams{ID: first.ID, ListID: 13, Position: 1})
+	s.Require().NoError(err)
+	assert.Equal(s.T(), 13, moved.ListID)
+	assert.Equal(s.T(), 1, moved.Position)
+	s.requireContiguous(17)
+	s.requireContiguous(13)
+
+	moved, err = s.uc.Move(&pkgCards.MoveParams{ID: first.ID, ListID: 17, Position: pkgCards.LastPosition})
+	s.Require().NoError(err)
+	assert.Equal(s.T(), len(s.requireContiguous(17)), moved.Position, "card not moved last")
+	s.requireContiguous(13)
+
+	_, err = s.uc.Move(&pkgCards.MoveParams{ID: first.ID, ListID: 1, Position: 1})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrCardMoveOtherWorkspace)
+
+	_, err = s.uc.Move(&pkgCards.MoveParams{ID: first.ID, ListID: 999, Position: 1})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrListNotFound)
+
+	_, err = s.uc.Archive(first.ID, 1)
+	s.Require().NoError(err)
+	_, err = s.uc.Move(&pkgCards.MoveParams{ID: first.ID, ListID: 13, Position: 1})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrCardArchived)
+
+	_, err = s.uc.Move(&pkgCards.MoveParams{ID: 999, ListID: 13, Position: 1})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrCardNotFound)
+}
+
+func (s *CardsSuite) TestMoveLabels() {
+	// List 17 is on board 6, list 13 on board 5.
+	labels := labelsRepo.New(s.db, s.logger)
+	card, err := s.uc.Create(&pkgCards.CreateParams{Title: "Labeled", ListID: 17})
+	s.Require().NoError(err)
+	defer func() { _ = s.uc.Delete(card.ID) }()
+
+	urgent, err := labels.Create(&pkgLabels.CreateParams{BoardID: 6, Name: "Urgent", Color: "#eb5a46"})
+	s.Require().NoError(err)
+	defer func() { _ = labels.Delete(urgent.ID) }()
+	later, err := labels.Create(&pkgLabels.CreateParams{BoardID: 6, Name: "Later", Color: "#61bd4f"})
+	s.Require().NoError(err)
+	defer func() { _ = labels.Delete(later.ID) }()
+	target, err := labels.Create(&pkgLabels.CreateParams{BoardID: 5, Name: "Later", Color: "#61bd4f"})
+	s.Require().NoError(err)
+	defer func() { _ = labels.Delete(target.ID) }()
+	s.Require().NoError(labels.Attach(card.ID, urgent.ID))
+	s.Require().NoError(labels.Attach(card.ID, later.ID))
+
+	_, err = s.uc.Move(&pkgCards.MoveParams{ID: card.ID, ListID: 13, Position: 1})
+	s.Require().NoError(err)
+
+	moved, err := labels.ListByCard(card.ID)
+	s.Require().NoError(err)
+	s.Require().Len(moved, 2)
+	names := map[string]int{}
+	for _, label := range moved {
+		assert.Equal(s.T(), 5, label.BoardID, "label of the old board kept")
+		names[label.Name] = label.ID
+		if label.ID != target.ID {
+			defer func(id int) { _ = labels.Delete(id) }(label.ID)
+		}
+	}
+	assert.Equal(s.T(), target.ID, names["Later"], "existing label of the board not reused")
+	assert.Contains(s.T(), names, "Urgent")
+}
+
+func (s *CardsSuite) TestPartialUpdateWithMove() {
+	// Lists 17 and 13 are in workspace 2.
+	card, err := s.uc.Create(&pkgCards.CreateParams{Title: "Moved", ListID: 17})
+	s.Require().NoError(err)
+	defer func() { _ = s.uc.Delete(card.ID) }()
+
+	startAt := time.Now().Add(48 * time.Hour)
+	dueAt := time.Now()
+	_, err = s.uc.PartialUpdate(&pkgCards.PartialUpdateParams{
+		ID:            card.ID,
+		ListID:        13,
+		UpdateListID:  true,
+		StartAt:       &startAt,
+		UpdateStartAt: true,
+		DueAt:         &dueAt,
+		UpdateDueAt:   true,
+	})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrInvalidCardDates)
+	got, err := s.uc.Get(card.ID)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), 17, got.ListID, "card moved by a failed update")
+
+	updated, err := s.uc.PartialUpdate(&pkgCards.PartialUpdateParams{
+		ID:           card.ID,
+		Title:        "Moved and renamed",
+		UpdateTitle:  true,
+		ListID:       13,
+		UpdateListID: true,
+	})
+	s.Require().NoError(err)
+	assert.Equal(s.T(), 13, updated.ListID)
+	assert.Equal(s.T(), "Moved and renamed", updated.Title)
+}
+
+func (s *CardsSuite) TestListByTitle() {
+	// List 1 is on board 1 of workspace 1, user 2 is no member of it.
+	labels := labelsRepo.New(s.db, s.logger)
+	card, err := s.uc.Create(&pkgCards.CreateParams{Title: "Searchable needle", ListID: 1})
+	s.Require().NoError(err)
+	defer func() { _ = s.uc.Delete(card.ID) }()
+	label, err := labels.Create(&pkgLabels.CreateParams{BoardID: 1, Name: "Found", Color: "#61bd4f"})
+	s.Require().NoError(err)
+	defer func() { _ = labels.Delete(label.ID) }()
+	s.Require().NoError(labels.Attach(card.ID, label.ID))
+
+	found, err := s.uc.ListByTitle("NEEDLE", 1)
+	s.Require().NoError(err)
+	s.Require().Len(found, 1)
+	assert.Equal(s.T(), card.ID, found[0].ID)
+	s.Require().Len(found[0].Labels, 1, "labels not filled")
+	assert.Equal(s.T(), label.ID, found[0].Labels[0].ID)
+	assert.NotNil(s.T(), found[0].Assignees, "assignees not filled")
+
+	found, err = s.uc.ListByTitle("needle", 2)
+	s.Require().NoError(err)
+	assert.Empty(s.T(), found, "card of another workspace found")
+}
+
+func (s *CardsSuite) TestMoveByNeighbors() {
+	var cards []models.Card
+	for _, title := range []string{"A", "B", "C", "D", "E"} {
+		card, err := s.uc.Create(&pkgCards.CreateParams{Title: title, ListID: 17})
+		s.Require().NoError(err)
+		defer func() { _ = s.uc.Delete(card.ID) }()
+		cards = append(cards, card)
+	}
+	a, b, c, d, e := cards[0].ID, cards[1].ID, cards[2].ID, cards[3].ID, cards[4].ID
+	position := func(id int) int {
+		card, err := s.uc.Get(id)
+		s.Require().NoError(err)
+		return card.Position
+	}
+
+	// A C B D E
+	moved, err := s.uc.Move(&pkgCards.MoveParams{ID: c, ListID: 17, BeforeID: a, AfterID: b})
+	s.Require().NoError(err)
+	assert.Equal(s.T(), position(a)+1, moved.Position)
+	assert.Equal(s.T(), moved.Position+1, position(b))
+
+	// C B A D E
+	moved, err = s.uc.Move(&pkgCards.MoveParams{ID: a, ListID: 17, AfterID: d})
+	s.Require().NoError(err)
+	assert.Equal(s.T(), moved.Position+1, position(d), "card not put right before its neighbor")
+	s.requireContiguous(17)
+
+	// C D A E with B archived between C and A, which does not matter.
+	_, err = s.uc.Archive(b, 1)
+	s.Require().NoError(err)
+	moved, err = s.uc.Move(&pkgCards.MoveParams{ID: d, ListID: 17, BeforeID: c, AfterID: a})
+	s.Require().NoError(err)
+	assert.Equal(s.T(), position(c)+1, moved.Position)
+	s.requireContiguous(17)
+
+	for name, params := range map[string]pkgCards.MoveParams{
+		"not adjacent":     {ID: e, ListID: 17, BeforeID: c, AfterID: a},
+		"reversed":         {ID: e, ListID: 17, BeforeID: a, AfterID: c},
+		"archived":         {ID: e, ListID: 17, BeforeID: b},
+		"of other list":    {ID: e, ListID: 13, BeforeID: c},
+		"the card itself":  {ID: e, ListID: 17, AfterID: e},
+		"nonexistent card": {ID: e, ListID: 17, BeforeID: 999},
+	} {
+		params := params
+		_, err = s.uc.Move(&params)
+		assert.ErrorIs(s.T(), err, pkgErrors.ErrBadCardNeighbors, name)
+	}
+}
+
+func (s *CardsSuite) TestMoveConcurrently() {
+	// Lists 14 (board 5) and 16 (board 6) are in workspace 2.
+	const perList = 10
+	create := func(listID int) []models.Card {
+		var cards []models.Card
+		for i := 0; i < perList; i++ {
+			card, err := s.uc.Create(&pkgCards.CreateParams{Title: "Concurrent", ListID: listID})
+			s.Require().NoError(err)
+			cards = append(cards, card)
+		}
+		return cards
+	}
+	crossing := map[int][]models.Card{14: create(14), 16: create(16)}
+	staying := map[int][]models.Card{14: create(14), 16: create(16)}
+	defer func() {
+		for _, cards := range [][]models.Card{crossing[14], crossing[16], staying[14], staying[16]} {
+			for _, card := range cards {
+				_ = s.uc.Delete(card.ID)
+			}
+		}
+	}()
+	before14 := s.requireContiguous(14)
+	before16 := s.requireContiguous(16)
+
+	// Cards cross in both directions while others are reordered within the lists.
+	var wg sync.WaitGroup
+	errs := make(chan error, 4*perList)
+	move := func(params pkgCards.MoveParams) {
+		defer wg.Done()
+		_, err := s.uc.Move(&params)
+		errs <- err
+	}
+	for i := 0; i < perList; i++ {
+		wg.Add(4)
+		go move(pkgCards.MoveParams{ID: crossing[14][i].ID, ListID: 16, Position: 1})
+		go move(pkgCards.MoveParams{ID: crossing[16][i].ID, ListID: 14, Position: 2})
+		go move(pkgCards.MoveParams{ID: staying[14][i].ID, ListID: 14, Position: i + 1})
+		go move(pkgCards.MoveParams{ID: staying[16][i].ID, ListID: 16, Position: pkgCards.LastPosition})
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		s.Require().NoError(err)
+	}
+
+	after14 := s.requireContiguous(14)
+	after16 := s.requireContiguous(16)
+	assert.Len(s.T(), after14, len(before14))
+	assert.Len(s.T(), after16, len(before16))
+	for _, card := range crossing[14] {
+		got, err := s.uc.Get(card.ID)
+		s.Require().NoError(err)
+		assert.Equal(s.T(), 16, got.ListID)
+	}
+}
+
 func TestCardSuite(t *testing.T) {
 	suite.Run(t, new(CardsSuite))
 }
@@ -0,0 +1,132 @@
+package integration
+
+import (
+	"database/sql"
+	pkgCards "github.com/SlavaShagalov/my-trello-backend/internal/cards"
+	pkgChecklists "github.com/SlavaShagalov/my-trello-backend/internal/checklists"
+	"github.com/SlavaShagalov/my-trello-backend/internal/events/mocks"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pkgZap "github.com/SlavaShagalov/my-trello-backend/internal/pkg/log/zap"
+	pkgDb "github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages/postgres"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"log"
+	"os"
+	"testing"
+
+	cardsRepo "github.com/SlavaShagalov/my-trello-backend/internal/cards/repository/postgres"
+	checklistsRepo "github.com/SlavaShagalov/my-trello-backend/internal/checklists/repository/postgres"
+	checklistsUC "github.com/SlavaShagalov/my-trello-backend/internal/checklists/usecase"
+	listsRepo "github.com/SlavaShagalov/my-trello-backend/internal/lists/repository/postgres"
+)
+
+type ChecklistsSuite struct {
+	suite.Suite
+	db        *sql.DB
+	logger    *zap.Logger
+	logfile   *os.File
+	ctrl      *gomock.Controller
+	uc        pkgChecklists.Usecase
+	cardsRepo pkgCards.Repository
+}
+
+func (s *ChecklistsSuite) SetupSuite() {
+	var err error
+	s.logger, s.logfile, err = pkgZap.NewTestLogger("/logs/checklists.log")
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+
+	config.SetTestPostgresConfig()
+	s.db, err = pkgDb.NewStd(s.logger)
+	s.Require().NoError(err)
+
+	s.ctrl = gomock.NewController(s.T())
+	bus := mocks.NewMockBus(s.ctrl)
+	bus.EXPECT().Publish(gomock.Any()).AnyTimes()
+
+	s.uc = checklistsUC.New(checklistsRepo.New(s.db, s.logger), listsRepo.New(s.db, s.logger), bus)
+	s.cardsRepo = cardsRepo.New(s.db, s.logger)
+}
+
+func (s *ChecklistsSuite) TearDownSuite() {
+	s.ctrl.Finish()
+
+	err := s.db.Close()
+	s.Require().NoError(err)
+
+	err = s.logger.Sync()
+	if err != nil {
+		log.Println(err)
+	}
+	err = s.logfile.Close()
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+func (s *ChecklistsSuite) TestItems() {
+	checklist, err := s.uc.Create(&pkgChecklists.CreateParams{CardID: 3, Title: "Release"})
+	s.Require().NoError(err)
+	defer func() {
+		assert.NoError(s.T(), s.uc.Delete(3, checklist.ID))
+	}()
+
+	var items []models.ChecklistItem
+	for _, title := range []string{"Build", "Test", "Deploy"} {
+		item, err := s.uc.CreateItem(3, &pkgChecklists.CreateItemParams{ChecklistID: checklist.ID, Title: title})
+		s.Require().NoError(err)
+		items = append(items, item)
+	}
+	assert.Equal(s.T(), 3, items[2].Position)
+
+	// Move "Deploy" to the top.
+	_, err = s.uc.PartialUpdateItem(3, checklist.ID, &pkgChecklists.PartialUpdateItemParams{ID: items[2].ID,
+		Position: 1, UpdatePosition: true})
+	s.Require().NoError(err)
+
+	_, err = s.uc.PartialUpdateItem(3, checklist.ID, &pkgChecklists.PartialUpdateItemParams{ID: items[0].ID,
+		Done: true, UpdateDone: true})
+	s.Require().NoError(err)
+
+	checklists, err := s.uc.ListByCard(3)
+	s.Require().NoError(err)
+	s.Require().Len(checklists, 1)
+	titles := []string{}
+	for _, item := range checklists[0].Items {
+		titles = append(titles, item.Title)
+	}
+	assert.Equal(s.T(), []string{"Deploy", "Build", "Test"}, titles)
+
+	card, err := s.cardsRepo.Get(3)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), models.ChecklistProgress{Done: 1, Total: 3}, card.ChecklistProgress)
+
+	// Converting "Build" closes the gap it leaves.
+	converted, err := s.uc.ConvertItem(3, checklist.ID, items[0].ID)
+	s.Require().NoError(err)
+	defer func() {
+		assert.NoError(s.T(), s.cardsRepo.Delete(converted.ID))
+	}()
+	assert.Equal(s.T(), "Build", converted.Title)
+	card, err = s.cardsRepo.Get(3)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), card.ListID, converted.ListID)
+	assert.Equal(s.T(), models.ChecklistProgress{Done: 0, Total: 2}, card.ChecklistProgress)
+
+	checklists, err = s.uc.ListByCard(3)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), 2, checklists[0].Items[1].Position)
+
+	_, err = s.uc.ConvertItem(4, checklist.ID, items[1].ID)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrChecklistNotFound)
+}
+
+func TestChecklistsSuite(t *testing.T) {
+	suite.Run(t, new(ChecklistsSuite))
+}
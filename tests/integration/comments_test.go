@@ -0,0 +1,116 @@
+package integration
+
+import (
+	"database/sql"
+	pkgCards "github.com/SlavaShagalov/my-trello-backend/internal/cards"
+	pkgComments "github.com/SlavaShagalov/my-trello-backend/internal/comments"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pkgZap "github.com/SlavaShagalov/my-trello-backend/internal/pkg/log/zap"
+	pkgDb "github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"log"
+	"os"
+	"testing"
+
+	cardsRepo "github.com/SlavaShagalov/my-trello-backend/internal/cards/repository/postgres"
+	commentsRepo "github.com/SlavaShagalov/my-trello-backend/internal/comments/repository/postgres"
+	commentsUC "github.com/SlavaShagalov/my-trello-backend/internal/comments/usecase"
+)
+
+type CommentsSuite struct {
+	suite.Suite
+	db        *sql.DB
+	logger    *zap.Logger
+	logfile   *os.File
+	uc        pkgComments.Usecase
+	cardsRepo pkgCards.Repository
+}
+
+func (s *CommentsSuite) SetupSuite() {
+	var err error
+	s.logger, s.logfile, err = pkgZap.NewTestLogger("/logs/comments.log")
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+
+	config.SetTestPostgresConfig()
+	s.db, err = pkgDb.NewStd(s.logger)
+	s.Require().NoError(err)
+
+	s.uc = commentsUC.New(commentsRepo.New(s.db, s.logger))
+	s.cardsRepo = cardsRepo.New(s.db, s.logger)
+}
+
+func (s *CommentsSuite) TearDownSuite() {
+	err := s.db.Close()
+	s.Require().NoError(err)
+
+	err = s.logger.Sync()
+	if err != nil {
+		log.Println(err)
+	}
+	err = s.logfile.Close()
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+func (s *CommentsSuite) TestDiscussion() {
+	var ids []int
+	for _, content := range []string{"first", "second", "third"} {
+		comment, err := s.uc.Create(&pkgComments.CreateParams{CardID: 5, AuthorID: 1, Content: content})
+		s.Require().NoError(err)
+		assert.Equal(s.T(), "slava", comment.AuthorUsername)
+		ids = append(ids, comment.ID)
+	}
+	defer func() {
+		for _, id := range ids {
+			_ = s.uc.Delete(1, 5, id)
+		}
+	}()
+
+	card, err := s.cardsRepo.Get(5)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), 3, card.CommentsCount)
+
+	page, err := s.uc.ListByCard(5, &pkgComments.ListParams{Limit: 2})
+	s.Require().NoError(err)
+	s.Require().Len(page.Comments, 2)
+	assert.Equal(s.T(), "third", page.Comments[0].Content)
+	s.Require().NotNil(page.NextBefore)
+
+	page, err = s.uc.ListByCard(5, &pkgComments.ListParams{Before: *page.NextBefore, Limit: 2})
+	s.Require().NoError(err)
+	s.Require().Len(page.Comments, 1)
+	assert.Equal(s.T(), "first", page.Comments[0].Content)
+	assert.Nil(s.T(), page.NextBefore)
+
+	_, err = s.uc.Update(4, 5, &pkgComments.UpdateParams{ID: ids[0], Content: "hijacked"})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrNotCommentAuthor)
+
+	edited, err := s.uc.Update(1, 5, &pkgComments.UpdateParams{ID: ids[0], Content: "first, edited"})
+	s.Require().NoError(err)
+	assert.NotNil(s.T(), edited.EditedAt)
+	_, err = s.uc.Update(1, 5, &pkgComments.UpdateParams{ID: ids[0], Content: "first, edited twice"})
+	s.Require().NoError(err)
+
+	versions, err := s.uc.ListVersions(5, ids[0])
+	s.Require().NoError(err)
+	s.Require().Len(versions, 2)
+	assert.Equal(s.T(), "first, edited", versions[0].Content)
+	assert.Equal(s.T(), "first", versions[1].Content)
+
+	err = s.uc.Delete(1, 5, ids[2])
+	s.Require().NoError(err)
+	card, err = s.cardsRepo.Get(5)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), 2, card.CommentsCount)
+}
+
+func TestCommentsSuite(t *testing.T) {
+	suite.Run(t, new(CommentsSuite))
+}
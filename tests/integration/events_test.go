@@ -0,0 +1,199 @@
+package integration
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	pkgCards "github.com/SlavaShagalov/my-trello-backend/internal/cards"
+	pkgEvents "github.com/SlavaShagalov/my-trello-backend/internal/events"
+	pkgLists "github.com/SlavaShagalov/my-trello-backend/internal/lists"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgZap "github.com/SlavaShagalov/my-trello-backend/internal/pkg/log/zap"
+	pkgStorages "github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages"
+	pkgDb "github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages/postgres"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"log"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	cardsRepo "github.com/SlavaShagalov/my-trello-backend/internal/cards/repository/postgres"
+	cardsUC "github.com/SlavaShagalov/my-trello-backend/internal/cards/usecase"
+	eventsBus "github.com/SlavaShagalov/my-trello-backend/internal/events/bus/redis"
+	listsRepo "github.com/SlavaShagalov/my-trello-backend/internal/lists/repository/postgres"
+	listsUC "github.com/SlavaShagalov/my-trello-backend/internal/lists/usecase"
+)
+
+const eventWait = 5 * time.Second
+
+type EventsSuite struct {
+	suite.Suite
+	db      *sql.DB
+	rdb     *redis.Client
+	logger  *zap.Logger
+	logfile *os.File
+	bus     pkgEvents.Bus
+	cardsUC pkgCards.Usecase
+	listsUC pkgLists.Usecase
+}
+
+func (s *EventsSuite) SetupSuite() {
+	var err error
+	s.logger, s.logfile, err = pkgZap.NewTestLogger("/logs/events.log")
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+
+	config.SetTestPostgresConfig()
+	s.db, err = pkgDb.NewStd(s.logger)
+	s.Require().NoError(err)
+
+	config.SetTestRedisConfig()
+	s.rdb, err = pkgStorages.NewRedis(s.logger, context.Background())
+	s.Require().NoError(err)
+
+	s.bus = eventsBus.New(s.rdb, context.Background(), s.logger)
+	lRepo := listsRepo.New(s.db, s.logger)
+	s.listsUC = listsUC.New(lRepo, s.bus)
+	s.cardsUC = cardsUC.New(cardsRepo.New(s.db, s.logger), lRepo, s.bus)
+}
+
+func (s *EventsSuite) TearDownSuite() {
+	err := s.db.Close()
+	s.Require().NoError(err)
+
+	err = s.rdb.Close()
+	s.Require().NoError(err)
+
+	err = s.logger.Sync()
+	if err != nil {
+		log.Println(err)
+	}
+	err = s.logfile.Close()
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+func (s *EventsSuite) receive(events <-chan models.Event) models.Event {
+	select {
+	case event, ok := <-events:
+		s.Require().True(ok, "events channel closed")
+		return event
+	case <-time.After(eventWait):
+		s.FailNow("no event received")
+		return models.Event{}
+	}
+}
+
+func (s *EventsSuite) TestCardEvents() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := s.bus.Subscribe(ctx, 1, "")
+	s.Require().NoError(err)
+
+	card, err := s.cardsUC.Create(&pkgCards.CreateParams{Title: "Events", Content: "Card", ListID: 1})
+	s.Require().NoError(err)
+
+	event := s.receive(events)
+	assert.Equal(s.T(), models.EventCardCreated, event.Type)
+	assert.Equal(s.T(), 1, event.BoardID)
+	var created models.Card
+	s.Require().NoError(json.Unmarshal(event.Data, &created))
+	assert.Equal(s.T(), card.ID, created.ID)
+
+	_, err = s.cardsUC.PartialUpdate(&pkgCards.PartialUpdateParams{ID: card.ID, ListID: 2, UpdateListID: true})
+	s.Require().NoError(err)
+
+	event = s.receive(events)
+	assert.Equal(s.T(), models.EventCardMoved, event.Type)
+
+	err = s.cardsUC.Delete(card.ID)
+	s.Require().NoError(err)
+
+	event = s.receive(events)
+	assert.Equal(s.T(), models.EventCardDeleted, event.Type)
+	assert.JSONEq(s.T(), `{"id": `+strconv.Itoa(card.ID)+`, "list_id": 2}`, string(event.Data))
+}
+
+func (s *EventsSuite) TestListEventsAreScopedToBoard() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	otherBoard, err := s.bus.Subscribe(ctx, 2, "")
+	s.Require().NoError(err)
+	events, err := s.bus.Subscribe(ctx, 1, "")
+	s.Require().NoError(err)
+
+	list, err := s.listsUC.Create(&pkgLists.CreateParams{Title: "Events", BoardID: 1})
+	s.Require().NoError(err)
+
+	event := s.receive(events)
+	assert.Equal(s.T(), models.EventListCreated, event.Type)
+
+	err = s.listsUC.Delete(list.ID)
+	s.Require().NoError(err)
+
+	event = s.receive(events)
+	assert.Equal(s.T(), models.EventListDeleted, event.Type)
+
+	select {
+	case event = <-otherBoard:
+		s.Failf("unexpected event", "board 2 received %s", event.Type)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func (s *EventsSuite) TestResume() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := s.bus.Subscribe(ctx, 3, "")
+	s.Require().NoError(err)
+
+	list, err := s.listsUC.Create(&pkgLists.CreateParams{Title: "Resume", BoardID: 3})
+	s.Require().NoError(err)
+	created := s.receive(events)
+	s.Require().NotEmpty(created.ID)
+	cancel()
+
+	// Missed while disconnected.
+	err = s.listsUC.Delete(list.ID)
+	s.Require().NoError(err)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err = s.bus.Subscribe(ctx, 3, created.ID)
+	s.Require().NoError(err)
+
+	event := s.receive(events)
+	assert.Equal(s.T(), models.EventListDeleted, event.Type)
+	assert.Equal(s.T(), 3, event.BoardID)
+	assert.NotEmpty(s.T(), event.ID)
+	assert.NotEqual(s.T(), created.ID, event.ID)
+}
+
+func (s *EventsSuite) TestResumeAfterTrimmedEvent() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := s.bus.Subscribe(ctx, 3, "1-0")
+	s.Require().NoError(err)
+
+	event := s.receive(events)
+	assert.Equal(s.T(), models.EventBoardResync, event.Type)
+	assert.Equal(s.T(), 3, event.BoardID)
+	assert.Empty(s.T(), event.ID)
+}
+
+func TestEventsSuite(t *testing.T) {
+	suite.Run(t, new(EventsSuite))
+}
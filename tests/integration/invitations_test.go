@@ -0,0 +1,202 @@
+package integration
+
+import (
+	"database/sql"
+	pkgInvitations "github.com/SlavaShagalov/my-trello-backend/internal/invitations"
+	pkgMembers "github.com/SlavaShagalov/my-trello-backend/internal/members"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pkgZap "github.com/SlavaShagalov/my-trello-backend/internal/pkg/log/zap"
+	pkgDb "github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"log"
+	"os"
+	"testing"
+
+	invitationsRepo "github.com/SlavaShagalov/my-trello-backend/internal/invitations/repository/postgres"
+	invitationsSender "github.com/SlavaShagalov/my-trello-backend/internal/invitations/sender/logger"
+	invitationsUC "github.com/SlavaShagalov/my-trello-backend/internal/invitations/usecase"
+	membersRepo "github.com/SlavaShagalov/my-trello-backend/internal/members/repository/postgres"
+	membersUC "github.com/SlavaShagalov/my-trello-backend/internal/members/usecase"
+	usersRepo "github.com/SlavaShagalov/my-trello-backend/internal/users/repository/postgres"
+)
+
+type InvitationsSuite struct {
+	suite.Suite
+	db        *sql.DB
+	logger    *zap.Logger
+	logfile   *os.File
+	uc        pkgInvitations.Usecase
+	membersUC pkgMembers.Usecase
+}
+
+func (s *InvitationsSuite) SetupSuite() {
+	var err error
+	s.logger, s.logfile, err = pkgZap.NewTestLogger("/logs/invitations.log")
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+
+	config.SetTestPostgresConfig()
+	s.db, err = pkgDb.NewStd(s.logger)
+	s.Require().NoError(err)
+
+	s.membersUC = membersUC.New(membersRepo.New(s.db, s.logger))
+	s.uc = invitationsUC.New(
+		invitationsRepo.New(s.db, s.logger),
+		invitationsSender.New(s.logger),
+		usersRepo.New(s.db, s.logger),
+	)
+}
+
+func (s *InvitationsSuite) TearDownSuite() {
+	err := s.db.Close()
+	s.Require().NoError(err)
+
+	err = s.logger.Sync()
+	if err != nil {
+		log.Println(err)
+	}
+	err = s.logfile.Close()
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+func (s *InvitationsSuite) TestAccept() {
+	invitation, err := s.uc.Create(&pkgInvitations.InviteParams{
+		WorkspaceID: 2,
+		InviterID:   2,
+		Invitee:     "petya@vk.com",
+		Role:        models.RoleMember,
+	})
+	s.Require().NoError(err)
+	assert.Equal(s.T(), models.InvitationPending, invitation.Status)
+	assert.NotEmpty(s.T(), invitation.Token)
+
+	pending, err := s.uc.ListPending(2)
+	assert.NoError(s.T(), err, "unexpected error")
+	assert.Contains(s.T(), pending, invitation)
+
+	_, err = s.uc.Accept(4, invitation.Token)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrInvitationNotForUser)
+
+	member, err := s.uc.Accept(3, invitation.Token)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), 2, member.WorkspaceID)
+	assert.Equal(s.T(), models.RoleMember, member.Role)
+
+	_, err = s.uc.Accept(3, invitation.Token)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrInvitationNotPending)
+
+	err = s.membersUC.Remove(2, 3)
+	assert.NoError(s.T(), err, "unexpected error")
+}
+
+func (s *InvitationsSuite) TestAcceptByMember() {
+	// Kirill owns workspace 2 already.
+	invitation, err := s.uc.Create(&pkgInvitations.InviteParams{
+		WorkspaceID: 2,
+		InviterID:   2,
+		Invitee:     "kirill",
+		Role:        models.RoleMember,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.uc.Accept(2, invitation.Token)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrMemberAlreadyExists)
+
+	pending, err := s.uc.ListPending(2)
+	assert.NoError(s.T(), err, "unexpected error")
+	assert.Contains(s.T(), pending, invitation, "invitation answered by a failed accept")
+
+	member, err := s.membersUC.Get(2, 2)
+	assert.NoError(s.T(), err, "unexpected error")
+	assert.Equal(s.T(), models.RoleOwner, member.Role)
+
+	err = s.uc.Revoke(2, invitation.ID)
+	assert.NoError(s.T(), err, "unexpected error")
+}
+
+func (s *InvitationsSuite) TestDecline() {
+	invitation, err := s.uc.Create(&pkgInvitations.InviteParams{
+		WorkspaceID: 2,
+		InviterID:   2,
+		Invitee:     "evgenii",
+		Role:        models.RoleObserver,
+	})
+	s.Require().NoError(err)
+
+	err = s.uc.Decline(4, invitation.Token)
+	assert.NoError(s.T(), err, "unexpected error")
+
+	_, err = s.membersUC.Get(2, 4)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrMemberNotFound)
+
+	err = s.uc.Revoke(2, invitation.ID)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrInvitationNotPending)
+}
+
+func (s *InvitationsSuite) TestRevoke() {
+	invitation, err := s.uc.Create(&pkgInvitations.InviteParams{
+		WorkspaceID: 2,
+		InviterID:   2,
+		Invitee:     "slava",
+		Role:        models.RoleAdmin,
+	})
+	s.Require().NoError(err)
+
+	err = s.uc.Revoke(1, invitation.ID)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrInvitationNotFound)
+
+	err = s.uc.Revoke(2, invitation.ID)
+	assert.NoError(s.T(), err, "unexpected error")
+
+	_, err = s.uc.Accept(1, invitation.Token)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrInvitationNotPending)
+}
+
+func (s *InvitationsSuite) TestCreateErrors() {
+	type testCase struct {
+		params pkgInvitations.InviteParams
+		err    error
+	}
+
+	tests := map[string]testCase{
+		"workspace not found": {
+			params: pkgInvitations.InviteParams{WorkspaceID: 999, InviterID: 1, Invitee: "kirill",
+				Role: models.RoleMember},
+			err: pkgErrors.ErrWorkspaceNotFound,
+		},
+		"empty invitee": {
+			params: pkgInvitations.InviteParams{WorkspaceID: 1, InviterID: 1, Invitee: "",
+				Role: models.RoleMember},
+			err: pkgErrors.ErrEmptyInvitee,
+		},
+		"invalid role": {
+			params: pkgInvitations.InviteParams{WorkspaceID: 1, InviterID: 1, Invitee: "kirill",
+				Role: models.RoleOwner},
+			err: pkgErrors.ErrInvalidRole,
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			_, err := s.uc.Create(&test.params)
+			assert.ErrorIs(s.T(), err, test.err, "unexpected error")
+		})
+	}
+}
+
+func (s *InvitationsSuite) TestUnknownToken() {
+	_, err := s.uc.Accept(1, "unknown")
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrInvitationNotFound)
+}
+
+func TestInvitationsSuite(t *testing.T) {
+	suite.Run(t, new(InvitationsSuite))
+}
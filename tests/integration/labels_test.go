@@ -0,0 +1,143 @@
+package integration
+
+import (
+	"database/sql"
+	pkgCards "github.com/SlavaShagalov/my-trello-backend/internal/cards"
+	pkgLabels "github.com/SlavaShagalov/my-trello-backend/internal/labels"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pkgZap "github.com/SlavaShagalov/my-trello-backend/internal/pkg/log/zap"
+	pkgDb "github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"log"
+	"os"
+	"testing"
+
+	cardsRepo "github.com/SlavaShagalov/my-trello-backend/internal/cards/repository/postgres"
+	labelsRepo "github.com/SlavaShagalov/my-trello-backend/internal/labels/repository/postgres"
+	labelsUC "github.com/SlavaShagalov/my-trello-backend/internal/labels/usecase"
+)
+
+type LabelsSuite struct {
+	suite.Suite
+	db        *sql.DB
+	logger    *zap.Logger
+	logfile   *os.File
+	uc        pkgLabels.Usecase
+	cardsRepo pkgCards.Repository
+}
+
+func (s *LabelsSuite) SetupSuite() {
+	var err error
+	s.logger, s.logfile, err = pkgZap.NewTestLogger("/logs/labels.log")
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+
+	config.SetTestPostgresConfig()
+	s.db, err = pkgDb.NewStd(s.logger)
+	s.Require().NoError(err)
+
+	s.uc = labelsUC.New(labelsRepo.New(s.db, s.logger))
+	s.cardsRepo = cardsRepo.New(s.db, s.logger)
+}
+
+func (s *LabelsSuite) TearDownSuite() {
+	err := s.db.Close()
+	s.Require().NoError(err)
+
+	err = s.logger.Sync()
+	if err != nil {
+		log.Println(err)
+	}
+	err = s.logfile.Close()
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+func (s *LabelsSuite) TestAttachAndDelete() {
+	bug, err := s.uc.Create(&pkgLabels.CreateParams{BoardID: 1, Name: "bug", Color: "#EB5A46"})
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "#eb5a46", bug.Color)
+
+	feature, err := s.uc.Create(&pkgLabels.CreateParams{BoardID: 1, Name: "feature", Color: "#61bd4f"})
+	s.Require().NoError(err)
+	defer func() {
+		assert.NoError(s.T(), s.uc.Delete(1, feature.ID))
+	}()
+
+	palette, err := s.uc.ListByBoard(1)
+	s.Require().NoError(err)
+	assert.Contains(s.T(), palette, bug)
+	assert.Contains(s.T(), palette, feature)
+
+	_, err = s.uc.Attach(1, bug.ID)
+	s.Require().NoError(err)
+	_, err = s.uc.Attach(2, bug.ID)
+	s.Require().NoError(err)
+	labels, err := s.uc.Attach(1, feature.ID)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), []models.Label{bug, feature}, labels)
+
+	// Attaching twice changes nothing.
+	labels, err = s.uc.Attach(1, feature.ID)
+	s.Require().NoError(err)
+	assert.Len(s.T(), labels, 2)
+
+	cards, err := s.cardsRepo.ListByList(1, nil)
+	s.Require().NoError(err)
+	for _, card := range cards {
+		switch card.ID {
+		case 1:
+			assert.Equal(s.T(), []models.Label{bug, feature}, card.Labels)
+		case 2:
+			assert.Equal(s.T(), []models.Label{bug}, card.Labels)
+		default:
+			assert.Empty(s.T(), card.Labels)
+		}
+	}
+
+	err = s.uc.Delete(1, bug.ID)
+	s.Require().NoError(err)
+
+	labels, err = s.uc.ListByCard(1)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), []models.Label{feature}, labels)
+	labels, err = s.uc.ListByCard(2)
+	s.Require().NoError(err)
+	assert.Empty(s.T(), labels)
+
+	labels, err = s.uc.Detach(1, feature.ID)
+	s.Require().NoError(err)
+	assert.Empty(s.T(), labels)
+
+	_, err = s.uc.Detach(1, feature.ID)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrLabelNotAttached)
+}
+
+func (s *LabelsSuite) TestAttachFromAnotherBoard() {
+	label, err := s.uc.Create(&pkgLabels.CreateParams{BoardID: 2, Name: "design", Color: "#c377e0"})
+	s.Require().NoError(err)
+	defer func() {
+		assert.NoError(s.T(), s.uc.Delete(2, label.ID))
+	}()
+
+	// Card 1 is on board 1.
+	_, err = s.uc.Attach(1, label.ID)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrLabelNotOnBoard)
+
+	err = s.uc.Delete(1, label.ID)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrLabelNotFound)
+
+	_, err = s.uc.Attach(1, 999999)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrLabelNotFound)
+}
+
+func TestLabelsSuite(t *testing.T) {
+	suite.Run(t, new(LabelsSuite))
+}
@@ -1,13 +1,19 @@
 package integration
 
 import (
+	"context"
 	"database/sql"
+	pkgCards "github.com/SlavaShagalov/my-trello-backend/internal/cards"
+	pkgChecklists "github.com/SlavaShagalov/my-trello-backend/internal/checklists"
+	pkgLabels "github.com/SlavaShagalov/my-trello-backend/internal/labels"
 	pkgLists "github.com/SlavaShagalov/my-trello-backend/internal/lists"
 	"github.com/SlavaShagalov/my-trello-backend/internal/models"
 	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
 	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
 	pkgZap "github.com/SlavaShagalov/my-trello-backend/internal/pkg/log/zap"
+	pkgStorages "github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages"
 	pkgDb "github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages/postgres"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -15,7 +21,12 @@ import (
 	"log"
 	"os"
 	"testing"
+	"time"
 
+	cardsRepo "github.com/SlavaShagalov/my-trello-backend/internal/cards/repository/postgres"
+	checklistsRepo "github.com/SlavaShagalov/my-trello-backend/internal/checklists/repository/postgres"
+	eventsBus "github.com/SlavaShagalov/my-trello-backend/internal/events/bus/redis"
+	labelsRepo "github.com/SlavaShagalov/my-trello-backend/internal/labels/repository/postgres"
 	listsRepo "github.com/SlavaShagalov/my-trello-backend/internal/lists/repository/postgres"
 	listsUC "github.com/SlavaShagalov/my-trello-backend/internal/lists/usecase"
 )
@@ -23,6 +34,7 @@ import (
 type ListsSuite struct {
 	suite.Suite
 	db      *sql.DB
+	rdb     *redis.Client
 	logger  *zap.Logger
 	logfile *os.File
 	uc      pkgLists.Usecase
@@ -40,14 +52,22 @@ func (s *ListsSuite) SetupSuite() {
 	s.db, err = pkgDb.NewStd(s.logger)
 	s.Require().NoError(err)
 
+	config.SetTestRedisConfig()
+	s.rdb, err = pkgStorages.NewRedis(s.logger, context.Background())
+	s.Require().NoError(err)
+
 	repo := listsRepo.New(s.db, s.logger)
-	s.uc = listsUC.New(repo)
+	bus := eventsBus.New(s.rdb, context.Background(), s.logger)
+	s.uc = listsUC.New(repo, bus)
 }
 
 func (s *ListsSuite) TearDownSuite() {
 	err := s.db.Close()
 	s.Require().NoError(err)
 
+	err = s.rdb.Close()
+	s.Require().NoError(err)
+
 	err = s.logger.Sync()
 	if err != nil {
 		log.Println(err)
@@ -144,7 +164,7 @@ func (s *ListsSuite) TestList() {
 
 	for name, test := range tests {
 		s.Run(name, func() {
-			lists, err := s.uc.ListByBoard(test.boardID)
+			lists, err := s.uc.ListByBoard(test.boardID, false)
 
 			assert.ErrorIs(s.T(), err, test.err, "unexpected error")
 
@@ -209,8 +229,7 @@ func (s *ListsSuite) TestFullUpdate() {
 	tests := map[string]testCase{
 		"normal": {
 			params: &pkgLists.FullUpdateParams{
-				Title:   "MathStat",
-				BoardID: 3,
+				Title: "MathStat",
 			},
 			err: nil,
 		},
@@ -232,7 +251,7 @@ func (s *ListsSuite) TestFullUpdate() {
 				// check updated list
 				assert.Equal(s.T(), test.params.ID, list.ID, "incorrect ID")
 				assert.Equal(s.T(), test.params.Title, list.Title, "incorrect Title")
-				assert.Equal(s.T(), test.params.BoardID, list.BoardID, "incorrect BoardID")
+				assert.Equal(s.T(), tempList.BoardID, list.BoardID, "incorrect BoardID")
 
 				// check list in storages
 				getList, err := s.uc.Get(list.ID)
@@ -351,6 +370,238 @@ func (s *ListsSuite) TestDelete() {
 	}
 }
 
+func (s *ListsSuite) TestArchive() {
+	var lists []models.List
+	for _, title := range []string{"First", "Second", "Third"} {
+		list, err := s.uc.Create(&pkgLists.CreateParams{Title: title, BoardID: 11})
+		s.Require().NoError(err)
+		defer func() { _ = s.uc.Delete(list.ID) }()
+		lists = append(lists, list)
+	}
+	second, third := lists[1], lists[2]
+
+	archived, err := s.uc.Archive(second.ID, 1)
+	s.Require().NoError(err)
+	s.Require().NotNil(archived.ArchivedAt)
+
+	active, err := s.uc.ListByBoard(11, false)
+	s.Require().NoError(err)
+	s.Require().Len(active, 2)
+	assert.Equal(s.T(), third.ID, active[1].ID)
+	assert.Equal(s.T(), second.Position, active[1].Position, "following list not moved up")
+
+	inArchive, err := s.uc.ListByBoard(11, true)
+	s.Require().NoError(err)
+	s.Require().Len(inArchive, 1)
+	assert.Equal(s.T(), second.ID, inArchive[0].ID)
+
+	restored, err := s.uc.Unarchive(second.ID)
+	s.Require().NoError(err)
+	assert.Nil(s.T(), restored.ArchivedAt)
+	assert.Equal(s.T(), second.Position, restored.Position, "list not restored at its position")
+
+	active, err = s.uc.ListByBoard(11, false)
+	s.Require().NoError(err)
+	s.Require().Len(active, 3)
+	assert.Equal(s.T(), third.ID, active[2].ID)
+	assert.Equal(s.T(), third.Position, active[2].Position)
+
+	_, err = s.uc.Unarchive(999)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrListNotFound)
+}
+
+func (s *ListsSuite) TestListByTitle() {
+	// Board 1 is in workspace 1, user 2 is no member of it.
+	list, err := s.uc.Create(&pkgLists.CreateParams{Title: "Searchable needle", BoardID: 1})
+	s.Require().NoError(err)
+	defer func() { _ = s.uc.Delete(list.ID) }()
+
+	found, err := s.uc.ListByTitle("NEEDLE", 1)
+	s.Require().NoError(err)
+	s.Require().Len(found, 1)
+	assert.Equal(s.T(), list.ID, found[0].ID)
+
+	found, err = s.uc.ListByTitle("needle", 2)
+	s.Require().NoError(err)
+	assert.Empty(s.T(), found, "list of another workspace found")
+}
+
+func (s *ListsSuite) TestMove() {
+	// Boards 10 and 12 are in workspace 4, board 1 is in workspace 1.
+	var lists []models.List
+	for _, title := range []string{"First", "Second", "Third"} {
+		list, err := s.uc.Create(&pkgLists.CreateParams{Title: title, BoardID: 12})
+		s.Require().NoError(err)
+		defer func() { _ = s.uc.Delete(list.ID) }()
+		lists = append(lists, list)
+	}
+	first, second, third := lists[0], lists[1], lists[2]
+
+	moved, err := s.uc.Move(&pkgLists.MoveParams{ID: third.ID, BoardID: 12, BeforeID: first.ID, AfterID: second.ID})
+	s.Require().NoError(err)
+	assert.Equal(s.T(), first.Position+1, moved.Position)
+
+	active, err := s.uc.ListByBoard(12, false)
+	s.Require().NoError(err)
+	for i, list := range active {
+		s.Require().Equal(i+1, list.Position)
+	}
+	assert.Equal(s.T(), third.ID, active[second.Position-1].ID)
+	assert.Equal(s.T(), second.ID, active[third.Position-1].ID)
+
+	moved, err = s.uc.Move(&pkgLists.MoveParams{ID: first.ID, BoardID: 10, Position: 1})
+	s.Require().NoError(err)
+	assert.Equal(s.T(), 10, moved.BoardID)
+	assert.Equal(s.T(), 1, moved.Position)
+
+	moved, err = s.uc.Move(&pkgLists.MoveParams{ID: first.ID, BoardID: 12, Position: pkgLists.LastPosition})
+	s.Require().NoError(err)
+	assert.Equal(s.T(), third.Position, moved.Position, "list not moved last")
+
+	_, err = s.uc.Move(&pkgLists.MoveParams{ID: first.ID, BoardID: 12, BeforeID: second.ID, AfterID: third.ID})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrBadListNeighbors)
+
+	_, err = s.uc.Move(&pkgLists.MoveParams{ID: first.ID, BoardID: 1, Position: 1})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrListMoveOtherWorkspace)
+
+	_, err = s.uc.Move(&pkgLists.MoveParams{ID: first.ID, BoardID: 999, Position: 1})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrBoardNotFound)
+
+	_, err = s.uc.Archive(first.ID, 1)
+	s.Require().NoError(err)
+	_, err = s.uc.Move(&pkgLists.MoveParams{ID: first.ID, BoardID: 12, Position: 1})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrListArchivedMove)
+
+	_, err = s.uc.Move(&pkgLists.MoveParams{ID: 999, BoardID: 12, Position: 1})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrListNotFound)
+}
+
+func (s *ListsSuite) TestMoveLabels() {
+	// Boards 10 and 12 are in workspace 4.
+	cards := cardsRepo.New(s.db, s.logger)
+	labels := labelsRepo.New(s.db, s.logger)
+
+	list, err := s.uc.Create(&pkgLists.CreateParams{Title: "Labeled", BoardID: 12})
+	s.Require().NoError(err)
+	defer func() { _ = s.uc.Delete(list.ID) }()
+
+	urgent, err := labels.Create(&pkgLabels.CreateParams{BoardID: 12, Name: "Urgent", Color: "#eb5a46"})
+	s.Require().NoError(err)
+	defer func() { _ = labels.Delete(urgent.ID) }()
+	later, err := labels.Create(&pkgLabels.CreateParams{BoardID: 12, Name: "Later", Color: "#61bd4f"})
+	s.Require().NoError(err)
+	defer func() { _ = labels.Delete(later.ID) }()
+	target, err := labels.Create(&pkgLabels.CreateParams{BoardID: 10, Name: "Later", Color: "#61bd4f"})
+	s.Require().NoError(err)
+	defer func() { _ = labels.Delete(target.ID) }()
+
+	for _, labelIDs := range [][]int{{urgent.ID, later.ID}, {urgent.ID}, nil} {
+		card, err := cards.Create(&pkgCards.CreateParams{Title: "Card", ListID: list.ID})
+		s.Require().NoError(err)
+		for _, labelID := range labelIDs {
+			s.Require().NoError(labels.Attach(card.ID, labelID))
+		}
+	}
+
+	_, err = s.uc.Move(&pkgLists.MoveParams{ID: list.ID, BoardID: 10, Position: 1})
+	s.Require().NoError(err)
+
+	moved, err := cards.ListByList(list.ID, nil)
+	s.Require().NoError(err)
+	s.Require().Len(moved, 3)
+	var urgentID int
+	for i, card := range moved {
+		for _, label := range card.Labels {
+			assert.Equal(s.T(), 10, label.BoardID, "card %d keeps a label of the old board", i)
+			switch label.Name {
+			case "Later":
+				assert.Equal(s.T(), target.ID, label.ID, "existing label of the board not reused")
+			case "Urgent":
+				if urgentID == 0 {
+					urgentID = label.ID
+					defer func() { _ = labels.Delete(urgentID) }()
+				}
+				assert.Equal(s.T(), urgentID, label.ID, "label created twice")
+			}
+		}
+	}
+	assert.Len(s.T(), moved[0].Labels, 2)
+	assert.Len(s.T(), moved[1].Labels, 1)
+	assert.Empty(s.T(), moved[2].Labels)
+}
+
+func (s *ListsSuite) TestCopy() {
+	// Boards 10 and 12 are in workspace 4.
+	cards := cardsRepo.New(s.db, s.logger)
+	labels := labelsRepo.New(s.db, s.logger)
+	checklists := checklistsRepo.New(s.db, s.logger)
+
+	list, err := s.uc.Create(&pkgLists.CreateParams{Title: "Sprint", BoardID: 12})
+	s.Require().NoError(err)
+	defer func() { _ = s.uc.Delete(list.ID) }()
+
+	var titles []string
+	for _, title := range []string{"Design", "Build", "Ship"} {
+		_, err = cards.Create(&pkgCards.CreateParams{Title: title, ListID: list.ID})
+		s.Require().NoError(err)
+		titles = append(titles, title)
+	}
+	archived, err := cards.Create(&pkgCards.CreateParams{Title: "Dropped", ListID: list.ID})
+	s.Require().NoError(err)
+	now := time.Now()
+	_, err = cards.SetArchived(archived.ID, &now, 1)
+	s.Require().NoError(err)
+
+	srcCards, err := cards.ListByList(list.ID, nil)
+	s.Require().NoError(err)
+	label, err := labels.Create(&pkgLabels.CreateParams{BoardID: 12, Name: "Copied", Color: "#61bd4f"})
+	s.Require().NoError(err)
+	defer func() { _ = labels.Delete(label.ID) }()
+	s.Require().NoError(labels.Attach(srcCards[0].ID, label.ID))
+	checklist, err := checklists.Create(&pkgChecklists.CreateParams{CardID: srcCards[0].ID, Title: "Steps"})
+	s.Require().NoError(err)
+	_, err = checklists.CreateItem(&pkgChecklists.CreateItemParams{ChecklistID: checklist.ID, Title: "Sketch"})
+	s.Require().NoError(err)
+
+	copied, err := s.uc.Copy(&pkgLists.CopyParams{ID: list.ID, BoardID: 10, Position: 1, Checklists: true, Labels: true})
+	s.Require().NoError(err)
+	defer func() { _ = s.uc.Delete(copied.ID) }()
+	assert.NotEqual(s.T(), list.ID, copied.ID)
+	assert.Equal(s.T(), 10, copied.BoardID)
+	assert.Equal(s.T(), 1, copied.Position)
+	assert.Equal(s.T(), list.Title, copied.Title)
+
+	copiedCards, err := cards.ListByList(copied.ID, nil)
+	s.Require().NoError(err)
+	s.Require().Len(copiedCards, len(titles), "archived card copied")
+	for i, card := range copiedCards {
+		assert.Equal(s.T(), titles[i], card.Title)
+	}
+	s.Require().Len(copiedCards[0].Labels, 1)
+	assert.Equal(s.T(), 10, copiedCards[0].Labels[0].BoardID)
+	assert.Equal(s.T(), label.Name, copiedCards[0].Labels[0].Name)
+	defer func() { _ = labels.Delete(copiedCards[0].Labels[0].ID) }()
+	assert.Equal(s.T(), models.ChecklistProgress{Total: 1}, copiedCards[0].ChecklistProgress)
+
+	bare, err := s.uc.Copy(&pkgLists.CopyParams{ID: list.ID, BoardID: 12, Title: "Sprint 2", AfterID: list.ID})
+	s.Require().NoError(err)
+	defer func() { _ = s.uc.Delete(bare.ID) }()
+	assert.Equal(s.T(), "Sprint 2", bare.Title)
+	assert.Equal(s.T(), list.Position, bare.Position)
+
+	bareCards, err := cards.ListByList(bare.ID, nil)
+	s.Require().NoError(err)
+	s.Require().Len(bareCards, len(titles))
+	assert.Empty(s.T(), bareCards[0].Labels)
+	assert.Equal(s.T(), models.ChecklistProgress{}, bareCards[0].ChecklistProgress)
+
+	_, err = s.uc.Copy(&pkgLists.CopyParams{ID: list.ID, BoardID: 1, Position: 1})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrListMoveOtherWorkspace)
+
+	_, err = s.uc.Copy(&pkgLists.CopyParams{ID: 999, BoardID: 12, Position: 1})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrListNotFound)
+}
+
 func TestListSuite(t *testing.T) {
 	suite.Run(t, new(ListsSuite))
 }
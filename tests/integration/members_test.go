@@ -0,0 +1,134 @@
+package integration
+
+import (
+	"database/sql"
+	pkgMembers "github.com/SlavaShagalov/my-trello-backend/internal/members"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pkgZap "github.com/SlavaShagalov/my-trello-backend/internal/pkg/log/zap"
+	pkgDb "github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"log"
+	"os"
+	"testing"
+
+	membersRepo "github.com/SlavaShagalov/my-trello-backend/internal/members/repository/postgres"
+	membersUC "github.com/SlavaShagalov/my-trello-backend/internal/members/usecase"
+)
+
+type MembersSuite struct {
+	suite.Suite
+	db      *sql.DB
+	logger  *zap.Logger
+	logfile *os.File
+	uc      pkgMembers.Usecase
+}
+
+func (s *MembersSuite) SetupSuite() {
+	var err error
+	s.logger, s.logfile, err = pkgZap.NewTestLogger("/logs/members.log")
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+
+	config.SetTestPostgresConfig()
+	s.db, err = pkgDb.NewStd(s.logger)
+	s.Require().NoError(err)
+
+	repo := membersRepo.New(s.db, s.logger)
+	s.uc = membersUC.New(repo)
+}
+
+func (s *MembersSuite) TearDownSuite() {
+	err := s.db.Close()
+	s.Require().NoError(err)
+
+	err = s.logger.Sync()
+	if err != nil {
+		log.Println(err)
+	}
+	err = s.logfile.Close()
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+func (s *MembersSuite) TestList() {
+	members, err := s.uc.List(1)
+	assert.NoError(s.T(), err, "unexpected error")
+
+	roles := make(map[int]string, len(members))
+	for _, member := range members {
+		roles[member.UserID] = member.Role
+	}
+	assert.Equal(s.T(), map[int]string{
+		1: models.RoleOwner,
+		3: models.RoleObserver,
+		4: models.RoleMember,
+	}, roles)
+}
+
+func (s *MembersSuite) TestAddUpdateRemove() {
+	member, err := s.uc.Add(&pkgMembers.CreateParams{WorkspaceID: 2, UserID: 1, Role: models.RoleObserver})
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "slava", member.Username)
+	assert.Equal(s.T(), models.RoleObserver, member.Role)
+
+	_, err = s.uc.Add(&pkgMembers.CreateParams{WorkspaceID: 2, UserID: 1, Role: models.RoleMember})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrMemberAlreadyExists)
+
+	member, err = s.uc.UpdateRole(&pkgMembers.UpdateRoleParams{WorkspaceID: 2, UserID: 1, Role: models.RoleAdmin})
+	assert.NoError(s.T(), err, "unexpected error")
+	assert.Equal(s.T(), models.RoleAdmin, member.Role)
+
+	err = s.uc.Remove(2, 1)
+	assert.NoError(s.T(), err, "unexpected error")
+
+	_, err = s.uc.Get(2, 1)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrMemberNotFound)
+}
+
+func (s *MembersSuite) TestAddErrors() {
+	type testCase struct {
+		params pkgMembers.CreateParams
+		err    error
+	}
+
+	tests := map[string]testCase{
+		"workspace not found": {
+			params: pkgMembers.CreateParams{WorkspaceID: 999, UserID: 1, Role: models.RoleMember},
+			err:    pkgErrors.ErrWorkspaceNotFound,
+		},
+		"user not found": {
+			params: pkgMembers.CreateParams{WorkspaceID: 1, UserID: 999, Role: models.RoleMember},
+			err:    pkgErrors.ErrUserNotFound,
+		},
+		"owner role": {
+			params: pkgMembers.CreateParams{WorkspaceID: 1, UserID: 2, Role: models.RoleOwner},
+			err:    pkgErrors.ErrInvalidRole,
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			_, err := s.uc.Add(&test.params)
+			assert.ErrorIs(s.T(), err, test.err, "unexpected error")
+		})
+	}
+}
+
+func (s *MembersSuite) TestOwnerImmutable() {
+	_, err := s.uc.UpdateRole(&pkgMembers.UpdateRoleParams{WorkspaceID: 1, UserID: 1, Role: models.RoleMember})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrOwnerRoleImmutable)
+
+	err = s.uc.Remove(1, 1)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrOwnerRoleImmutable)
+}
+
+func TestMembersSuite(t *testing.T) {
+	suite.Run(t, new(MembersSuite))
+}
@@ -0,0 +1,118 @@
+package integration
+
+import (
+	"database/sql"
+	pkgCards "github.com/SlavaShagalov/my-trello-backend/internal/cards"
+	pkgLists "github.com/SlavaShagalov/my-trello-backend/internal/lists"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgZap "github.com/SlavaShagalov/my-trello-backend/internal/pkg/log/zap"
+	pkgDb "github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages/postgres"
+	pkgRanks "github.com/SlavaShagalov/my-trello-backend/internal/ranks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	cardsRepo "github.com/SlavaShagalov/my-trello-backend/internal/cards/repository/postgres"
+	listsRepo "github.com/SlavaShagalov/my-trello-backend/internal/lists/repository/postgres"
+	ranksRepo "github.com/SlavaShagalov/my-trello-backend/internal/ranks/repository/postgres"
+)
+
+type RanksSuite struct {
+	suite.Suite
+	db        *sql.DB
+	logger    *zap.Logger
+	logfile   *os.File
+	repo      pkgRanks.Repository
+	listsRepo pkgLists.Repository
+	cardsRepo pkgCards.Repository
+}
+
+func (s *RanksSuite) SetupSuite() {
+	var err error
+	s.logger, s.logfile, err = pkgZap.NewTestLogger("/logs/ranks.log")
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+
+	config.SetTestPostgresConfig()
+	s.db, err = pkgDb.NewStd(s.logger)
+	s.Require().NoError(err)
+
+	s.repo = ranksRepo.New(s.db, s.logger)
+	s.listsRepo = listsRepo.New(s.db, s.logger)
+	s.cardsRepo = cardsRepo.New(s.db, s.logger)
+}
+
+func (s *RanksSuite) TearDownSuite() {
+	err := s.db.Close()
+	s.Require().NoError(err)
+
+	err = s.logger.Sync()
+	if err != nil {
+		log.Println(err)
+	}
+	err = s.logfile.Close()
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+func (s *RanksSuite) TestRebalance() {
+	// Board 11 is in workspace 4.
+	list, err := s.listsRepo.Create(&pkgLists.CreateParams{Title: "Ranks", BoardID: 11})
+	s.Require().NoError(err)
+	defer func() { _ = s.listsRepo.Delete(list.ID) }()
+
+	var cards []models.Card
+	for _, title := range []string{"A", "B", "C", "D"} {
+		card, err := s.cardsRepo.Create(&pkgCards.CreateParams{Title: title, ListID: list.ID})
+		s.Require().NoError(err)
+		cards = append(cards, card)
+	}
+
+	// Archived cards are rebalanced too, so that they are restored in place.
+	now := time.Now().UTC()
+	_, err = s.cardsRepo.SetArchived(cards[3].ID, &now, 1)
+	s.Require().NoError(err)
+
+	// Moving cards right after A again and again halves the gap each time.
+	for i := 0; i < 60; i++ {
+		card := cards[1+i%2]
+		_, err = s.cardsRepo.Move(&pkgCards.MoveParams{ID: card.ID, ListID: list.ID, BeforeID: cards[0].ID})
+		s.Require().NoError(err)
+	}
+	order := func() []int {
+		listed, err := s.cardsRepo.ListByList(list.ID, nil)
+		s.Require().NoError(err)
+		var ids []int
+		for _, card := range listed {
+			ids = append(ids, card.ID)
+		}
+		return ids
+	}
+	before := order()
+
+	container := pkgRanks.Container{Kind: pkgRanks.KindCards, ID: list.ID}
+	crowded, err := s.repo.ListCrowded(10)
+	s.Require().NoError(err)
+	s.Require().Contains(crowded, container)
+
+	count, err := s.repo.Rebalance(container)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), int64(len(cards)), count)
+	assert.Equal(s.T(), before, order(), "rebalancing changed the order")
+
+	crowded, err = s.repo.ListCrowded(10)
+	s.Require().NoError(err)
+	assert.NotContains(s.T(), crowded, container)
+}
+
+func TestRanksSuite(t *testing.T) {
+	suite.Run(t, new(RanksSuite))
+}
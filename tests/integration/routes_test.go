@@ -0,0 +1,197 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	pkgAccess "github.com/SlavaShagalov/my-trello-backend/internal/access"
+	mw "github.com/SlavaShagalov/my-trello-backend/internal/middleware"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgZap "github.com/SlavaShagalov/my-trello-backend/internal/pkg/log/zap"
+	pkgDb "github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages/postgres"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"testing"
+
+	accessRepo "github.com/SlavaShagalov/my-trello-backend/internal/access/repository/postgres"
+	accessUC "github.com/SlavaShagalov/my-trello-backend/internal/access/usecase"
+	assigneesDel "github.com/SlavaShagalov/my-trello-backend/internal/assignees/delivery/http"
+	assigneesMocks "github.com/SlavaShagalov/my-trello-backend/internal/assignees/mocks"
+	attachmentsDel "github.com/SlavaShagalov/my-trello-backend/internal/attachments/delivery/http"
+	attachmentsMocks "github.com/SlavaShagalov/my-trello-backend/internal/attachments/mocks"
+	boardsDel "github.com/SlavaShagalov/my-trello-backend/internal/boards/delivery/http"
+	boardsMocks "github.com/SlavaShagalov/my-trello-backend/internal/boards/mocks"
+	cardsDel "github.com/SlavaShagalov/my-trello-backend/internal/cards/delivery/http"
+	cardsMocks "github.com/SlavaShagalov/my-trello-backend/internal/cards/mocks"
+	checklistsDel "github.com/SlavaShagalov/my-trello-backend/internal/checklists/delivery/http"
+	checklistsMocks "github.com/SlavaShagalov/my-trello-backend/internal/checklists/mocks"
+	commentsDel "github.com/SlavaShagalov/my-trello-backend/internal/comments/delivery/http"
+	commentsMocks "github.com/SlavaShagalov/my-trello-backend/internal/comments/mocks"
+	invitationsDel "github.com/SlavaShagalov/my-trello-backend/internal/invitations/delivery/http"
+	invitationsMocks "github.com/SlavaShagalov/my-trello-backend/internal/invitations/mocks"
+	labelsDel "github.com/SlavaShagalov/my-trello-backend/internal/labels/delivery/http"
+	labelsMocks "github.com/SlavaShagalov/my-trello-backend/internal/labels/mocks"
+	listsDel "github.com/SlavaShagalov/my-trello-backend/internal/lists/delivery/http"
+	listsMocks "github.com/SlavaShagalov/my-trello-backend/internal/lists/mocks"
+	membersDel "github.com/SlavaShagalov/my-trello-backend/internal/members/delivery/http"
+	membersMocks "github.com/SlavaShagalov/my-trello-backend/internal/members/mocks"
+	trashDel "github.com/SlavaShagalov/my-trello-backend/internal/trash/delivery/http"
+	trashMocks "github.com/SlavaShagalov/my-trello-backend/internal/trash/mocks"
+	usersDel "github.com/SlavaShagalov/my-trello-backend/internal/users/delivery/http"
+	usersMocks "github.com/SlavaShagalov/my-trello-backend/internal/users/mocks"
+	webhooksDel "github.com/SlavaShagalov/my-trello-backend/internal/webhooks/delivery/http"
+	webhooksMocks "github.com/SlavaShagalov/my-trello-backend/internal/webhooks/mocks"
+	workspacesDel "github.com/SlavaShagalov/my-trello-backend/internal/workspaces/delivery/http"
+	workspacesMocks "github.com/SlavaShagalov/my-trello-backend/internal/workspaces/mocks"
+)
+
+// testUserHeader carries the id of the user the request is sent by, in place
+// of a session.
+const testUserHeader = "X-Test-User-ID"
+
+// RoutesSuite sends requests through the handlers with the access checks on
+// the test data. Usecases are mocks without expectations: a request that gets
+// past its access check fails the test.
+type RoutesSuite struct {
+	suite.Suite
+	db       *sql.DB
+	logger   *zap.Logger
+	logfile  *os.File
+	accessUC pkgAccess.Usecase
+}
+
+func (s *RoutesSuite) SetupSuite() {
+	var err error
+	s.logger, s.logfile, err = pkgZap.NewTestLogger("/logs/routes.log")
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+
+	config.SetTestPostgresConfig()
+	s.db, err = pkgDb.NewStd(s.logger)
+	s.Require().NoError(err)
+
+	s.accessUC = accessUC.New(accessRepo.New(s.db, s.logger))
+}
+
+func (s *RoutesSuite) TearDownSuite() {
+	err := s.db.Close()
+	s.Require().NoError(err)
+
+	err = s.logger.Sync()
+	if err != nil {
+		log.Println(err)
+	}
+	err = s.logfile.Close()
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+func (s *RoutesSuite) router(ctrl *gomock.Controller) *mux.Router {
+	checkAuth := func(h http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			userID, err := strconv.Atoi(r.Header.Get(testUserHeader))
+			if err != nil {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			h(w, r.WithContext(context.WithValue(r.Context(), mw.ContextUserID, userID)))
+		}
+	}
+	metrics := func(h http.HandlerFunc) http.HandlerFunc {
+		return h
+	}
+
+	boardsUC := boardsMocks.NewMockUsecase(ctrl)
+
+	router := mux.NewRouter()
+	usersDel.RegisterHandlers(router, usersMocks.NewMockUsecase(ctrl), s.accessUC, s.logger, checkAuth, metrics)
+	workspacesDel.RegisterHandlers(router, workspacesMocks.NewMockUsecase(ctrl), boardsUC, s.accessUC, s.logger,
+		checkAuth, metrics)
+	membersDel.RegisterHandlers(router, membersMocks.NewMockUsecase(ctrl), s.accessUC, s.logger, checkAuth, metrics)
+	invitationsDel.RegisterHandlers(router, invitationsMocks.NewMockUsecase(ctrl), s.accessUC, s.logger, checkAuth,
+		metrics)
+	boardsDel.RegisterHandlers(router, boardsUC, s.accessUC, s.logger, checkAuth, metrics)
+	listsDel.RegisterHandlers(router, listsMocks.NewMockUsecase(ctrl), cardsMocks.NewMockUsecase(ctrl), s.accessUC,
+		s.logger, checkAuth, metrics)
+	cardsDel.RegisterHandlers(router, cardsMocks.NewMockUsecase(ctrl), s.accessUC, s.logger, checkAuth, metrics)
+	labelsDel.RegisterHandlers(router, labelsMocks.NewMockUsecase(ctrl), s.accessUC, s.logger, checkAuth, metrics)
+	assigneesDel.RegisterHandlers(router, assigneesMocks.NewMockUsecase(ctrl), s.accessUC, s.logger, checkAuth,
+		metrics)
+	checklistsDel.RegisterHandlers(router, checklistsMocks.NewMockUsecase(ctrl), s.accessUC, s.logger, checkAuth,
+		metrics)
+	commentsDel.RegisterHandlers(router, commentsMocks.NewMockUsecase(ctrl), s.accessUC, s.logger, checkAuth, metrics)
+	attachmentsDel.RegisterHandlers(router, attachmentsMocks.NewMockUsecase(ctrl), s.accessUC, s.logger, checkAuth,
+		metrics)
+	webhooksDel.RegisterHandlers(router, webhooksMocks.NewMockUsecase(ctrl), s.accessUC, s.logger, checkAuth, metrics)
+	trashDel.RegisterHandlers(router, trashMocks.NewMockUsecase(ctrl), s.accessUC, s.logger, checkAuth, metrics)
+	return router
+}
+
+// Test data: workspace 1 (boards 1-3, lists 1-9, cards 1-27) is owned by user 1,
+// user 3 is its observer and user 4 its member; user 2 is no member of it.
+
+func (s *RoutesSuite) TestCrossUserAccess() {
+	type testCase struct {
+		method string
+		path   string
+		userID int
+		body   string
+		status int
+	}
+
+	tests := map[string]testCase{
+		"update other user":       {http.MethodPatch, "/api/v1/users/2", 1, `{"name":"Mallory"}`, http.StatusForbidden},
+		"update other avatar":     {http.MethodPut, "/api/v1/users/2/avatar", 1, "", http.StatusForbidden},
+		"get workspace":           {http.MethodGet, "/api/v1/workspaces/1", 2, "", http.StatusForbidden},
+		"update workspace":        {http.MethodPatch, "/api/v1/workspaces/1", 2, `{"title":"x"}`, http.StatusForbidden},
+		"import into workspace":   {http.MethodPost, "/api/v1/workspaces/1/import", 2, "{}", http.StatusForbidden},
+		"list members":            {http.MethodGet, "/api/v1/workspaces/1/members", 2, "", http.StatusForbidden},
+		"list invitations":        {http.MethodGet, "/api/v1/workspaces/1/invitations", 2, "", http.StatusForbidden},
+		"get board":               {http.MethodGet, "/api/v1/boards/1", 2, "", http.StatusForbidden},
+		"get full board":          {http.MethodGet, "/api/v1/boards/1/full", 2, "", http.StatusForbidden},
+		"export board":            {http.MethodGet, "/api/v1/boards/1/export", 2, "", http.StatusForbidden},
+		"delete board":            {http.MethodDelete, "/api/v1/boards/1", 2, "", http.StatusForbidden},
+		"get list":                {http.MethodGet, "/api/v1/lists/1", 2, "", http.StatusForbidden},
+		"get card":                {http.MethodGet, "/api/v1/cards/1", 2, "", http.StatusForbidden},
+		"observer updates card":   {http.MethodPatch, "/api/v1/cards/1", 3, `{"title":"x"}`, http.StatusForbidden},
+		"card not found":          {http.MethodGet, "/api/v1/cards/999", 2, "", http.StatusNotFound},
+		"list board labels":       {http.MethodGet, "/api/v1/boards/1/labels", 2, "", http.StatusForbidden},
+		"list card assignees":     {http.MethodGet, "/api/v1/cards/1/assignees", 2, "", http.StatusForbidden},
+		"list card checklists":    {http.MethodGet, "/api/v1/cards/1/checklists", 2, "", http.StatusForbidden},
+		"list card comments":      {http.MethodGet, "/api/v1/cards/1/comments", 2, "", http.StatusForbidden},
+		"list card attachments":   {http.MethodGet, "/api/v1/cards/1/attachments", 2, "", http.StatusForbidden},
+		"download attachment":     {http.MethodGet, "/api/v1/cards/1/attachments/1/download", 2, "", http.StatusForbidden},
+		"list workspace webhooks": {http.MethodGet, "/api/v1/workspaces/1/webhooks", 2, "", http.StatusForbidden},
+		"list workspace trash":    {http.MethodGet, "/api/v1/workspaces/1/trash", 2, "", http.StatusForbidden},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			ctrl := gomock.NewController(s.T())
+			defer ctrl.Finish()
+			router := s.router(ctrl)
+
+			r := httptest.NewRequest(test.method, test.path, bytes.NewBufferString(test.body))
+			r.Header.Set(testUserHeader, strconv.Itoa(test.userID))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, r)
+
+			assert.Equal(s.T(), test.status, w.Code, w.Body.String())
+		})
+	}
+}
+
+func TestRoutesSuite(t *testing.T) {
+	suite.Run(t, new(RoutesSuite))
+}
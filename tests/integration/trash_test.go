@@ -0,0 +1,183 @@
+package integration
+
+import (
+	"context"
+	"database/sql"
+	pkgAttachments "github.com/SlavaShagalov/my-trello-backend/internal/attachments"
+	pkgCards "github.com/SlavaShagalov/my-trello-backend/internal/cards"
+	pkgLists "github.com/SlavaShagalov/my-trello-backend/internal/lists"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pkgZap "github.com/SlavaShagalov/my-trello-backend/internal/pkg/log/zap"
+	pkgStorages "github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages"
+	pkgDb "github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages/postgres"
+	pkgTrash "github.com/SlavaShagalov/my-trello-backend/internal/trash"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	attachmentsRepo "github.com/SlavaShagalov/my-trello-backend/internal/attachments/repository/postgres"
+	boardsRepo "github.com/SlavaShagalov/my-trello-backend/internal/boards/repository/std"
+	cardsRepo "github.com/SlavaShagalov/my-trello-backend/internal/cards/repository/postgres"
+	eventsBus "github.com/SlavaShagalov/my-trello-backend/internal/events/bus/redis"
+	listsRepo "github.com/SlavaShagalov/my-trello-backend/internal/lists/repository/postgres"
+	listsUC "github.com/SlavaShagalov/my-trello-backend/internal/lists/usecase"
+	trashRepo "github.com/SlavaShagalov/my-trello-backend/internal/trash/repository/postgres"
+)
+
+type TrashSuite struct {
+	suite.Suite
+	db      *sql.DB
+	rdb     *redis.Client
+	logger  *zap.Logger
+	logfile *os.File
+	repo    pkgTrash.Repository
+	listsUC pkgLists.Usecase
+}
+
+func (s *TrashSuite) SetupSuite() {
+	var err error
+	s.logger, s.logfile, err = pkgZap.NewTestLogger("/logs/trash.log")
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+
+	config.SetTestPostgresConfig()
+	s.db, err = pkgDb.NewStd(s.logger)
+	s.Require().NoError(err)
+
+	config.SetTestRedisConfig()
+	s.rdb, err = pkgStorages.NewRedis(s.logger, context.Background())
+	s.Require().NoError(err)
+
+	s.repo = trashRepo.New(s.db, s.logger)
+	bus := eventsBus.New(s.rdb, context.Background(), s.logger)
+	s.listsUC = listsUC.New(listsRepo.New(s.db, s.logger), bus)
+}
+
+func (s *TrashSuite) TearDownSuite() {
+	err := s.db.Close()
+	s.Require().NoError(err)
+
+	err = s.rdb.Close()
+	s.Require().NoError(err)
+
+	err = s.logger.Sync()
+	if err != nil {
+		log.Println(err)
+	}
+	err = s.logfile.Close()
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+func (s *TrashSuite) TestListAndPurge() {
+	// Board 11 is in workspace 4.
+	list, err := s.listsUC.Create(&pkgLists.CreateParams{Title: "Trash", BoardID: 11})
+	s.Require().NoError(err)
+	defer func() { _ = s.listsUC.Delete(list.ID) }()
+
+	card, err := cardsRepo.New(s.db, s.logger).Create(&pkgCards.CreateParams{Title: "Attached", ListID: list.ID})
+	s.Require().NoError(err)
+	attachment, err := attachmentsRepo.New(s.db, s.logger).Create(&pkgAttachments.CreateParams{
+		CardID:      card.ID,
+		UploaderID:  1,
+		Filename:    "notes.txt",
+		Size:        5,
+		ContentType: "text/plain",
+		Key:         "attachments/trash-test",
+	})
+	s.Require().NoError(err)
+
+	archived, err := s.listsUC.Archive(list.ID, 1)
+	s.Require().NoError(err)
+
+	items, err := s.repo.ListByWorkspace(4)
+	s.Require().NoError(err)
+	var item *models.TrashItem
+	for i := range items {
+		if items[i].Type == models.TrashItemList && items[i].ID == list.ID {
+			item = &items[i]
+		}
+	}
+	s.Require().NotNil(item, "archived list not in trash")
+	assert.Equal(s.T(), 11, item.BoardID)
+	assert.Equal(s.T(), 1, *item.ArchivedBy)
+	assert.Equal(s.T(), "slava", *item.ArchivedByUsername)
+
+	items, err = s.repo.ListByWorkspace(1)
+	s.Require().NoError(err)
+	for _, item := range items {
+		assert.NotEqual(s.T(), list.ID, item.ID, "list in trash of another workspace")
+	}
+
+	report, err := s.repo.Purge(archived.ArchivedAt.Add(-time.Second))
+	s.Require().NoError(err)
+	assert.Zero(s.T(), report.Lists, "list purged before retention")
+	assert.NotContains(s.T(), report.Attachments, attachment.Key)
+
+	report, err = s.repo.Purge(archived.ArchivedAt.Add(time.Second))
+	s.Require().NoError(err)
+	assert.GreaterOrEqual(s.T(), report.Lists, int64(1))
+	assert.Contains(s.T(), report.Attachments, attachment.Key, "key of the purged attachment not returned")
+	_, err = s.listsUC.Get(list.ID)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrListNotFound, "list not purged")
+}
+
+func (s *TrashSuite) TestRestore() {
+	// Board 11 is in workspace 4.
+	boards := boardsRepo.New(s.db, s.logger)
+	cards := cardsRepo.New(s.db, s.logger)
+	list, err := s.listsUC.Create(&pkgLists.CreateParams{Title: "Restored", BoardID: 11})
+	s.Require().NoError(err)
+	defer func() { _ = s.listsUC.Delete(list.ID) }()
+	card, err := cards.Create(&pkgCards.CreateParams{Title: "Restored", ListID: list.ID})
+	s.Require().NoError(err)
+
+	now := time.Now().UTC()
+	_, err = cards.SetArchived(card.ID, &now, 1)
+	s.Require().NoError(err)
+	_, err = s.listsUC.Archive(list.ID, 1)
+	s.Require().NoError(err)
+
+	// Nothing is restored under an archived board.
+	_, err = boards.SetArchived(context.Background(), 11, &now, 1)
+	s.Require().NoError(err)
+	_, err = s.repo.RestoreCard(card.ID)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrBoardArchivedRestore)
+	_, err = s.repo.RestoreList(list.ID)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrBoardArchivedRestore)
+	_, err = boards.SetArchived(context.Background(), 11, nil, 0)
+	s.Require().NoError(err)
+
+	got, err := s.listsUC.Get(list.ID)
+	s.Require().NoError(err)
+	assert.NotNil(s.T(), got.ArchivedAt, "list restored under an archived board")
+
+	restored, err := s.repo.RestoreCard(card.ID)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), pkgTrash.Restored{BoardID: 11, ListID: list.ID, CardID: card.ID, ListRestored: true},
+		restored)
+
+	got, err = s.listsUC.Get(list.ID)
+	s.Require().NoError(err)
+	assert.Nil(s.T(), got.ArchivedAt, "list of the restored card still archived")
+	gotCard, err := cards.Get(card.ID)
+	s.Require().NoError(err)
+	assert.Nil(s.T(), gotCard.ArchivedAt)
+
+	_, err = s.repo.RestoreCard(999999)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrCardNotFound)
+}
+
+func TestTrashSuite(t *testing.T) {
+	suite.Run(t, new(TrashSuite))
+}
@@ -0,0 +1,191 @@
+package integration
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	pkgCards "github.com/SlavaShagalov/my-trello-backend/internal/cards"
+	"github.com/SlavaShagalov/my-trello-backend/internal/models"
+	"github.com/SlavaShagalov/my-trello-backend/internal/pkg/config"
+	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
+	pkgZap "github.com/SlavaShagalov/my-trello-backend/internal/pkg/log/zap"
+	pkgDb "github.com/SlavaShagalov/my-trello-backend/internal/pkg/storages/postgres"
+	pkgWebhooks "github.com/SlavaShagalov/my-trello-backend/internal/webhooks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	cardsRepo "github.com/SlavaShagalov/my-trello-backend/internal/cards/repository/postgres"
+	cardsUC "github.com/SlavaShagalov/my-trello-backend/internal/cards/usecase"
+	webhooksBus "github.com/SlavaShagalov/my-trello-backend/internal/events/bus/webhooks"
+	eventsMocks "github.com/SlavaShagalov/my-trello-backend/internal/events/mocks"
+	listsRepo "github.com/SlavaShagalov/my-trello-backend/internal/lists/repository/postgres"
+	webhooksDispatcher "github.com/SlavaShagalov/my-trello-backend/internal/webhooks/dispatcher"
+	webhooksMocks "github.com/SlavaShagalov/my-trello-backend/internal/webhooks/mocks"
+	webhooksRepo "github.com/SlavaShagalov/my-trello-backend/internal/webhooks/repository/postgres"
+	webhooksUC "github.com/SlavaShagalov/my-trello-backend/internal/webhooks/usecase"
+)
+
+type WebhooksSuite struct {
+	suite.Suite
+	db      *sql.DB
+	logger  *zap.Logger
+	logfile *os.File
+	ctrl    *gomock.Controller
+	repo    pkgWebhooks.Repository
+	uc      pkgWebhooks.Usecase
+	cardsUC pkgCards.Usecase
+}
+
+func (s *WebhooksSuite) SetupSuite() {
+	var err error
+	s.logger, s.logfile, err = pkgZap.NewTestLogger("/logs/webhooks.log")
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+
+	config.SetTestPostgresConfig()
+	s.db, err = pkgDb.NewStd(s.logger)
+	s.Require().NoError(err)
+
+	s.ctrl = gomock.NewController(s.T())
+	next := eventsMocks.NewMockBus(s.ctrl)
+	next.EXPECT().Publish(gomock.Any()).AnyTimes()
+
+	resolver := webhooksMocks.NewMockResolver(s.ctrl)
+	resolver.EXPECT().LookupIPAddr(gomock.Any(), "ci.example.com").
+		Return([]net.IPAddr{{IP: net.ParseIP("93.184.216.34")}}, nil).AnyTimes()
+
+	s.repo = webhooksRepo.New(s.db, s.logger)
+	s.uc = webhooksUC.New(s.repo, resolver)
+	bus := webhooksBus.New(next, s.repo)
+	s.cardsUC = cardsUC.New(cardsRepo.New(s.db, s.logger), listsRepo.New(s.db, s.logger), bus)
+}
+
+func (s *WebhooksSuite) TearDownSuite() {
+	s.ctrl.Finish()
+
+	err := s.db.Close()
+	s.Require().NoError(err)
+
+	err = s.logger.Sync()
+	if err != nil {
+		log.Println(err)
+	}
+	err = s.logfile.Close()
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+func (s *WebhooksSuite) TestDelivery() {
+	const secret = "s3cr3t"
+
+	received := make(chan models.Event, 1)
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !pkgWebhooks.Verify(secret, body, r.Header.Get(pkgWebhooks.SignatureHeader)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var event models.Event
+		_ = json.Unmarshal(body, &event)
+		received <- event
+	}))
+	defer ok.Close()
+
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer broken.Close()
+
+	// The receivers listen on loopback, which the usecase refuses, so the
+	// hooks are stored directly.
+	boardID := 4
+	boardHook, err := s.repo.Create(&pkgWebhooks.CreateParams{
+		BoardID: &boardID,
+		URL:     ok.URL,
+		Secret:  secret,
+		Events:  []string{models.EventCardCreated},
+	})
+	s.Require().NoError(err)
+	defer func() {
+		assert.NoError(s.T(), s.uc.Delete(boardHook.ID))
+	}()
+
+	workspaceID := 2
+	workspaceHook, err := s.repo.Create(&pkgWebhooks.CreateParams{WorkspaceID: &workspaceID, URL: broken.URL,
+		Secret: secret, Events: []string{}})
+	s.Require().NoError(err)
+	defer func() {
+		assert.NoError(s.T(), s.uc.Delete(workspaceHook.ID))
+	}()
+
+	card, err := s.cardsUC.Create(&pkgCards.CreateParams{Title: "Webhooks", Content: "Card", ListID: 10})
+	s.Require().NoError(err)
+	err = s.cardsUC.Delete(card.ID)
+	s.Require().NoError(err)
+
+	dispatcher := webhooksDispatcher.New(s.repo, ok.Client(), s.logger)
+	_, err = dispatcher.Dispatch(context.Background())
+	s.Require().NoError(err)
+
+	select {
+	case event := <-received:
+		assert.Equal(s.T(), models.EventCardCreated, event.Type)
+		assert.Equal(s.T(), boardID, event.BoardID)
+	default:
+		s.FailNow("webhook was not delivered")
+	}
+
+	deliveries, err := s.uc.ListDeliveries(boardHook.ID)
+	s.Require().NoError(err)
+	s.Require().Len(deliveries, 1)
+	assert.Equal(s.T(), models.DeliveryDelivered, deliveries[0].Status)
+	assert.Equal(s.T(), 1, deliveries[0].Attempts)
+	assert.NotNil(s.T(), deliveries[0].DeliveredAt)
+
+	// Both the created and the deleted card events, each waiting for a retry.
+	deliveries, err = s.uc.ListDeliveries(workspaceHook.ID)
+	s.Require().NoError(err)
+	s.Require().Len(deliveries, 2)
+	for _, delivery := range deliveries {
+		assert.Equal(s.T(), models.DeliveryPending, delivery.Status)
+		assert.Equal(s.T(), 1, delivery.Attempts)
+		if assert.NotNil(s.T(), delivery.ResponseCode) {
+			assert.Equal(s.T(), http.StatusServiceUnavailable, *delivery.ResponseCode)
+		}
+		assert.True(s.T(), delivery.NextAttemptAt.After(delivery.UpdatedAt))
+	}
+	assert.Equal(s.T(), models.EventCardDeleted, deliveries[0].EventType)
+}
+
+func (s *WebhooksSuite) TestCreateErrors() {
+	boardID := 999
+	_, err := s.uc.Create(&pkgWebhooks.SubscribeParams{BoardID: &boardID, URL: "https://ci.example.com/hook"})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrBoardNotFound)
+
+	boardID = 4
+	_, err = s.uc.Create(&pkgWebhooks.SubscribeParams{BoardID: &boardID, URL: "http://127.0.0.1:8080/hook"})
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrWebhookURLNotAllowed)
+
+	_, err = s.uc.Get(999)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrWebhookNotFound)
+
+	err = s.uc.Delete(999)
+	assert.ErrorIs(s.T(), err, pkgErrors.ErrWebhookNotFound)
+}
+
+func TestWebhooksSuite(t *testing.T) {
+	suite.Run(t, new(WebhooksSuite))
+}
@@ -9,6 +9,7 @@ import (
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/zap"
+	"reflect"
 	"testing"
 
 	"github.com/ozontech/allure-go/pkg/framework/provider"
@@ -135,7 +136,7 @@ func (s *AuthUsecaseSuite) TestSignIn(t provider.T) {
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if user != test.user {
+			if !reflect.DeepEqual(user, test.user) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.user, user)
 			}
 			if authToken != test.authToken {
@@ -222,7 +223,7 @@ func (s *AuthUsecaseSuite) TestSignUp(t provider.T) {
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if user != test.user {
+			if !reflect.DeepEqual(user, test.user) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.user, user)
 			}
 			if authToken != test.authToken {
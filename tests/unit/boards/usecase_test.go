@@ -103,7 +103,7 @@ func (s *BoardsUsecaseSuite) TestCreate(t provider.T) {
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if board != test.board {
+			if !reflect.DeepEqual(board, test.board) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.board, board)
 			}
 		})
@@ -275,7 +275,7 @@ func (s *BoardsUsecaseSuite) TestGet(t provider.T) {
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if board != test.board {
+			if !reflect.DeepEqual(board, test.board) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.board, board)
 			}
 		})
@@ -339,7 +339,7 @@ func (s *BoardsUsecaseSuite) TestFullUpdate(t provider.T) {
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if board != test.board {
+			if !reflect.DeepEqual(board, test.board) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.board, board)
 			}
 		})
@@ -406,7 +406,7 @@ func (s *BoardsUsecaseSuite) TestPartialUpdate(t provider.T) {
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if board != test.board {
+			if !reflect.DeepEqual(board, test.board) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.board, board)
 			}
 		})
@@ -429,14 +429,19 @@ func (s *BoardsUsecaseSuite) TestDelete(t provider.T) {
 	tests := map[string]testCase{
 		"normal": {
 			prepare: func(f *fields) {
+				key := "backgrounds/old.png"
+				f.repo.EXPECT().Get(f.id).Return(models.Board{ID: f.id, BackgroundKey: &key}, nil)
 				f.repo.EXPECT().Delete(f.id).Return(nil)
+				f.imgRepo.EXPECT().Delete(key).Return(nil)
+				f.imgRepo.EXPECT().Delete("backgrounds/old_small.png").Return(nil)
+				f.imgRepo.EXPECT().Delete("backgrounds/old_medium.png").Return(nil)
 			},
 			id:  21,
 			err: nil,
 		},
 		"board not found": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().Delete(f.id).Return(pkgErrors.ErrBoardNotFound)
+				f.repo.EXPECT().Get(f.id).Return(models.Board{}, pkgErrors.ErrBoardNotFound)
 			},
 			id:  21,
 			err: pkgErrors.ErrBoardNotFound,
@@ -4,6 +4,8 @@ import (
 	pkgCards "github.com/SlavaShagalov/my-trello-backend/internal/cards"
 	"github.com/SlavaShagalov/my-trello-backend/internal/cards/mocks"
 	cardsUsecase "github.com/SlavaShagalov/my-trello-backend/internal/cards/usecase"
+	eventsMocks "github.com/SlavaShagalov/my-trello-backend/internal/events/mocks"
+	listsMocks "github.com/SlavaShagalov/my-trello-backend/internal/lists/mocks"
 	"github.com/SlavaShagalov/my-trello-backend/internal/models"
 	pkgErrors "github.com/SlavaShagalov/my-trello-backend/internal/pkg/errors"
 	"github.com/golang/mock/gomock"
@@ -20,9 +22,11 @@ type CardsUsecaseSuite struct {
 
 func (s *CardsUsecaseSuite) TestCreate(t provider.T) {
 	type fields struct {
-		repo   *mocks.MockRepository
-		params *pkgCards.CreateParams
-		card   *models.Card
+		repo      *mocks.MockRepository
+		listsRepo *listsMocks.MockRepository
+		bus       *eventsMocks.MockBus
+		params    *pkgCards.CreateParams
+		card      *models.Card
 	}
 
 	type testCase struct {
@@ -36,6 +40,8 @@ func (s *CardsUsecaseSuite) TestCreate(t provider.T) {
 		"normal": {
 			prepare: func(f *fields) {
 				f.repo.EXPECT().Create(f.params).Return(*f.card, nil)
+				f.listsRepo.EXPECT().Get(gomock.Any()).Return(models.List{ID: 27, BoardID: 9}, nil)
+				f.bus.EXPECT().Publish(gomock.Any())
 			},
 			params: &pkgCards.CreateParams{
 				Title:   "Lab 1",
@@ -77,17 +83,18 @@ func (s *CardsUsecaseSuite) TestCreate(t provider.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			f := fields{repo: mocks.NewMockRepository(ctrl), params: test.params, card: &test.card}
+			f := fields{repo: mocks.NewMockRepository(ctrl), listsRepo: listsMocks.NewMockRepository(ctrl),
+				bus: eventsMocks.NewMockBus(ctrl), params: test.params, card: &test.card}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := cardsUsecase.New(f.repo)
+			uc := cardsUsecase.New(f.repo, f.listsRepo, f.bus)
 			card, err := uc.Create(test.params)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if card != test.card {
+			if !reflect.DeepEqual(card, test.card) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.card, card)
 			}
 		})
@@ -111,7 +118,7 @@ func (s *CardsUsecaseSuite) TestList(t provider.T) {
 	tests := map[string]testCase{
 		"normal": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().ListByList(f.listID).Return(f.cards, nil)
+				f.repo.EXPECT().ListByList(f.listID, nil).Return(f.cards, nil)
 			},
 			listID: 27,
 			cards: []models.Card{
@@ -123,7 +130,7 @@ func (s *CardsUsecaseSuite) TestList(t provider.T) {
 		},
 		"empty result": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().ListByList(f.listID).Return(f.cards, nil)
+				f.repo.EXPECT().ListByList(f.listID, nil).Return(f.cards, nil)
 			},
 			listID: 27,
 			cards:  []models.Card{},
@@ -131,7 +138,7 @@ func (s *CardsUsecaseSuite) TestList(t provider.T) {
 		},
 		"list not found": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().ListByList(f.listID).Return(f.cards, pkgErrors.ErrListNotFound)
+				f.repo.EXPECT().ListByList(f.listID, nil).Return(f.cards, pkgErrors.ErrListNotFound)
 			},
 			listID: 27,
 			cards:  nil,
@@ -139,7 +146,7 @@ func (s *CardsUsecaseSuite) TestList(t provider.T) {
 		},
 		"storages error": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().ListByList(f.listID).Return(f.cards, pkgErrors.ErrDb)
+				f.repo.EXPECT().ListByList(f.listID, nil).Return(f.cards, pkgErrors.ErrDb)
 			},
 			listID: 27,
 			cards:  nil,
@@ -160,8 +167,8 @@ func (s *CardsUsecaseSuite) TestList(t provider.T) {
 				test.prepare(&f)
 			}
 
-			serv := cardsUsecase.New(f.repo)
-			cards, err := serv.ListByList(test.listID)
+			serv := cardsUsecase.New(f.repo, nil, nil)
+			cards, err := serv.ListByList(test.listID, nil)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
@@ -226,12 +233,12 @@ func (s *CardsUsecaseSuite) TestGet(t provider.T) {
 				test.prepare(&f)
 			}
 
-			uc := cardsUsecase.New(f.repo)
+			uc := cardsUsecase.New(f.repo, nil, nil)
 			card, err := uc.Get(test.id)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if card != test.card {
+			if !reflect.DeepEqual(card, test.card) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.card, card)
 			}
 		})
@@ -240,9 +247,11 @@ func (s *CardsUsecaseSuite) TestGet(t provider.T) {
 
 func (s *CardsUsecaseSuite) TestFullUpdate(t provider.T) {
 	type fields struct {
-		repo   *mocks.MockRepository
-		params *pkgCards.FullUpdateParams
-		card   *models.Card
+		repo      *mocks.MockRepository
+		listsRepo *listsMocks.MockRepository
+		bus       *eventsMocks.MockBus
+		params    *pkgCards.FullUpdateParams
+		card      *models.Card
 	}
 
 	type testCase struct {
@@ -256,13 +265,13 @@ func (s *CardsUsecaseSuite) TestFullUpdate(t provider.T) {
 		"normal": {
 			prepare: func(f *fields) {
 				f.repo.EXPECT().FullUpdate(f.params).Return(*f.card, nil)
+				f.listsRepo.EXPECT().Get(gomock.Any()).Return(models.List{ID: 27, BoardID: 9}, nil)
+				f.bus.EXPECT().Publish(gomock.Any())
 			},
 			params: &pkgCards.FullUpdateParams{
-				ID:       21,
-				Title:    "Lab 1",
-				Content:  "Надо сделать",
-				Position: 41,
-				ListID:   27,
+				ID:      21,
+				Title:   "Lab 1",
+				Content: "Надо сделать",
 			},
 			card: models.Card{ID: 21, ListID: 27, Title: "Lab 1", Content: "Надо сделать", Position: 41},
 			err:  nil,
@@ -277,17 +286,18 @@ func (s *CardsUsecaseSuite) TestFullUpdate(t provider.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			f := fields{repo: mocks.NewMockRepository(ctrl), params: test.params, card: &test.card}
+			f := fields{repo: mocks.NewMockRepository(ctrl), listsRepo: listsMocks.NewMockRepository(ctrl),
+				bus: eventsMocks.NewMockBus(ctrl), params: test.params, card: &test.card}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := cardsUsecase.New(f.repo)
+			uc := cardsUsecase.New(f.repo, f.listsRepo, f.bus)
 			card, err := uc.FullUpdate(test.params)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if card != test.card {
+			if !reflect.DeepEqual(card, test.card) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.card, card)
 			}
 		})
@@ -296,9 +306,11 @@ func (s *CardsUsecaseSuite) TestFullUpdate(t provider.T) {
 
 func (s *CardsUsecaseSuite) TestPartialUpdate(t provider.T) {
 	type fields struct {
-		repo   *mocks.MockRepository
-		params *pkgCards.PartialUpdateParams
-		card   *models.Card
+		repo      *mocks.MockRepository
+		listsRepo *listsMocks.MockRepository
+		bus       *eventsMocks.MockBus
+		params    *pkgCards.PartialUpdateParams
+		card      *models.Card
 	}
 
 	type testCase struct {
@@ -311,7 +323,11 @@ func (s *CardsUsecaseSuite) TestPartialUpdate(t provider.T) {
 	tests := map[string]testCase{
 		"normal": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().PartialUpdate(f.params).Return(*f.card, nil)
+				f.repo.EXPECT().Get(21).Return(models.Card{ID: 21, ListID: 27, Position: 3}, nil)
+				f.repo.EXPECT().Move(&pkgCards.MoveParams{ID: 21, ListID: 27, Position: 41, Update: f.params}).
+					Return(*f.card, nil)
+				f.listsRepo.EXPECT().Get(gomock.Any()).Return(models.List{ID: 27, BoardID: 9}, nil).Times(2)
+				f.bus.EXPECT().Publish(gomock.Any()).Times(2)
 			},
 			params: &pkgCards.PartialUpdateParams{
 				ID:             21,
@@ -343,17 +359,18 @@ func (s *CardsUsecaseSuite) TestPartialUpdate(t provider.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			f := fields{repo: mocks.NewMockRepository(ctrl), params: test.params, card: &test.card}
+			f := fields{repo: mocks.NewMockRepository(ctrl), listsRepo: listsMocks.NewMockRepository(ctrl),
+				bus: eventsMocks.NewMockBus(ctrl), params: test.params, card: &test.card}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := cardsUsecase.New(f.repo)
+			uc := cardsUsecase.New(f.repo, f.listsRepo, f.bus)
 			card, err := uc.PartialUpdate(test.params)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
-			if card != test.card {
+			if !reflect.DeepEqual(card, test.card) {
 				t.Errorf("\nExpected: %v\nGot: %v", test.card, card)
 			}
 		})
@@ -362,8 +379,10 @@ func (s *CardsUsecaseSuite) TestPartialUpdate(t provider.T) {
 
 func (s *CardsUsecaseSuite) TestDelete(t provider.T) {
 	type fields struct {
-		repo *mocks.MockRepository
-		id   int
+		repo      *mocks.MockRepository
+		listsRepo *listsMocks.MockRepository
+		bus       *eventsMocks.MockBus
+		id        int
 	}
 
 	type testCase struct {
@@ -375,14 +394,17 @@ func (s *CardsUsecaseSuite) TestDelete(t provider.T) {
 	tests := map[string]testCase{
 		"normal": {
 			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(f.id).Return(models.Card{ID: 21, ListID: 27}, nil)
 				f.repo.EXPECT().Delete(f.id).Return(nil)
+				f.listsRepo.EXPECT().Get(gomock.Any()).Return(models.List{ID: 27, BoardID: 9}, nil)
+				f.bus.EXPECT().Publish(gomock.Any())
 			},
 			id:  21,
 			err: nil,
 		},
 		"card not found": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().Delete(f.id).Return(pkgErrors.ErrCardNotFound)
+				f.repo.EXPECT().Get(f.id).Return(models.Card{}, pkgErrors.ErrCardNotFound)
 			},
 			id:  21,
 			err: pkgErrors.ErrCardNotFound,
@@ -397,12 +419,13 @@ func (s *CardsUsecaseSuite) TestDelete(t provider.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			f := fields{repo: mocks.NewMockRepository(ctrl), id: test.id}
+			f := fields{repo: mocks.NewMockRepository(ctrl), listsRepo: listsMocks.NewMockRepository(ctrl),
+				bus: eventsMocks.NewMockBus(ctrl), id: test.id}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := cardsUsecase.New(f.repo)
+			uc := cardsUsecase.New(f.repo, f.listsRepo, f.bus)
 			err := uc.Delete(test.id)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
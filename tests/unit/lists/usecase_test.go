@@ -1,6 +1,7 @@
 package lists
 
 import (
+	eventsMocks "github.com/SlavaShagalov/my-trello-backend/internal/events/mocks"
 	pkgLists "github.com/SlavaShagalov/my-trello-backend/internal/lists"
 	"github.com/SlavaShagalov/my-trello-backend/internal/lists/mocks"
 	listsUsecase "github.com/SlavaShagalov/my-trello-backend/internal/lists/usecase"
@@ -30,6 +31,7 @@ func (s *ListsUsecaseSuite) BeforeEach(t provider.T) {
 func (s *ListsUsecaseSuite) TestCreate(t provider.T) {
 	type fields struct {
 		repo   *mocks.MockRepository
+		bus    *eventsMocks.MockBus
 		params *pkgLists.CreateParams
 		list   *models.List
 	}
@@ -45,6 +47,7 @@ func (s *ListsUsecaseSuite) TestCreate(t provider.T) {
 		"normal": {
 			prepare: func(f *fields) {
 				f.repo.EXPECT().Create(f.params).Return(*f.list, nil)
+				f.bus.EXPECT().Publish(gomock.Any())
 			},
 			params: &pkgLists.CreateParams{
 				Title:   "MathStat",
@@ -84,12 +87,12 @@ func (s *ListsUsecaseSuite) TestCreate(t provider.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			f := fields{repo: mocks.NewMockRepository(ctrl), params: test.params, list: &test.list}
+			f := fields{repo: mocks.NewMockRepository(ctrl), bus: eventsMocks.NewMockBus(ctrl), params: test.params, list: &test.list}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := listsUsecase.New(f.repo)
+			uc := listsUsecase.New(f.repo, f.bus)
 			list, err := uc.Create(test.params)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
@@ -118,7 +121,7 @@ func (s *ListsUsecaseSuite) TestList(t provider.T) {
 	tests := map[string]testCase{
 		"normal": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().ListByBoard(f.boardID).Return(f.lists, nil)
+				f.repo.EXPECT().ListByBoard(f.boardID, false).Return(f.lists, nil)
 			},
 			boardID: 27,
 			lists: []models.List{
@@ -145,7 +148,7 @@ func (s *ListsUsecaseSuite) TestList(t provider.T) {
 		},
 		"empty result": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().ListByBoard(f.boardID).Return(f.lists, nil)
+				f.repo.EXPECT().ListByBoard(f.boardID, false).Return(f.lists, nil)
 			},
 			boardID: 27,
 			lists:   []models.List{},
@@ -153,7 +156,7 @@ func (s *ListsUsecaseSuite) TestList(t provider.T) {
 		},
 		"board not found": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().ListByBoard(f.boardID).Return(f.lists, pkgErrors.ErrBoardNotFound)
+				f.repo.EXPECT().ListByBoard(f.boardID, false).Return(f.lists, pkgErrors.ErrBoardNotFound)
 			},
 			boardID: 27,
 			lists:   nil,
@@ -161,7 +164,7 @@ func (s *ListsUsecaseSuite) TestList(t provider.T) {
 		},
 		"storages error": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().ListByBoard(f.boardID).Return(f.lists, pkgErrors.ErrDb)
+				f.repo.EXPECT().ListByBoard(f.boardID, false).Return(f.lists, pkgErrors.ErrDb)
 			},
 			boardID: 27,
 			lists:   nil,
@@ -182,8 +185,8 @@ func (s *ListsUsecaseSuite) TestList(t provider.T) {
 				test.prepare(&f)
 			}
 
-			serv := listsUsecase.New(f.repo)
-			lists, err := serv.ListByBoard(test.boardID)
+			serv := listsUsecase.New(f.repo, nil)
+			lists, err := serv.ListByBoard(test.boardID, false)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
 			}
@@ -253,7 +256,7 @@ func (s *ListsUsecaseSuite) TestGet(t provider.T) {
 				test.prepare(&f)
 			}
 
-			uc := listsUsecase.New(f.repo)
+			uc := listsUsecase.New(f.repo, nil)
 			list, err := uc.Get(test.id)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
@@ -268,6 +271,7 @@ func (s *ListsUsecaseSuite) TestGet(t provider.T) {
 func (s *ListsUsecaseSuite) TestFullUpdate(t provider.T) {
 	type fields struct {
 		repo   *mocks.MockRepository
+		bus    *eventsMocks.MockBus
 		params *pkgLists.FullUpdateParams
 		list   *models.List
 	}
@@ -283,8 +287,9 @@ func (s *ListsUsecaseSuite) TestFullUpdate(t provider.T) {
 		"normal": {
 			prepare: func(f *fields) {
 				f.repo.EXPECT().FullUpdate(f.params).Return(*f.list, nil)
+				f.bus.EXPECT().Publish(gomock.Any())
 			},
-			params: &pkgLists.FullUpdateParams{ID: 21, Title: "MathStat", Position: 41, BoardID: 27},
+			params: &pkgLists.FullUpdateParams{ID: 21, Title: "MathStat"},
 			list: s.listsBuilder.
 				WithID(21).
 				WithBoardID(27).
@@ -303,12 +308,12 @@ func (s *ListsUsecaseSuite) TestFullUpdate(t provider.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			f := fields{repo: mocks.NewMockRepository(ctrl), params: test.params, list: &test.list}
+			f := fields{repo: mocks.NewMockRepository(ctrl), bus: eventsMocks.NewMockBus(ctrl), params: test.params, list: &test.list}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := listsUsecase.New(f.repo)
+			uc := listsUsecase.New(f.repo, f.bus)
 			list, err := uc.FullUpdate(test.params)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
@@ -323,6 +328,7 @@ func (s *ListsUsecaseSuite) TestFullUpdate(t provider.T) {
 func (s *ListsUsecaseSuite) TestPartialUpdate(t provider.T) {
 	type fields struct {
 		repo   *mocks.MockRepository
+		bus    *eventsMocks.MockBus
 		params *pkgLists.PartialUpdateParams
 		list   *models.List
 	}
@@ -337,7 +343,11 @@ func (s *ListsUsecaseSuite) TestPartialUpdate(t provider.T) {
 	tests := map[string]testCase{
 		"normal": {
 			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(21).Return(s.listsBuilder.WithID(21).WithBoardID(27).Build(), nil)
+				f.repo.EXPECT().Move(&pkgLists.MoveParams{ID: 21, BoardID: 27, Position: 41}).Return(*f.list, nil)
+				f.bus.EXPECT().Publish(gomock.Any())
 				f.repo.EXPECT().PartialUpdate(f.params).Return(*f.list, nil)
+				f.bus.EXPECT().Publish(gomock.Any())
 			},
 			params: &pkgLists.PartialUpdateParams{
 				ID:             21,
@@ -366,12 +376,12 @@ func (s *ListsUsecaseSuite) TestPartialUpdate(t provider.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			f := fields{repo: mocks.NewMockRepository(ctrl), params: test.params, list: &test.list}
+			f := fields{repo: mocks.NewMockRepository(ctrl), bus: eventsMocks.NewMockBus(ctrl), params: test.params, list: &test.list}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := listsUsecase.New(f.repo)
+			uc := listsUsecase.New(f.repo, f.bus)
 			list, err := uc.PartialUpdate(test.params)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)
@@ -386,6 +396,7 @@ func (s *ListsUsecaseSuite) TestPartialUpdate(t provider.T) {
 func (s *ListsUsecaseSuite) TestDelete(t provider.T) {
 	type fields struct {
 		repo *mocks.MockRepository
+		bus  *eventsMocks.MockBus
 		id   int
 	}
 
@@ -398,14 +409,16 @@ func (s *ListsUsecaseSuite) TestDelete(t provider.T) {
 	tests := map[string]testCase{
 		"normal": {
 			prepare: func(f *fields) {
+				f.repo.EXPECT().Get(f.id).Return(models.List{ID: 21, BoardID: 27}, nil)
 				f.repo.EXPECT().Delete(f.id).Return(nil)
+				f.bus.EXPECT().Publish(gomock.Any())
 			},
 			id:  21,
 			err: nil,
 		},
 		"list not found": {
 			prepare: func(f *fields) {
-				f.repo.EXPECT().Delete(f.id).Return(pkgErrors.ErrListNotFound)
+				f.repo.EXPECT().Get(f.id).Return(models.List{}, pkgErrors.ErrListNotFound)
 			},
 			id:  21,
 			err: pkgErrors.ErrListNotFound,
@@ -420,12 +433,12 @@ func (s *ListsUsecaseSuite) TestDelete(t provider.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			f := fields{repo: mocks.NewMockRepository(ctrl), id: test.id}
+			f := fields{repo: mocks.NewMockRepository(ctrl), bus: eventsMocks.NewMockBus(ctrl), id: test.id}
 			if test.prepare != nil {
 				test.prepare(&f)
 			}
 
-			uc := listsUsecase.New(f.repo)
+			uc := listsUsecase.New(f.repo, f.bus)
 			err := uc.Delete(test.id)
 			if !errors.Is(err, test.err) {
 				t.Errorf("\nExpected: %s\nGot: %s", test.err, err)